@@ -0,0 +1,160 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// adminDocument holds the fields of a mint, invalid mint or burn needed to transition it
+type adminDocument struct {
+	TransactionHash  string `bson:"transaction_hash"`
+	RecipientAddress string `bson:"recipient_address"`
+	Status           string `bson:"status"`
+	FencingToken     *int64 `bson:"fencing_token"`
+}
+
+// adminLockResource is the resource the signers lock while working on the document
+func adminLockResource(collection string, id primitive.ObjectID, doc adminDocument) string {
+	if collection == models.CollectionMints {
+		return fmt.Sprintf("%s/%s", collection, strings.ToLower(doc.RecipientAddress))
+	}
+	return fmt.Sprintf("%s/%s", collection, id.Hex())
+}
+
+// adminUpdate returns the update of a manual transition and the status it moves the document to
+func adminUpdate(action string, collection string, status string) (bson.M, string, error) {
+	now := time.Now()
+	clearRetries := bson.M{
+		"retries":    map[string]models.Retry{},
+		"updated_at": now,
+	}
+
+	switch action {
+	case models.AdminActionFail:
+		// a signed document may already be submitted by an executor, failing it does not stop the submission
+		if status == models.StatusSuccess || status == models.StatusFailed || status == models.StatusSigned {
+			return nil, "", fmt.Errorf("cannot fail a document with status %s", status)
+		}
+		return bson.M{"$set": bson.M{"status": models.StatusFailed, "updated_at": now}}, models.StatusFailed, nil
+
+	case models.AdminActionRequeue:
+		if status == models.StatusSuccess || status == models.StatusPending {
+			return nil, "", fmt.Errorf("cannot requeue a document with status %s", status)
+		}
+		clearRetries["status"] = models.StatusPending
+		return bson.M{"$set": clearRetries}, models.StatusPending, nil
+
+	case models.AdminActionReset:
+		// a signed or submitted document may still be executed, signing it again with a new sequence or nonce could pay out twice
+		if status == models.StatusSuccess || status == models.StatusSubmitted || status == models.StatusSigned {
+			return nil, "", fmt.Errorf("cannot reset a document with status %s", status)
+		}
+		clearRetries["status"] = models.StatusPending
+		if collection == models.CollectionMints {
+			clearRetries["signers"] = []string{}
+			clearRetries["signatures"] = []string{}
+			clearRetries["data"] = nil
+			clearRetries["nonce"] = ""
+		} else {
+			clearRetries["signatures"] = []models.Signature{}
+			clearRetries["return_transaction_body"] = ""
+			clearRetries["return_transaction_hash"] = ""
+			clearRetries["sequence"] = nil
+		}
+		return bson.M{"$set": clearRetries}, models.StatusPending, nil
+
+	default:
+		return nil, "", fmt.Errorf("unknown action: %s", action)
+	}
+}
+
+// ApplyAdminAction manually transitions a mint, invalid mint or burn and records the action with its reason.
+// The document is locked like the signers lock it, so the transition cannot interleave with a signer working on it,
+// and the update only applies if the status has not changed since the document was read.
+func ApplyAdminAction(ctx context.Context, collection string, id primitive.ObjectID, action string, reason string, operator string) (*models.AdminAction, error) {
+	if strings.TrimSpace(reason) == "" {
+		return nil, errors.New("a reason is required")
+	}
+
+	var doc adminDocument
+	if err := DB.FindOne(ctx, collection, bson.M{"_id": id}, &doc); err != nil {
+		return nil, fmt.Errorf("error finding document: %w", err)
+	}
+
+	update, toStatus, err := adminUpdate(action, collection, doc.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, lockCtx, err := AcquireLock(ctx, adminLockResource(collection, id, doc))
+	if err != nil {
+		return nil, fmt.Errorf("error locking document, a validator may be working on it: %w", err)
+	}
+	defer func() {
+		if err := lock.Release(ctx); err != nil {
+			log.Error("[ADMIN] Error unlocking document: ", err)
+		}
+	}()
+
+	filter, update, err := Fence(lockCtx, bson.M{"_id": id, "status": doc.Status}, update, doc.FencingToken)
+	if err != nil {
+		return nil, err
+	}
+
+	record := models.AdminAction{
+		Action:          action,
+		Collection:      collection,
+		DocumentId:      id.Hex(),
+		TransactionHash: doc.TransactionHash,
+		FromStatus:      doc.Status,
+		ToStatus:        toStatus,
+		Reason:          reason,
+		Operator:        operator,
+		CreatedAt:       time.Now(),
+	}
+
+	err = DB.WithTransaction(lockCtx, func(ctx context.Context) error {
+		if _, err := DB.UpdateOne(ctx, collection, filter, update); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return errors.New("document changed while applying the action, try again")
+			}
+			return fmt.Errorf("error updating document: %w", err)
+		}
+		if _, err := DB.InsertOne(ctx, models.CollectionAdminActions, record); err != nil {
+			return fmt.Errorf("error recording action: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithFields(log.Fields{
+		"collection":  collection,
+		"id":          record.DocumentId,
+		"from_status": record.FromStatus,
+		"to_status":   record.ToStatus,
+		"operator":    operator,
+	}).Info("[ADMIN] Applied ", action, ": ", reason)
+	return &record, nil
+}
+
+// FindAdminActions returns the manual transitions applied to a document, oldest first
+func FindAdminActions(ctx context.Context, collection string, id primitive.ObjectID) ([]models.AdminAction, error) {
+	actions := []models.AdminAction{}
+	filter := bson.M{"collection": collection, "document_id": id.Hex()}
+	err := DB.FindManySorted(ctx, models.CollectionAdminActions, filter, bson.D{{Key: "created_at", Value: 1}}, &actions)
+	if err != nil {
+		return nil, err
+	}
+	return actions, nil
+}
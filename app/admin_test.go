@@ -0,0 +1,191 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestAdminUpdate(t *testing.T) {
+
+	t.Run("Fail", func(t *testing.T) {
+		update, status, err := adminUpdate(models.AdminActionFail, models.CollectionBurns, models.StatusNeedsAttention)
+
+		assert.Nil(t, err)
+		assert.Equal(t, models.StatusFailed, status)
+		assert.Equal(t, models.StatusFailed, update["$set"].(bson.M)["status"])
+
+		_, _, err = adminUpdate(models.AdminActionFail, models.CollectionBurns, models.StatusSuccess)
+		assert.NotNil(t, err)
+
+		_, _, err = adminUpdate(models.AdminActionFail, models.CollectionBurns, models.StatusSigned)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Requeue", func(t *testing.T) {
+		update, status, err := adminUpdate(models.AdminActionRequeue, models.CollectionBurns, models.StatusNeedsAttention)
+
+		assert.Nil(t, err)
+		assert.Equal(t, models.StatusPending, status)
+		set := update["$set"].(bson.M)
+		assert.Equal(t, map[string]models.Retry{}, set["retries"])
+		assert.NotContains(t, set, "signatures")
+
+		_, _, err = adminUpdate(models.AdminActionRequeue, models.CollectionBurns, models.StatusPending)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Reset Burn", func(t *testing.T) {
+		update, _, err := adminUpdate(models.AdminActionReset, models.CollectionBurns, models.StatusNeedsAttention)
+
+		assert.Nil(t, err)
+		set := update["$set"].(bson.M)
+		assert.Equal(t, []models.Signature{}, set["signatures"])
+		assert.Equal(t, "", set["return_transaction_body"])
+		assert.Contains(t, set, "sequence")
+		assert.Nil(t, set["sequence"])
+
+		_, _, err = adminUpdate(models.AdminActionReset, models.CollectionBurns, models.StatusSubmitted)
+		assert.NotNil(t, err)
+
+		_, _, err = adminUpdate(models.AdminActionReset, models.CollectionBurns, models.StatusSigned)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Reset Mint", func(t *testing.T) {
+		update, _, err := adminUpdate(models.AdminActionReset, models.CollectionMints, models.StatusConfirmed)
+
+		assert.Nil(t, err)
+		set := update["$set"].(bson.M)
+		assert.Equal(t, []string{}, set["signers"])
+		assert.Equal(t, []string{}, set["signatures"])
+		assert.NotContains(t, set, "sequence")
+
+		_, _, err = adminUpdate(models.AdminActionReset, models.CollectionMints, models.StatusSigned)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		_, _, err := adminUpdate("unknown", models.CollectionBurns, models.StatusPending)
+
+		assert.NotNil(t, err)
+	})
+
+}
+
+func TestApplyAdminAction(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	expectDocument := func(mockDB *mocks.MockDatabase, status string) {
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionBurns, bson.M{"_id": id}, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				doc := result.(*adminDocument)
+				doc.TransactionHash = "0xhash"
+				doc.Status = status
+			}).Return(nil)
+	}
+
+	expectLock := func(mockDB *mocks.MockDatabase) {
+		resourceId := models.CollectionBurns + "/" + id.Hex()
+		mockDB.EXPECT().XLock(mock.Anything, resourceId).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, resourceId).Return(4, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+		mockDB.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+	}
+
+	filter := bson.M{
+		"$and": []bson.M{
+			{"_id": id, "status": models.StatusNeedsAttention},
+			{"fencing_token": nil},
+		},
+	}
+
+	t.Run("No Error", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		expectDocument(mockDB, models.StatusNeedsAttention)
+		expectLock(mockDB)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusPending, set["status"])
+				assert.Equal(t, int64(4), set["fencing_token"])
+			}).Return(id, nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionAdminActions, mock.Anything).
+			Run(func(_ context.Context, _ string, data interface{}) {
+				action := data.(models.AdminAction)
+				assert.Equal(t, models.AdminActionRequeue, action.Action)
+				assert.Equal(t, id.Hex(), action.DocumentId)
+				assert.Equal(t, "0xhash", action.TransactionHash)
+				assert.Equal(t, models.StatusNeedsAttention, action.FromStatus)
+				assert.Equal(t, models.StatusPending, action.ToStatus)
+				assert.Equal(t, "rpc outage", action.Reason)
+				assert.Equal(t, "alice", action.Operator)
+			}).Return(primitive.NewObjectID(), nil)
+
+		action, err := ApplyAdminAction(context.Background(), models.CollectionBurns, id, models.AdminActionRequeue, "rpc outage", "alice")
+
+		assert.Nil(t, err)
+		assert.Equal(t, models.StatusPending, action.ToStatus)
+	})
+
+	t.Run("Reason Required", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		action, err := ApplyAdminAction(context.Background(), models.CollectionBurns, id, models.AdminActionRequeue, " ", "alice")
+
+		assert.NotNil(t, err)
+		assert.Nil(t, action)
+	})
+
+	t.Run("Invalid Transition", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		expectDocument(mockDB, models.StatusSuccess)
+
+		action, err := ApplyAdminAction(context.Background(), models.CollectionBurns, id, models.AdminActionFail, "reason", "alice")
+
+		assert.NotNil(t, err)
+		assert.Nil(t, action)
+	})
+
+	t.Run("Error Locking", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		expectDocument(mockDB, models.StatusNeedsAttention)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("", errors.New("locked"))
+
+		action, err := ApplyAdminAction(context.Background(), models.CollectionBurns, id, models.AdminActionRequeue, "reason", "alice")
+
+		assert.NotNil(t, err)
+		assert.Nil(t, action)
+	})
+
+	t.Run("Document Changed", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		expectDocument(mockDB, models.StatusNeedsAttention)
+		expectLock(mockDB)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments)
+
+		action, err := ApplyAdminAction(context.Background(), models.CollectionBurns, id, models.AdminActionRequeue, "reason", "alice")
+
+		assert.NotNil(t, err)
+		assert.Nil(t, action)
+	})
+
+}
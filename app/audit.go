@@ -0,0 +1,200 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// auditFile is the part of *os.File used by the audit log
+type auditFile interface {
+	Write(b []byte) (int, error)
+	Sync() error
+	Truncate(size int64) error
+	Close() error
+}
+
+// AuditLog appends every signature of this validator to a hash-chained file and optionally to the database
+type AuditLog struct {
+	mu       sync.Mutex
+	file     auditFile
+	size     int64
+	mongo    bool
+	index    int64
+	prevHash string
+}
+
+var auditLog *AuditLog
+
+// InitAuditLog verifies the existing audit log file and opens it for appending
+func InitAuditLog() {
+	config := Config.AuditLog
+	if config.File == "" {
+		// the chain is continued from the file, the collection is only a copy of it
+		log.Debug("[AUDIT] Disabled")
+		return
+	}
+
+	x := &AuditLog{mongo: config.Mongo}
+
+	// the rolling limits of the signing policy are rebuilt from the signatures in the file
+	lastEntry, err := verifyAuditLog(config.File, ledger.restore)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Fatal("[AUDIT] Error verifying audit log: ", err)
+	}
+	if lastEntry != nil {
+		x.index = lastEntry.Index + 1
+		x.prevHash = lastEntry.Hash
+	}
+
+	file, err := os.OpenFile(config.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		log.Fatal("[AUDIT] Error opening audit log: ", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		log.Fatal("[AUDIT] Error reading audit log: ", err)
+	}
+	x.file = file
+	x.size = info.Size()
+
+	auditLog = x
+	log.Info("[AUDIT] Initialized audit log at index ", x.index)
+}
+
+// RecordSignature appends the entry to the audit log, a signature that cannot be recorded must not be used
+func RecordSignature(ctx context.Context, entry models.AuditEntry) error {
+	if auditLog == nil {
+		return nil
+	}
+	entry.RequestId = signingRequestId(ctx)
+	return auditLog.Record(ctx, entry)
+}
+
+func (x *AuditLog) Record(ctx context.Context, entry models.AuditEntry) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	entry.Index = x.index
+	entry.PrevHash = x.prevHash
+	entry.SignedAt = time.Now().UTC()
+	hash, err := hashAuditEntry(entry)
+	if err != nil {
+		return err
+	}
+	entry.Hash = hash
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("error encoding audit entry: %w", err)
+	}
+	line = append(line, '\n')
+	if _, err := x.file.Write(line); err != nil {
+		return x.discard(fmt.Errorf("error writing audit entry: %w", err))
+	}
+	if err := x.file.Sync(); err != nil {
+		return x.discard(fmt.Errorf("error syncing audit log: %w", err))
+	}
+
+	x.size += int64(len(line))
+	x.index = entry.Index + 1
+	x.prevHash = entry.Hash
+
+	if x.mongo {
+		if _, err := DB.InsertOne(ctx, models.CollectionAuditLog, entry); err != nil {
+			// the file is the source of truth, the collection is a convenience copy
+			log.Error("[AUDIT] Error storing audit entry: ", err)
+		}
+	}
+
+	log.Debugf("[AUDIT] Recorded %s signature %d with digest %s", entry.Kind, entry.Index, entry.Digest)
+	return nil
+}
+
+// discard truncates a partially written entry so the chain still verifies on the next start
+func (x *AuditLog) discard(cause error) error {
+	if err := x.file.Truncate(x.size); err != nil {
+		log.Error("[AUDIT] Error truncating audit log: ", err)
+		return errors.Join(cause, fmt.Errorf("error truncating audit log: %w", err))
+	}
+	return cause
+}
+
+func (x *AuditLog) Close() error {
+	if x.file == nil {
+		return nil
+	}
+	return x.file.Close()
+}
+
+// hashAuditEntry hashes the entry without its own hash, which includes the hash of the previous entry
+func hashAuditEntry(entry models.AuditEntry) (string, error) {
+	entry.Hash = ""
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return "", fmt.Errorf("error encoding audit entry: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// VerifyAuditLog checks the hash chain of the audit log file and returns its last entry
+func VerifyAuditLog(path string) (*models.AuditEntry, error) {
+	return verifyAuditLog(path, nil)
+}
+
+// verifyAuditLog checks the hash chain and passes every verified entry to visit
+func verifyAuditLog(path string, visit func(models.AuditEntry)) (*models.AuditEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var last *models.AuditEntry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var entry models.AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return last, fmt.Errorf("line %d: error decoding audit entry: %w", line, err)
+		}
+
+		expectedIndex, expectedPrevHash := int64(0), ""
+		if last != nil {
+			expectedIndex, expectedPrevHash = last.Index+1, last.Hash
+		}
+		if entry.Index != expectedIndex {
+			return last, fmt.Errorf("line %d: expected index %d, found %d", line, expectedIndex, entry.Index)
+		}
+		if entry.PrevHash != expectedPrevHash {
+			return last, fmt.Errorf("line %d: previous hash does not match entry %d", line, expectedIndex-1)
+		}
+		hash, err := hashAuditEntry(entry)
+		if err != nil {
+			return last, fmt.Errorf("line %d: %w", line, err)
+		}
+		if hash != entry.Hash {
+			return last, fmt.Errorf("line %d: hash does not match entry contents", line)
+		}
+
+		if visit != nil {
+			visit(entry)
+		}
+		last = &entry
+	}
+	if err := scanner.Err(); err != nil {
+		return last, fmt.Errorf("error reading audit log: %w", err)
+	}
+	return last, nil
+}
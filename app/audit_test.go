@@ -0,0 +1,151 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// partialFile writes only half of the next entry before failing
+type partialFile struct {
+	*os.File
+	fail bool
+}
+
+func (x *partialFile) Write(b []byte) (int, error) {
+	if !x.fail {
+		return x.File.Write(b)
+	}
+	x.fail = false
+	n, _ := x.File.Write(b[:len(b)/2])
+	return n, errors.New("no space left on device")
+}
+
+func TestAuditLog(t *testing.T) {
+	defer func() {
+		auditLog = nil
+		Config.AuditLog = models.AuditLogConfig{}
+	}()
+
+	t.Run("Disabled", func(t *testing.T) {
+		auditLog = nil
+		Config.AuditLog = models.AuditLogConfig{}
+		InitAuditLog()
+		assert.Nil(t, auditLog)
+		assert.NoError(t, RecordSignature(context.Background(), models.AuditEntry{Kind: SigningKindMint}))
+	})
+
+	t.Run("Mongo without file", func(t *testing.T) {
+		auditLog = nil
+		Config.AuditLog = models.AuditLogConfig{Mongo: true}
+		InitAuditLog()
+		assert.Nil(t, auditLog)
+	})
+
+	t.Run("Chain continues across restarts", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		Config.AuditLog = models.AuditLogConfig{File: path}
+
+		InitAuditLog()
+		assert.NoError(t, RecordSignature(context.Background(), models.AuditEntry{Kind: SigningKindMint, Digest: "0x01"}))
+		assert.NoError(t, RecordSignature(context.Background(), models.AuditEntry{Kind: SigningKindRefund, Digest: "0x02"}))
+		assert.NoError(t, auditLog.Close())
+
+		InitAuditLog()
+		assert.Equal(t, int64(2), auditLog.index)
+		assert.NoError(t, RecordSignature(context.Background(), models.AuditEntry{Kind: SigningKindMint, Digest: "0x03"}))
+		assert.NoError(t, auditLog.Close())
+
+		last, err := VerifyAuditLog(path)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), last.Index)
+		assert.Equal(t, "0x03", last.Digest)
+	})
+
+	t.Run("Tampered entry", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		Config.AuditLog = models.AuditLogConfig{File: path}
+
+		InitAuditLog()
+		assert.NoError(t, RecordSignature(context.Background(), models.AuditEntry{Kind: SigningKindMint, Amount: "100"}))
+		assert.NoError(t, RecordSignature(context.Background(), models.AuditEntry{Kind: SigningKindMint, Amount: "200"}))
+		assert.NoError(t, auditLog.Close())
+
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.NoError(t, os.WriteFile(path, []byte(strings.Replace(string(data), `"amount":"100"`, `"amount":"900"`, 1)), 0600))
+
+		last, err := VerifyAuditLog(path)
+		assert.ErrorContains(t, err, "line 1: hash does not match entry contents")
+		assert.Nil(t, last)
+	})
+
+	t.Run("Removed entry", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		Config.AuditLog = models.AuditLogConfig{File: path}
+
+		InitAuditLog()
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, RecordSignature(context.Background(), models.AuditEntry{Kind: SigningKindMint}))
+		}
+		assert.NoError(t, auditLog.Close())
+
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		lines := strings.SplitAfter(string(data), "\n")
+		assert.NoError(t, os.WriteFile(path, []byte(lines[0]+lines[2]), 0600))
+
+		last, err := VerifyAuditLog(path)
+		assert.ErrorContains(t, err, "line 2: expected index 1, found 2")
+		assert.Equal(t, int64(0), last.Index)
+	})
+
+	t.Run("Failed write is truncated", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		Config.AuditLog = models.AuditLogConfig{File: path}
+
+		InitAuditLog()
+		assert.NoError(t, RecordSignature(context.Background(), models.AuditEntry{Kind: SigningKindMint, Digest: "0x01"}))
+
+		file := &partialFile{File: auditLog.file.(*os.File), fail: true}
+		auditLog.file = file
+		assert.ErrorContains(t, RecordSignature(context.Background(), models.AuditEntry{Kind: SigningKindMint, Digest: "0x02"}), "no space left on device")
+		assert.Equal(t, int64(1), auditLog.index)
+
+		assert.NoError(t, RecordSignature(context.Background(), models.AuditEntry{Kind: SigningKindMint, Digest: "0x03"}))
+		assert.NoError(t, auditLog.Close())
+
+		last, err := VerifyAuditLog(path)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), last.Index)
+		assert.Equal(t, "0x03", last.Digest)
+	})
+
+	t.Run("Mongo copy", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+		Config.AuditLog = models.AuditLogConfig{File: filepath.Join(t.TempDir(), "audit.log"), Mongo: true}
+		InitAuditLog()
+		defer auditLog.Close()
+
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionAuditLog, mock.Anything).
+			Run(func(_ context.Context, _ string, data interface{}) {
+				entry := data.(models.AuditEntry)
+				assert.Equal(t, int64(0), entry.Index)
+				assert.NotEmpty(t, entry.Hash)
+			}).Return(primitive.NilObjectID, errors.New("error")).Once()
+
+		// the database copy is best effort
+		assert.NoError(t, RecordSignature(context.Background(), models.AuditEntry{Kind: SigningKindMint}))
+		assert.Equal(t, int64(1), auditLog.index)
+	})
+}
@@ -0,0 +1,136 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func checkpointFilter(validatorId string, service string, address string) bson.M {
+	return bson.M{
+		"validator_id": validatorId,
+		"service":      service,
+		"address":      address,
+	}
+}
+
+// FindCheckpoint returns the sync checkpoint of a service for the given address
+func FindCheckpoint(ctx context.Context, validatorId string, service string, address string) (*models.Checkpoint, error) {
+	var checkpoint models.Checkpoint
+	err := DB.FindOne(ctx, models.CollectionCheckpoints, checkpointFilter(validatorId, service, address), &checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &checkpoint, nil
+}
+
+// FindCheckpoints returns all sync checkpoints of a validator
+func FindCheckpoints(ctx context.Context, validatorId string) ([]models.Checkpoint, error) {
+	checkpoints := []models.Checkpoint{}
+	sort := bson.D{{Key: "service", Value: 1}, {Key: "address", Value: 1}}
+	err := DB.FindManySorted(ctx, models.CollectionCheckpoints, bson.M{"validator_id": validatorId}, sort, &checkpoints)
+	if err != nil {
+		return nil, err
+	}
+	return checkpoints, nil
+}
+
+// SaveCheckpoint advances the sync checkpoint of a service, it never moves it backwards
+func SaveCheckpoint(ctx context.Context, validatorId string, service string, address string, height int64) error {
+	now := time.Now()
+	update := bson.M{
+		"$max":         bson.M{"height": height},
+		"$set":         bson.M{"updated_at": now},
+		"$setOnInsert": bson.M{"created_at": now},
+	}
+	_, err := DB.UpsertOne(ctx, models.CollectionCheckpoints, checkpointFilter(validatorId, service, address), update)
+	return err
+}
+
+// RewindCheckpoint sets the sync checkpoint of a service to the given height
+func RewindCheckpoint(ctx context.Context, validatorId string, service string, address string, height int64) error {
+	now := time.Now()
+	update := bson.M{
+		"$set":         bson.M{"height": height, "updated_at": now},
+		"$setOnInsert": bson.M{"created_at": now},
+	}
+	_, err := DB.UpsertOne(ctx, models.CollectionCheckpoints, checkpointFilter(validatorId, service, address), update)
+	return err
+}
+
+// LockCheckpoint locks the sync checkpoint of a service, it is held by a sync and by a rewind so they never overlap
+func LockCheckpoint(ctx context.Context, validatorId string, service string, address string) (*Lock, error) {
+	resourceID := fmt.Sprintf("%s/%s/%s/%s", models.CollectionCheckpoints, validatorId, service, address)
+	lock, _, err := AcquireLock(ctx, resourceID)
+	return lock, err
+}
+
+// SyncFromCheckpoint runs sync under the checkpoint lock, after passing the stored checkpoint to reload,
+// so a sync resumes from a rewound checkpoint and never saves its own progress over a rewind.
+// The sync does not run under the lock context, the documents it writes are fenced by their own locks.
+func SyncFromCheckpoint(ctx context.Context, validatorId string, service string, address string, reload func(height int64), sync func(ctx context.Context) bool) bool {
+	lock, err := LockCheckpoint(ctx, validatorId, service, address)
+	if err != nil {
+		log.Errorf("[%s] Error locking checkpoint: %s", service, err)
+		return false
+	}
+	defer func() {
+		if err := lock.Release(ctx); err != nil {
+			log.Errorf("[%s] Error unlocking checkpoint: %s", service, err)
+		}
+	}()
+
+	checkpoint, err := FindCheckpoint(ctx, validatorId, service, address)
+	if err == nil {
+		reload(checkpoint.Height)
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		log.Errorf("[%s] Error finding checkpoint: %s", service, err)
+		return false
+	}
+
+	return sync(ctx)
+}
+
+type checkpointKey struct{}
+
+type pendingCheckpoint struct {
+	validatorId string
+	service     string
+	address     string
+	height      int64
+}
+
+// WithCheckpoint returns a context under which persisted documents also advance the sync checkpoint of a service to height
+func WithCheckpoint(ctx context.Context, validatorId string, service string, address string, height int64) context.Context {
+	return context.WithValue(ctx, checkpointKey{}, pendingCheckpoint{validatorId, service, address, height})
+}
+
+// CommitCheckpoint advances the sync checkpoint held in ctx, it is called in the transaction that persists the synced documents.
+// It does nothing without a checkpoint in ctx.
+func CommitCheckpoint(ctx context.Context) error {
+	c, ok := ctx.Value(checkpointKey{}).(pendingCheckpoint)
+	if !ok {
+		return nil
+	}
+	return SaveCheckpoint(ctx, c.validatorId, c.service, c.address, c.height)
+}
+
+// PersistWithCheckpoint runs fn and advances the sync checkpoint held in ctx in one transaction,
+// so a checkpoint never covers documents that were not persisted. Without a checkpoint in ctx it only runs fn.
+func PersistWithCheckpoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(checkpointKey{}).(pendingCheckpoint); !ok {
+		return fn(ctx)
+	}
+	return DB.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := fn(ctx); err != nil {
+			return err
+		}
+		return CommitCheckpoint(ctx)
+	})
+}
@@ -0,0 +1,179 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestFindCheckpoint(t *testing.T) {
+
+	t.Run("No Error", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		filter := bson.M{"validator_id": "validatorId", "service": "service", "address": "address"}
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				result.(*models.Checkpoint).Height = 10
+			}).Return(nil)
+
+		checkpoint, err := FindCheckpoint(context.Background(), "validatorId", "service", "address")
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(10), checkpoint.Height)
+	})
+
+	t.Run("With Error", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(errors.New("error"))
+
+		checkpoint, err := FindCheckpoint(context.Background(), "validatorId", "service", "address")
+
+		assert.NotNil(t, err)
+		assert.Nil(t, checkpoint)
+	})
+
+}
+
+func TestSaveCheckpoint(t *testing.T) {
+	mockDB := mocks.NewMockDatabase(t)
+	DB = mockDB
+
+	filter := bson.M{"validator_id": "validatorId", "service": "service", "address": "address"}
+	mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).
+		Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+			assert.Equal(t, bson.M{"height": int64(10)}, update.(bson.M)["$max"])
+		}).Return(primitive.NewObjectID(), nil)
+
+	err := SaveCheckpoint(context.Background(), "validatorId", "service", "address", 10)
+
+	assert.Nil(t, err)
+}
+
+func TestRewindCheckpoint(t *testing.T) {
+	mockDB := mocks.NewMockDatabase(t)
+	DB = mockDB
+
+	filter := bson.M{"validator_id": "validatorId", "service": "service", "address": "address"}
+	mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).
+		Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+			assert.Equal(t, int64(5), update.(bson.M)["$set"].(bson.M)["height"])
+		}).Return(primitive.NewObjectID(), nil)
+
+	err := RewindCheckpoint(context.Background(), "validatorId", "service", "address", 5)
+
+	assert.Nil(t, err)
+}
+
+func TestSyncFromCheckpoint(t *testing.T) {
+	filter := bson.M{"validator_id": "validatorId", "service": "service", "address": "address"}
+
+	t.Run("Reloads Checkpoint", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().XLock(mock.Anything, "checkpoints/validatorId/service/address").Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, "checkpoints/validatorId/service/address").Return(1, nil)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				result.(*models.Checkpoint).Height = 5
+			}).Return(nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+
+		var reloaded int64
+		success := SyncFromCheckpoint(context.Background(), "validatorId", "service", "address",
+			func(height int64) { reloaded = height },
+			func(ctx context.Context) bool { return true })
+
+		assert.True(t, success)
+		assert.Equal(t, int64(5), reloaded)
+	})
+
+	t.Run("No Checkpoint", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).Return(mongo.ErrNoDocuments)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+
+		success := SyncFromCheckpoint(context.Background(), "validatorId", "service", "address",
+			func(height int64) { t.Fatal("reloaded without a checkpoint") },
+			func(ctx context.Context) bool { return false })
+
+		assert.False(t, success)
+	})
+
+	t.Run("Locked", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("", errors.New("locked"))
+
+		success := SyncFromCheckpoint(context.Background(), "validatorId", "service", "address",
+			func(height int64) {},
+			func(ctx context.Context) bool { t.Fatal("synced without the lock"); return true })
+
+		assert.False(t, success)
+	})
+}
+
+func TestPersistWithCheckpoint(t *testing.T) {
+	filter := bson.M{"validator_id": "validatorId", "service": "service", "address": "address"}
+
+	t.Run("Without Checkpoint", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		called := false
+		err := PersistWithCheckpoint(context.Background(), func(ctx context.Context) error {
+			called = true
+			return nil
+		})
+
+		assert.Nil(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("With Checkpoint", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				assert.Equal(t, bson.M{"height": int64(7)}, update.(bson.M)["$max"])
+			}).Return(primitive.NewObjectID(), nil)
+
+		ctx := WithCheckpoint(context.Background(), "validatorId", "service", "address", 7)
+		err := PersistWithCheckpoint(ctx, func(ctx context.Context) error { return nil })
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Error Persisting", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+
+		ctx := WithCheckpoint(context.Background(), "validatorId", "service", "address", 7)
+		err := PersistWithCheckpoint(ctx, func(ctx context.Context) error { return errors.New("error") })
+
+		assert.NotNil(t, err)
+	})
+}
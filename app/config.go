@@ -1,7 +1,9 @@
 package app
 
 import (
+	"net"
 	"os"
+	"strconv"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -15,6 +17,15 @@ var (
 	Config models.Config
 )
 
+const (
+	defaultDegradedAfterFailures  = 1
+	defaultUnhealthyAfterFailures = 5
+	defaultGossipTimeoutMillis    = 5000
+	defaultRetryMaxAttempts       = 10
+	defaultRetryBackoffMillis     = 30000
+	defaultRetryMaxBackoffMillis  = 3600000
+)
+
 func InitConfig(configFile string, envFile string) {
 	log.Debug("[CONFIG] Initializing config")
 	readConfigFromConfigFile(configFile)
@@ -45,15 +56,27 @@ func readConfigFromConfigFile(configFile string) bool {
 func validateConfig() {
 	log.Debug("[CONFIG] Validating config")
 	{
-		// mongodb
-		if Config.MongoDB.URI == "" {
-			log.Fatal("[CONFIG] MongoDB.URI is required")
-		}
-		if Config.MongoDB.Database == "" {
-			log.Fatal("[CONFIG] MongoDB.Database is required")
-		}
-		if Config.MongoDB.TimeoutMillis == 0 {
-			log.Fatal("[CONFIG] MongoDB.TimeoutMillis is required")
+		// database
+		switch Config.DatabaseBackend {
+		case "", models.DatabaseMongo:
+			if Config.MongoDB.URI == "" {
+				log.Fatal("[CONFIG] MongoDB.URI is required")
+			}
+			if Config.MongoDB.Database == "" {
+				log.Fatal("[CONFIG] MongoDB.Database is required")
+			}
+			if Config.MongoDB.TimeoutMillis == 0 {
+				log.Fatal("[CONFIG] MongoDB.TimeoutMillis is required")
+			}
+		case models.DatabasePostgres:
+			if Config.Postgres.URI == "" {
+				log.Fatal("[CONFIG] Postgres.URI is required")
+			}
+			if Config.Postgres.TimeoutMillis == 0 {
+				log.Fatal("[CONFIG] Postgres.TimeoutMillis is required")
+			}
+		default:
+			log.Fatal("[CONFIG] DatabaseBackend must be mongodb or postgres")
 		}
 	}
 
@@ -68,11 +91,39 @@ func validateConfig() {
 		if Config.Ethereum.RPCTimeoutMillis == 0 {
 			log.Fatal("[CONFIG] Ethereum.RPCTimeoutMillis is required")
 		}
-		if Config.Ethereum.PrivateKey == "" {
-			log.Fatal("[CONFIG] Ethereum.PrivateKey is required")
-		} else {
-			Config.Ethereum.PrivateKey = strings.TrimPrefix(Config.Ethereum.PrivateKey, "0x")
+		if Config.Ethereum.RPCRateLimit < 0 {
+			log.Fatal("[CONFIG] Ethereum.RPCRateLimit must not be negative")
+		}
+		for index, endpoint := range Config.Ethereum.RPCEndpoints {
+			if endpoint.URL == "" {
+				log.Fatalf("[CONFIG] Ethereum.RPCEndpoints[%d].URL is required", index)
+			}
+			if endpoint.TimeoutMillis < 0 || endpoint.RateLimit < 0 {
+				log.Fatalf("[CONFIG] Ethereum.RPCEndpoints[%d] timeout and rate limit must not be negative", index)
+			}
+		}
+		if Config.Ethereum.WSURL != "" && !strings.HasPrefix(Config.Ethereum.WSURL, "ws://") && !strings.HasPrefix(Config.Ethereum.WSURL, "wss://") {
+			log.Fatal("[CONFIG] Ethereum.WSURL must be a ws:// or wss:// url")
 		}
+		for index, wsURL := range Config.Ethereum.WSURLs {
+			if !strings.HasPrefix(wsURL, "ws://") && !strings.HasPrefix(wsURL, "wss://") {
+				log.Fatalf("[CONFIG] Ethereum.WSURLs[%d] must be a ws:// or wss:// url", index)
+			}
+		}
+		ethereumEndpoints := 1 + len(Config.Ethereum.RPCEndpoints)
+		if Config.Ethereum.Quorum < 0 || Config.Ethereum.Quorum > int64(ethereumEndpoints) {
+			log.Fatalf("[CONFIG] Ethereum.Quorum must be between 0 and the number of endpoints (%d)", ethereumEndpoints)
+		}
+		if Config.Ethereum.Quorum > 1 && Config.Ethereum.Quorum <= int64(ethereumEndpoints/2) {
+			log.Fatalf("[CONFIG] Ethereum.Quorum must be more than half of the number of endpoints (%d)", ethereumEndpoints)
+		}
+		if Config.Ethereum.PrivateKey == "" &&
+			Config.Ethereum.Mnemonic == "" &&
+			Config.Ethereum.RemoteSignerSocket == "" &&
+			Config.Ethereum.GcpKmsKeyName == "" {
+			log.Fatal("[CONFIG] Ethereum.PrivateKey, Ethereum.Mnemonic, Ethereum.RemoteSignerSocket or Ethereum.GcpKmsKeyName is required")
+		}
+		Config.Ethereum.PrivateKey = strings.TrimPrefix(Config.Ethereum.PrivateKey, "0x")
 
 		if Config.Ethereum.WrappedPocketAddress == "" {
 			log.Fatal("[CONFIG] Ethereum.WrappedPocketAddress is required")
@@ -127,6 +178,42 @@ func validateConfig() {
 				log.Fatal("Pocket.RPCURL is required when GRPCEnabled is false")
 			}
 		}
+		pocketEndpoints := 1 + len(Config.Pocket.RPCURLs)
+		if Config.Pocket.GRPCEnabled {
+			pocketEndpoints = 1 + len(Config.Pocket.GRPCEndpoints)
+			for index, endpoint := range Config.Pocket.GRPCEndpoints {
+				_, port, err := net.SplitHostPort(endpoint)
+				if err != nil {
+					log.Fatalf("Pocket.GRPCEndpoints[%d] must be a host:port address: %s", index, endpoint)
+				}
+				if _, err := strconv.ParseUint(port, 10, 64); err != nil {
+					log.Fatalf("Pocket.GRPCEndpoints[%d] has an invalid port: %s", index, endpoint)
+				}
+			}
+		}
+		if Config.Pocket.Quorum < 0 || Config.Pocket.Quorum > int64(pocketEndpoints) {
+			log.Fatalf("Pocket.Quorum must be between 0 and the number of endpoints (%d)", pocketEndpoints)
+		}
+		if Config.Pocket.Quorum > 1 && Config.Pocket.Quorum <= int64(pocketEndpoints/2) {
+			log.Fatalf("Pocket.Quorum must be more than half of the number of endpoints (%d)", pocketEndpoints)
+		}
+		switch Config.Pocket.ScanMode {
+		case "":
+			Config.Pocket.ScanMode = models.ScanModeTxSearch
+		case models.ScanModeTxSearch:
+		case models.ScanModeBlocks:
+			if Config.Pocket.RPCURL == "" {
+				log.Fatal("Pocket.RPCURL is required when ScanMode is blocks")
+			}
+			if Config.Pocket.ScanMaxBlocks == 0 {
+				log.Warn("Pocket.ScanMaxBlocks is 0, all new blocks are scanned in one run")
+			}
+		default:
+			log.Fatalf("Pocket.ScanMode must be %s or %s", models.ScanModeTxSearch, models.ScanModeBlocks)
+		}
+		if Config.Pocket.Subscribe && Config.Pocket.RPCURL == "" {
+			log.Fatal("Pocket.RPCURL is required when Subscribe is enabled")
+		}
 		if Config.Pocket.RPCTimeoutMillis == 0 {
 			log.Fatal("Pocket.TimeoutMS is required")
 		}
@@ -149,8 +236,8 @@ func validateConfig() {
 			log.Fatal("Pocket.MultisigPublicKeys is required and must have at least 2 public keys")
 		}
 
-		if Config.Pocket.Mnemonic == "" && Config.Pocket.GcpKmsKeyName == "" {
-			log.Fatal("[CONFIG] Pocket.Mnemonic or Pocket.GcpKmsKeyName is required")
+		if Config.Pocket.Mnemonic == "" && Config.Pocket.RemoteSignerSocket == "" && Config.Pocket.GcpKmsKeyName == "" {
+			log.Fatal("[CONFIG] Pocket.Mnemonic, Pocket.RemoteSignerSocket or Pocket.GcpKmsKeyName is required")
 		}
 
 		_, err := GetPocketSignerAndMultisig()
@@ -187,6 +274,75 @@ func validateConfig() {
 		if Config.HealthCheck.IntervalMillis == 0 {
 			log.Fatal("[CONFIG] HealthCheck.Interval is required")
 		}
+		if Config.HealthCheck.DegradedAfterFailures == 0 {
+			log.Warnf("[CONFIG] HealthCheck.DegradedAfterFailures is 0, using %d", defaultDegradedAfterFailures)
+			Config.HealthCheck.DegradedAfterFailures = defaultDegradedAfterFailures
+		}
+		if Config.HealthCheck.UnhealthyAfterFailures == 0 {
+			log.Warnf("[CONFIG] HealthCheck.UnhealthyAfterFailures is 0, using %d", defaultUnhealthyAfterFailures)
+			Config.HealthCheck.UnhealthyAfterFailures = defaultUnhealthyAfterFailures
+		}
+		if Config.HealthCheck.UnhealthyAfterFailures < Config.HealthCheck.DegradedAfterFailures {
+			log.Fatal("[CONFIG] HealthCheck.UnhealthyAfterFailures must not be less than HealthCheck.DegradedAfterFailures")
+		}
+	}
+
+	{
+		// http server
+		if Config.HTTPServer.Enabled && Config.HTTPServer.ListenAddress == "" {
+			log.Fatal("[CONFIG] HTTPServer.ListenAddress is required when HTTPServer.Enabled is true")
+		}
+	}
+
+	if Config.Gossip.Enabled {
+		// gossip
+		if Config.Gossip.ListenAddress == "" {
+			log.Fatal("[CONFIG] Gossip.ListenAddress is required when Gossip.Enabled is true")
+		}
+		if Config.Gossip.TLSCertFile == "" || Config.Gossip.TLSKeyFile == "" || Config.Gossip.TLSCAFile == "" {
+			log.Fatal("[CONFIG] Gossip.TLSCertFile, Gossip.TLSKeyFile and Gossip.TLSCAFile are required when Gossip.Enabled is true")
+		}
+		if len(Config.Gossip.Peers) == 0 {
+			log.Warn("[CONFIG] Gossip.Peers is empty, no signatures will be exchanged")
+		}
+		for i, peer := range Config.Gossip.Peers {
+			if !strings.HasPrefix(peer, "https://") {
+				log.Fatalf("[CONFIG] Gossip.Peers[%d] must be an https url", i)
+			}
+		}
+		if Config.Gossip.TimeoutMillis == 0 {
+			log.Warnf("[CONFIG] Gossip.TimeoutMillis is 0, using %d", defaultGossipTimeoutMillis)
+			Config.Gossip.TimeoutMillis = defaultGossipTimeoutMillis
+		}
+	}
+
+	{
+		// audit log
+		if Config.AuditLog.Mongo && Config.AuditLog.File == "" {
+			log.Fatal("[CONFIG] AuditLog.File is required when AuditLog.Mongo is true, the collection only holds a copy of the file")
+		}
+		if Config.AuditLog.File == "" && (hasRollingLimits(Config.SigningPolicy.Mint) || hasRollingLimits(Config.SigningPolicy.Refund)) {
+			log.Warn("[CONFIG] AuditLog.File is empty, the rolling limits of SigningPolicy restart empty")
+		}
+	}
+
+	{
+		// retry
+		if Config.Retry.MaxAttempts == 0 {
+			log.Warnf("[CONFIG] Retry.MaxAttempts is 0, using %d", defaultRetryMaxAttempts)
+			Config.Retry.MaxAttempts = defaultRetryMaxAttempts
+		}
+		if Config.Retry.BackoffMillis == 0 {
+			log.Warnf("[CONFIG] Retry.BackoffMillis is 0, using %d", defaultRetryBackoffMillis)
+			Config.Retry.BackoffMillis = defaultRetryBackoffMillis
+		}
+		if Config.Retry.MaxBackoffMillis == 0 {
+			log.Warnf("[CONFIG] Retry.MaxBackoffMillis is 0, using %d", defaultRetryMaxBackoffMillis)
+			Config.Retry.MaxBackoffMillis = defaultRetryMaxBackoffMillis
+		}
+		if Config.Retry.MaxBackoffMillis < Config.Retry.BackoffMillis {
+			log.Fatal("[CONFIG] Retry.MaxBackoffMillis must not be less than Retry.BackoffMillis")
+		}
 	}
 
 	log.Debug("[CONFIG] Config validated")
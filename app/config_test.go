@@ -116,6 +116,26 @@ func TestValidateConfig(t *testing.T) {
 		assert.Panics(t, func() { validateConfig() })
 	})
 
+	t.Run("Without Postgres URI", func(t *testing.T) {
+		Config = models.Config{}
+		Config.DatabaseBackend = models.DatabasePostgres
+
+		defer func() { log.StandardLogger().ExitFunc = nil }()
+		log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
+
+		assert.Panics(t, func() { validateConfig() })
+	})
+
+	t.Run("Invalid Database Backend", func(t *testing.T) {
+		Config = models.Config{}
+		Config.DatabaseBackend = "mysql"
+
+		defer func() { log.StandardLogger().ExitFunc = nil }()
+		log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
+
+		assert.Panics(t, func() { validateConfig() })
+	})
+
 	t.Run("Without Eth RPC URL", func(t *testing.T) {
 		Config = models.Config{}
 		Config.MongoDB.URI = "mongodb://localhost:27017"
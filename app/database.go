@@ -12,8 +12,10 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
 
+	"errors"
 	"fmt"
 
 	lock "github.com/square/mongo-lock"
@@ -27,19 +29,29 @@ type Database interface {
 	Connect() error
 	Disconnect() error
 
-	InsertOne(collection string, data interface{}) (primitive.ObjectID, error)
-	FindOne(collection string, filter interface{}, result interface{}) error
-	FindMany(collection string, filter interface{}, result interface{}) error
-	FindManySorted(collection string, filter interface{}, sort interface{}, result interface{}) error
-	AggregateOne(collection string, pipeline interface{}, result interface{}) error
-	AggregateMany(collection string, pipeline interface{}, result interface{}) error
-
-	UpdateOne(collection string, filter interface{}, update interface{}) (primitive.ObjectID, error)
-	UpsertOne(collection string, filter interface{}, update interface{}) (primitive.ObjectID, error)
-
-	XLock(resourceID string) (string, error)
-	SLock(resourceID string) (string, error)
-	Unlock(lockID string) error
+	InsertOne(ctx context.Context, collection string, data interface{}) (primitive.ObjectID, error)
+	FindOne(ctx context.Context, collection string, filter interface{}, result interface{}) error
+	FindMany(ctx context.Context, collection string, filter interface{}, result interface{}) error
+	FindManySorted(ctx context.Context, collection string, filter interface{}, sort interface{}, result interface{}) error
+	FindManyPaged(ctx context.Context, collection string, filter interface{}, sort interface{}, skip int64, limit int64, result interface{}) error
+	CountDocuments(ctx context.Context, collection string, filter interface{}) (int64, error)
+	AggregateOne(ctx context.Context, collection string, pipeline interface{}, result interface{}) error
+	AggregateMany(ctx context.Context, collection string, pipeline interface{}, result interface{}) error
+
+	UpdateOne(ctx context.Context, collection string, filter interface{}, update interface{}) (primitive.ObjectID, error)
+	UpsertOne(ctx context.Context, collection string, filter interface{}, update interface{}) (primitive.ObjectID, error)
+
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	XLock(ctx context.Context, resourceID string) (string, error)
+	SLock(ctx context.Context, resourceID string) (string, error)
+	Unlock(ctx context.Context, lockID string) error
+	RenewLock(ctx context.Context, lockID string) error
+	NextFencingToken(ctx context.Context, resourceID string) (int64, error)
+
+	Migrations() []models.Migration
+	AppliedMigrations(ctx context.Context) ([]models.SchemaMigration, error)
+	ApplyMigration(ctx context.Context, migration models.Migration) error
 }
 
 // MongoDatabase is a wrapper around the mongo database
@@ -91,9 +103,30 @@ func randomString(n int) (string, error) {
 	return string(bytes), nil
 }
 
+// WithTransaction runs fn in a transaction, retrying it on transient errors.
+// Operations that use the ctx passed to fn are part of the transaction.
+func (d *MongoDatabase) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if mongo.SessionFromContext(ctx) != nil {
+		// already in a transaction
+		return fn(ctx)
+	}
+
+	session, err := d.db.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(context.WithoutCancel(ctx))
+
+	opts := options.Transaction().SetReadConcern(readconcern.Snapshot()).SetWriteConcern(writeconcern.Majority())
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	}, opts)
+	return err
+}
+
 // XLock locks a resource for exclusive access
-func (d *MongoDatabase) XLock(resourceID string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+func (d *MongoDatabase) XLock(ctx context.Context, resourceID string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
 	defer cancel()
 
 	lockID, err := randomString(32)
@@ -101,14 +134,14 @@ func (d *MongoDatabase) XLock(resourceID string) (string, error) {
 		return "", err
 	}
 	err = d.locker.XLock(ctx, resourceID, lockID, lock.LockDetails{
-		TTL: 60, // locks expire in 60 seconds
+		TTL: lockTTL,
 	})
 	return lockID, err
 }
 
 // SLock locks a resource for shared access
-func (d *MongoDatabase) SLock(resourceID string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+func (d *MongoDatabase) SLock(ctx context.Context, resourceID string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
 	defer cancel()
 
 	lockID, err := randomString(32)
@@ -116,114 +149,223 @@ func (d *MongoDatabase) SLock(resourceID string) (string, error) {
 		return "", err
 	}
 	err = d.locker.SLock(ctx, resourceID, lockID, lock.LockDetails{
-		TTL: 60, // locks expire in 60 seconds
+		TTL: lockTTL,
 	}, -1)
 	return lockID, err
 }
 
-// Unlock unlocks a resource
-func (d *MongoDatabase) Unlock(lockID string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+// Unlock unlocks a resource, even if ctx has already been cancelled
+func (d *MongoDatabase) Unlock(ctx context.Context, lockID string) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), d.timeout)
 	defer cancel()
 
 	_, err := d.locker.Unlock(ctx, lockID)
 	return err
 }
 
-// Setup Indexes
-func (d *MongoDatabase) SetupIndexesAndLocker() error {
-	d.logger.Debug("[DB] Setting up indexes")
+// lockTTL is how long a lock lasts in seconds without being renewed
+const lockTTL = 60
 
-	// setup unique index for mints
-	d.logger.Debug("[DB] Setting up indexes for mints")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
-	defer cancel()
-	_, err := d.db.Collection(models.CollectionMints).Indexes().CreateOne(ctx, mongo.IndexModel{
-		Keys:    bson.D{{Key: "transaction_hash", Value: 1}},
-		Options: options.Index().SetUnique(true),
-	})
-	if err != nil {
-		return err
-	}
+// collectionFencingTokens holds the last fencing token handed out for each locked resource
+const collectionFencingTokens = "fencing_tokens"
 
-	// setup unique index for invalid mints
-	d.logger.Debug("[DB] Setting up indexes for invalid mints")
-	ctx, cancel = context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+// RenewLock extends a lock by its full TTL, failing if it has already expired
+func (d *MongoDatabase) RenewLock(ctx context.Context, lockID string) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
 	defer cancel()
-	_, err = d.db.Collection(models.CollectionInvalidMints).Indexes().CreateOne(ctx, mongo.IndexModel{
-		Keys:    bson.D{{Key: "transaction_hash", Value: 1}},
-		Options: options.Index().SetUnique(true),
-	})
-	if err != nil {
-		return err
-	}
 
-	// setup index for unique sequence for invalid mints
-	ctx, cancel = context.WithTimeout(context.Background(), d.timeout)
-	defer cancel()
-	_, err = d.db.Collection(models.CollectionInvalidMints).Indexes().CreateOne(ctx, mongo.IndexModel{
-		Keys: bson.D{{Key: "sequence", Value: 1}},
-		Options: options.Index().SetUnique(true).
-			SetPartialFilterExpression(bson.D{{Key: "sequence", Value: bson.D{{Key: "$exists", Value: true}, {Key: "$type", Value: "long"}}}}),
-	})
-	if err != nil {
-		return err
-	}
+	_, err := d.locker.Renew(ctx, lockID, lockTTL)
+	return err
+}
 
-	// setup unique index for burns
-	d.logger.Debug("[DB] Setting up indexes for burns")
-	ctx, cancel = context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
+// NextFencingToken increments and returns the fencing token of a resource
+func (d *MongoDatabase) NextFencingToken(ctx context.Context, resourceID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
 	defer cancel()
-	_, err = d.db.Collection(models.CollectionBurns).Indexes().CreateOne(ctx, mongo.IndexModel{
-		Keys:    bson.D{{Key: "transaction_hash", Value: 1}, {Key: "d.logger.index", Value: 1}},
-		Options: options.Index().SetUnique(true),
-	})
-	if err != nil {
-		return err
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	update := bson.M{"$inc": bson.M{"token": int64(1)}}
+
+	var result struct {
+		Token int64 `bson:"token"`
 	}
+	err := d.db.Collection(collectionFencingTokens).FindOneAndUpdate(ctx, bson.M{"_id": resourceID}, update, opts).Decode(&result)
+	if mongo.IsDuplicateKeyError(err) {
+		// another validator created the token first
+		err = d.db.Collection(collectionFencingTokens).FindOneAndUpdate(ctx, bson.M{"_id": resourceID}, update, opts).Decode(&result)
+	}
+	return result.Token, err
+}
 
-	// setup index for unique sequence for burns
-	ctx, cancel = context.WithTimeout(context.Background(), d.timeout)
-	defer cancel()
-	_, err = d.db.Collection(models.CollectionBurns).Indexes().CreateOne(ctx, mongo.IndexModel{
+// mongoIndex is an index created by a migration
+type mongoIndex struct {
+	collection string
+	model      mongo.IndexModel
+}
+
+// mongoMigration changes the database with operations that have no effect when repeated
+type mongoMigration struct {
+	models.Migration
+	up func(ctx context.Context, d *MongoDatabase) error
+}
+
+// uniqueSequenceIndex keeps sequences unique among documents that have one
+func uniqueSequenceIndex(collection string) mongoIndex {
+	return mongoIndex{collection, mongo.IndexModel{
 		Keys: bson.D{{Key: "sequence", Value: 1}},
 		Options: options.Index().SetUnique(true).
 			SetPartialFilterExpression(bson.D{{Key: "sequence", Value: bson.D{{Key: "$exists", Value: true}, {Key: "$type", Value: "long"}}}}),
-	})
-	if err != nil {
-		return err
-	}
+	}}
+}
 
-	// setup unique index for healthchecks
-	d.logger.Debug("[DB] Setting up indexes for healthchecks")
-	ctx, cancel = context.WithTimeout(context.Background(), time.Duration(Config.MongoDB.TimeoutMillis)*time.Millisecond)
-	defer cancel()
-	_, err = d.db.Collection(models.CollectionHealthChecks).Indexes().CreateOne(ctx, mongo.IndexModel{
-		Keys:    bson.D{{Key: "validator_id", Value: 1}, {Key: "hostname", Value: 1}},
-		Options: options.Index().SetUnique(true),
-	})
-	if err != nil {
-		return err
+func index(collection string, keys ...string) mongoIndex {
+	d := bson.D{}
+	for _, key := range keys {
+		d = append(d, bson.E{Key: key, Value: 1})
 	}
+	return mongoIndex{collection, mongo.IndexModel{Keys: d}}
+}
+
+func uniqueIndex(collection string, keys ...string) mongoIndex {
+	i := index(collection, keys...)
+	i.model.Options = options.Index().SetUnique(true)
+	return i
+}
 
-	d.logger.Info("[DB] Indexes setup")
+// legacyBurnsIndex was created on a key that burns do not have, so it only allowed one burn per transaction
+const legacyBurnsIndex = "transaction_hash_1_d.logger.index_1"
+
+var mongoMigrations = []mongoMigration{
+	{
+		models.Migration{Version: 1, Description: "create indexes for mints, invalid mints, burns and health checks"},
+		func(ctx context.Context, d *MongoDatabase) error {
+			return d.createIndexes(ctx,
+				uniqueIndex(models.CollectionMints, "transaction_hash"),
+				uniqueIndex(models.CollectionInvalidMints, "transaction_hash"),
+				uniqueSequenceIndex(models.CollectionInvalidMints),
+				uniqueIndex(models.CollectionBurns, "transaction_hash", "log_index"),
+				uniqueSequenceIndex(models.CollectionBurns),
+				uniqueIndex(models.CollectionHealthChecks, "validator_id", "hostname"),
+			)
+		},
+	},
+	{
+		models.Migration{Version: 2, Description: "create indexes for checkpoints, discrepancies and audit log"},
+		func(ctx context.Context, d *MongoDatabase) error {
+			return d.createIndexes(ctx,
+				uniqueIndex(models.CollectionCheckpoints, "validator_id", "service", "address"),
+				uniqueIndex(models.CollectionDiscrepancies, "validator_id", "collection", "transaction_hash", "field"),
+				uniqueIndex(models.CollectionAuditLog, "signer", "hash"),
+			)
+		},
+	},
+	{
+		models.Migration{Version: 3, Description: "create indexes for locks"},
+		func(ctx context.Context, d *MongoDatabase) error {
+			ctx, cancel := context.WithTimeout(ctx, d.timeout)
+			defer cancel()
+			return d.locker.CreateIndexes(ctx)
+		},
+	},
+	{
+		models.Migration{Version: 4, Description: "drop the burns index on d.logger.index"},
+		func(ctx context.Context, d *MongoDatabase) error {
+			ctx, cancel := context.WithTimeout(ctx, d.timeout)
+			defer cancel()
+			_, err := d.db.Collection(models.CollectionBurns).Indexes().DropOne(ctx, legacyBurnsIndex)
+			var cmdErr mongo.CommandError
+			if errors.As(err, &cmdErr) && (cmdErr.Code == 26 || cmdErr.Code == 27) {
+				// the collection or index does not exist
+				return nil
+			}
+			return err
+		},
+	},
+	{
+		models.Migration{Version: 5, Description: "create indexes for admin actions"},
+		func(ctx context.Context, d *MongoDatabase) error {
+			return d.createIndexes(ctx, index(models.CollectionAdminActions, "collection", "document_id"))
+		},
+	},
+	{
+		models.Migration{Version: 6, Description: "create indexes for the signing ledger"},
+		func(ctx context.Context, d *MongoDatabase) error {
+			return d.createIndexes(ctx,
+				uniqueIndex(models.CollectionSigningLedger, "signer", "kind", "request_id"),
+				index(models.CollectionSigningLedger, "signer", "kind", "signed_at"),
+			)
+		},
+	},
+	{
+		models.Migration{Version: 7, Description: "create indexes for deposits"},
+		func(ctx context.Context, d *MongoDatabase) error {
+			return d.createIndexes(ctx, uniqueIndex(models.CollectionDeposits, "transaction_hash"))
+		},
+	},
+	{
+		models.Migration{Version: 8, Description: "drop the signing ledger"},
+		func(ctx context.Context, d *MongoDatabase) error {
+			ctx, cancel := context.WithTimeout(ctx, d.timeout)
+			defer cancel()
+			// the ledger is kept by each validator and rebuilt from its audit log
+			return d.db.Collection(models.CollectionSigningLedger).Drop(ctx)
+		},
+	},
+}
 
-	d.logger.Debug("[DB] Setting up locker")
-	d.logger.Debug("[DB] Setting up indexes for locks")
+func (d *MongoDatabase) createIndexes(ctx context.Context, indexes ...mongoIndex) error {
+	for _, index := range indexes {
+		d.logger.Debug("[DB] Setting up index for ", index.collection)
+		ctx, cancel := context.WithTimeout(ctx, d.timeout)
+		_, err := d.db.Collection(index.collection).Indexes().CreateOne(ctx, index.model)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	ctx, cancel = context.WithTimeout(context.Background(), d.timeout)
-	defer cancel()
+// Migrations returns the migrations known to this binary
+func (d *MongoDatabase) Migrations() []models.Migration {
+	migrations := make([]models.Migration, len(mongoMigrations))
+	for i, m := range mongoMigrations {
+		migrations[i] = m.Migration
+	}
+	return migrations
+}
 
-	locker := lock.NewClient(d.db.Collection("locks"))
-	err = locker.CreateIndexes(ctx)
-	if err != nil {
+// AppliedMigrations returns the migrations recorded in the database
+func (d *MongoDatabase) AppliedMigrations(ctx context.Context) ([]models.SchemaMigration, error) {
+	applied := []models.SchemaMigration{}
+	err := d.FindManySorted(ctx, models.CollectionSchemaMigrations, bson.M{}, bson.D{{Key: "version", Value: 1}}, &applied)
+	return applied, err
+}
+
+// ApplyMigration runs a migration and records it, validators migrating at the same time record it once
+func (d *MongoDatabase) ApplyMigration(ctx context.Context, migration models.Migration) error {
+	if err := d.createIndexes(ctx, uniqueIndex(models.CollectionSchemaMigrations, "version")); err != nil {
 		return err
 	}
 
-	d.locker = locker
-
-	d.logger.Info("[DB] Locker setup")
-	return nil
+	for _, m := range mongoMigrations {
+		if m.Version != migration.Version {
+			continue
+		}
+		if err := m.up(ctx, d); err != nil {
+			return err
+		}
+		_, err := d.InsertOne(ctx, models.CollectionSchemaMigrations, models.SchemaMigration{
+			Version:     m.Version,
+			Description: m.Description,
+			AppliedAt:   time.Now(),
+		})
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		return err
+	}
+	return fmt.Errorf("unknown migration %d", migration.Version)
 }
 
 // Disconnect disconnects from the database
@@ -237,8 +379,8 @@ func (d *MongoDatabase) Disconnect() error {
 }
 
 // method for insert single value in a collection
-func (d *MongoDatabase) InsertOne(collection string, data interface{}) (primitive.ObjectID, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+func (d *MongoDatabase) InsertOne(ctx context.Context, collection string, data interface{}) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
 	defer cancel()
 	result, err := d.db.Collection(collection).InsertOne(ctx, data)
 	if err != nil {
@@ -248,16 +390,16 @@ func (d *MongoDatabase) InsertOne(collection string, data interface{}) (primitiv
 }
 
 // method for find single value in a collection
-func (d *MongoDatabase) FindOne(collection string, filter interface{}, result interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+func (d *MongoDatabase) FindOne(ctx context.Context, collection string, filter interface{}, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
 	defer cancel()
 	err := d.db.Collection(collection).FindOne(ctx, filter).Decode(result)
 	return err
 }
 
 // method for find multiple values in a collection
-func (d *MongoDatabase) FindMany(collection string, filter interface{}, result interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+func (d *MongoDatabase) FindMany(ctx context.Context, collection string, filter interface{}, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
 	defer cancel()
 	cursor, err := d.db.Collection(collection).Find(ctx, filter)
 	if err != nil {
@@ -277,8 +419,8 @@ func (d *MongoDatabase) FindMany(collection string, filter interface{}, result i
 }
 
 // method for find and sort multiple values in a collection
-func (d *MongoDatabase) FindManySorted(collection string, filter interface{}, sort interface{}, result interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+func (d *MongoDatabase) FindManySorted(ctx context.Context, collection string, filter interface{}, sort interface{}, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
 	defer cancel()
 
 	opts := options.Find().SetSort(sort)
@@ -299,9 +441,40 @@ func (d *MongoDatabase) FindManySorted(collection string, filter interface{}, so
 	return nil
 }
 
+// method for find, sort and paginate multiple values in a collection
+func (d *MongoDatabase) FindManyPaged(ctx context.Context, collection string, filter interface{}, sort interface{}, skip int64, limit int64, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	opts := options.Find().SetSort(sort).SetSkip(skip).SetLimit(limit)
+	cursor, err := d.db.Collection(collection).Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	err = cursor.All(ctx, result)
+
+	if err != nil {
+		return err
+	}
+
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// method for counting the documents matching a filter in a collection
+func (d *MongoDatabase) CountDocuments(ctx context.Context, collection string, filter interface{}) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.db.Collection(collection).CountDocuments(ctx, filter)
+}
+
 // Aggregate One
-func (d *MongoDatabase) AggregateOne(collection string, pipeline interface{}, result interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+func (d *MongoDatabase) AggregateOne(ctx context.Context, collection string, pipeline interface{}, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
 	defer cancel()
 	cursor, err := d.db.Collection(collection).Aggregate(ctx, pipeline)
 	if err != nil {
@@ -332,8 +505,8 @@ func (d *MongoDatabase) AggregateOne(collection string, pipeline interface{}, re
 }
 
 // Aggregate Many
-func (d *MongoDatabase) AggregateMany(collection string, pipeline interface{}, result interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+func (d *MongoDatabase) AggregateMany(ctx context.Context, collection string, pipeline interface{}, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
 	defer cancel()
 	cursor, err := d.db.Collection(collection).Aggregate(ctx, pipeline)
 	if err != nil {
@@ -354,8 +527,8 @@ func (d *MongoDatabase) AggregateMany(collection string, pipeline interface{}, r
 }
 
 // method for update single value in a collection
-func (d *MongoDatabase) UpdateOne(collection string, filter interface{}, update interface{}) (primitive.ObjectID, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+func (d *MongoDatabase) UpdateOne(ctx context.Context, collection string, filter interface{}, update interface{}) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
 	defer cancel()
 
 	opts := options.FindOneAndUpdate().SetReturnDocument(options.After).SetProjection(bson.M{"_id": 1})
@@ -376,8 +549,8 @@ func (d *MongoDatabase) UpdateOne(collection string, filter interface{}, update
 }
 
 // method for upsert single value in a collection
-func (d *MongoDatabase) UpsertOne(collection string, filter interface{}, update interface{}) (primitive.ObjectID, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+func (d *MongoDatabase) UpsertOne(ctx context.Context, collection string, filter interface{}, update interface{}) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
 	defer cancel()
 
 	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After).SetProjection(bson.M{"_id": 1})
@@ -399,6 +572,12 @@ func (d *MongoDatabase) UpsertOne(collection string, filter interface{}, update
 
 // InitDB creates a new database wrapper
 func InitDB() {
+	if Config.DatabaseBackend == models.DatabasePostgres {
+		DB = initPostgresDB()
+		log.Info("[DB] Database initialized")
+		return
+	}
+
 	db := &MongoDatabase{
 		uri:      Config.MongoDB.URI,
 		database: Config.MongoDB.Database,
@@ -410,10 +589,7 @@ func InitDB() {
 	if err != nil {
 		db.logger.Fatal("[DB] Failed to connect to database: ", err)
 	}
-	err = db.SetupIndexesAndLocker()
-	if err != nil {
-		db.logger.Fatal("[DB] Failed to setup indexes and locker: ", err)
-	}
+	db.locker = lock.NewClient(db.db.Collection("locks"))
 
 	db.logger.Info("[DB] Database initialized")
 
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// RecordDiscrepancy stores a mismatch found in verification mode, repeated findings only bump its count
+func RecordDiscrepancy(ctx context.Context, discrepancy models.Discrepancy) error {
+	log.Warnf("[VERIFICATION] %s found %s of %s %s does not match chain data",
+		discrepancy.Service, discrepancy.Field, discrepancy.Collection, discrepancy.TransactionHash)
+
+	now := time.Now()
+	filter := bson.M{
+		"validator_id":     discrepancy.ValidatorId,
+		"collection":       discrepancy.Collection,
+		"transaction_hash": discrepancy.TransactionHash,
+		"field":            discrepancy.Field,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"service":    discrepancy.Service,
+			"expected":   discrepancy.Expected,
+			"stored":     discrepancy.Stored,
+			"updated_at": now,
+		},
+		"$inc":         bson.M{"count": 1},
+		"$setOnInsert": bson.M{"created_at": now},
+	}
+	_, err := DB.UpsertOne(ctx, models.CollectionDiscrepancies, filter, update)
+	return err
+}
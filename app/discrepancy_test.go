@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRecordDiscrepancy(t *testing.T) {
+	discrepancy := models.Discrepancy{
+		ValidatorId:     "validatorId",
+		Service:         "service",
+		Collection:      models.CollectionMints,
+		TransactionHash: "hash",
+		Field:           "data",
+		Expected:        "expected",
+		Stored:          "stored",
+	}
+
+	t.Run("No Error", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		filter := bson.M{"validator_id": "validatorId", "collection": models.CollectionMints, "transaction_hash": "hash", "field": "data"}
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionDiscrepancies, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, "expected", set["expected"])
+				assert.Equal(t, "stored", set["stored"])
+				assert.Equal(t, bson.M{"count": 1}, update.(bson.M)["$inc"])
+			}).Return(primitive.NewObjectID(), nil)
+
+		err := RecordDiscrepancy(context.Background(), discrepancy)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("With Error", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionDiscrepancies, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
+
+		err := RecordDiscrepancy(context.Background(), discrepancy)
+
+		assert.NotNil(t, err)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/dan13ram/wpokt-validator/models"
 	"github.com/joho/godotenv"
 	log "github.com/sirupsen/logrus"
 )
@@ -23,6 +24,9 @@ func readConfigFromENV(envFile string) {
 
 	log.Debug("[ENV] Reading config from ENV variables")
 
+	if os.Getenv("DATABASE_BACKEND") != "" {
+		Config.DatabaseBackend = os.Getenv("DATABASE_BACKEND")
+	}
 	if os.Getenv("MONGODB_URI") != "" {
 		Config.MongoDB.URI = os.Getenv("MONGODB_URI")
 	}
@@ -37,17 +41,52 @@ func readConfigFromENV(envFile string) {
 			Config.MongoDB.TimeoutMillis = timeoutMillis
 		}
 	}
+	if os.Getenv("POSTGRES_URI") != "" {
+		Config.Postgres.URI = os.Getenv("POSTGRES_URI")
+	}
+	if os.Getenv("POSTGRES_TIMEOUT_MS") != "" {
+		timeoutMillis, err := strconv.ParseInt(os.Getenv("POSTGRES_TIMEOUT_MS"), 10, 64)
+		if err != nil {
+			log.Warn("[ENV] Error parsing POSTGRES_TIMEOUT_MS: ", err.Error())
+		} else {
+			Config.Postgres.TimeoutMillis = timeoutMillis
+		}
+	}
 
 	// ethereum
 	if os.Getenv("ETH_RPC_URL") != "" {
 		Config.Ethereum.RPCURL = os.Getenv("ETH_RPC_URL")
 	}
+	if os.Getenv("ETH_RPC_URLS") != "" {
+		Config.Ethereum.RPCEndpoints = nil
+		for _, rpcURL := range strings.Split(os.Getenv("ETH_RPC_URLS"), ",") {
+			Config.Ethereum.RPCEndpoints = append(Config.Ethereum.RPCEndpoints, models.EthereumEndpointConfig{URL: rpcURL})
+		}
+	}
+	if os.Getenv("ETH_WS_URL") != "" {
+		Config.Ethereum.WSURL = os.Getenv("ETH_WS_URL")
+	}
+	if os.Getenv("ETH_WS_URLS") != "" {
+		Config.Ethereum.WSURLs = strings.Split(os.Getenv("ETH_WS_URLS"), ",")
+	}
 	if os.Getenv("ETH_CHAIN_ID") != "" {
 		Config.Ethereum.ChainID = os.Getenv("ETH_CHAIN_ID")
 	}
 	if os.Getenv("ETH_PRIVATE_KEY") != "" {
 		Config.Ethereum.PrivateKey = os.Getenv("ETH_PRIVATE_KEY")
 	}
+	if os.Getenv("ETH_MNEMONIC") != "" {
+		Config.Ethereum.Mnemonic = os.Getenv("ETH_MNEMONIC")
+	}
+	if os.Getenv("ETH_GCP_KMS_KEY_NAME") != "" {
+		Config.Ethereum.GcpKmsKeyName = os.Getenv("ETH_GCP_KMS_KEY_NAME")
+	}
+	if os.Getenv("ETH_REMOTE_SIGNER_SOCKET") != "" {
+		Config.Ethereum.RemoteSignerSocket = os.Getenv("ETH_REMOTE_SIGNER_SOCKET")
+	}
+	if os.Getenv("ETH_REMOTE_SIGNER_TOKEN") != "" {
+		Config.Ethereum.RemoteSignerToken = os.Getenv("ETH_REMOTE_SIGNER_TOKEN")
+	}
 	if os.Getenv("ETH_START_BLOCK_NUMBER") != "" {
 		blockNumber, err := strconv.ParseInt(os.Getenv("ETH_START_BLOCK_NUMBER"), 10, 64)
 		if err != nil {
@@ -72,6 +111,22 @@ func readConfigFromENV(envFile string) {
 			Config.Ethereum.RPCTimeoutMillis = timeoutMillis
 		}
 	}
+	if os.Getenv("ETH_RPC_RATE_LIMIT") != "" {
+		rateLimit, err := strconv.ParseFloat(os.Getenv("ETH_RPC_RATE_LIMIT"), 64)
+		if err != nil {
+			log.Warn("[ENV] Error parsing ETH_RPC_RATE_LIMIT: ", err.Error())
+		} else {
+			Config.Ethereum.RPCRateLimit = rateLimit
+		}
+	}
+	if os.Getenv("ETH_QUORUM") != "" {
+		quorum, err := strconv.ParseInt(os.Getenv("ETH_QUORUM"), 10, 64)
+		if err != nil {
+			log.Warn("[ENV] Error parsing ETH_QUORUM: ", err.Error())
+		} else {
+			Config.Ethereum.Quorum = quorum
+		}
+	}
 	if os.Getenv("ETH_WRAPPED_POCKET_ADDRESS") != "" {
 		Config.Ethereum.WrappedPocketAddress = os.Getenv("ETH_WRAPPED_POCKET_ADDRESS")
 	}
@@ -86,6 +141,9 @@ func readConfigFromENV(envFile string) {
 	if os.Getenv("POKT_RPC_URL") != "" {
 		Config.Pocket.RPCURL = os.Getenv("POKT_RPC_URL")
 	}
+	if os.Getenv("POKT_RPC_URLS") != "" {
+		Config.Pocket.RPCURLs = strings.Split(os.Getenv("POKT_RPC_URLS"), ",")
+	}
 	if os.Getenv("POKT_GRPC_ENABLED") != "" {
 		enabled, err := strconv.ParseBool(os.Getenv("POKT_GRPC_ENABLED"))
 		if err != nil {
@@ -105,6 +163,17 @@ func readConfigFromENV(envFile string) {
 			Config.Pocket.GRPCPort = port
 		}
 	}
+	if os.Getenv("POKT_GRPC_ENDPOINTS") != "" {
+		Config.Pocket.GRPCEndpoints = strings.Split(os.Getenv("POKT_GRPC_ENDPOINTS"), ",")
+	}
+	if os.Getenv("POKT_QUORUM") != "" {
+		quorum, err := strconv.ParseInt(os.Getenv("POKT_QUORUM"), 10, 64)
+		if err != nil {
+			log.Warn("[ENV] Error parsing POKT_QUORUM: ", err.Error())
+		} else {
+			Config.Pocket.Quorum = quorum
+		}
+	}
 
 	if os.Getenv("POKT_CHAIN_ID") != "" {
 		Config.Pocket.ChainID = os.Getenv("POKT_CHAIN_ID")
@@ -115,6 +184,12 @@ func readConfigFromENV(envFile string) {
 	if os.Getenv("POKT_GCP_KMS_KEY_NAME") != "" {
 		Config.Pocket.GcpKmsKeyName = os.Getenv("POKT_GCP_KMS_KEY_NAME")
 	}
+	if os.Getenv("POKT_REMOTE_SIGNER_SOCKET") != "" {
+		Config.Pocket.RemoteSignerSocket = os.Getenv("POKT_REMOTE_SIGNER_SOCKET")
+	}
+	if os.Getenv("POKT_REMOTE_SIGNER_TOKEN") != "" {
+		Config.Pocket.RemoteSignerToken = os.Getenv("POKT_REMOTE_SIGNER_TOKEN")
+	}
 	if os.Getenv("POKT_START_HEIGHT") != "" {
 		startHeight, err := strconv.ParseInt(os.Getenv("POKT_START_HEIGHT"), 10, 64)
 		if err != nil {
@@ -172,6 +247,25 @@ func readConfigFromENV(envFile string) {
 			Config.Pocket.MintDisabled = disabled
 		}
 	}
+	if os.Getenv("POKT_SCAN_MODE") != "" {
+		Config.Pocket.ScanMode = os.Getenv("POKT_SCAN_MODE")
+	}
+	if os.Getenv("POKT_SCAN_MAX_BLOCKS") != "" {
+		maxBlocks, err := strconv.ParseInt(os.Getenv("POKT_SCAN_MAX_BLOCKS"), 10, 64)
+		if err != nil {
+			log.Warn("[ENV] Error parsing POKT_SCAN_MAX_BLOCKS: ", err.Error())
+		} else {
+			Config.Pocket.ScanMaxBlocks = maxBlocks
+		}
+	}
+	if os.Getenv("POKT_SUBSCRIBE") != "" {
+		subscribe, err := strconv.ParseBool(os.Getenv("POKT_SUBSCRIBE"))
+		if err != nil {
+			log.Warn("[ENV] Error parsing POKT_SUBSCRIBE: ", err.Error())
+		} else {
+			Config.Pocket.Subscribe = subscribe
+		}
+	}
 	if os.Getenv("POKT_MULTISIG_PUBLIC_KEYS") != "" {
 		multisigPublicKeys := os.Getenv("POKT_MULTISIG_PUBLIC_KEYS")
 		Config.Pocket.MultisigPublicKeys = strings.Split(multisigPublicKeys, ",")
@@ -302,6 +396,132 @@ func readConfigFromENV(envFile string) {
 			Config.HealthCheck.ReadLastHealth = readLastHealth
 		}
 	}
+	if os.Getenv("HEALTH_CHECK_DEGRADED_AFTER_FAILURES") != "" {
+		degradedAfterFailures, err := strconv.ParseInt(os.Getenv("HEALTH_CHECK_DEGRADED_AFTER_FAILURES"), 10, 64)
+		if err != nil {
+			log.Warn("[ENV] Error parsing HEALTH_CHECK_DEGRADED_AFTER_FAILURES: ", err.Error())
+		} else {
+			Config.HealthCheck.DegradedAfterFailures = degradedAfterFailures
+		}
+	}
+	if os.Getenv("HEALTH_CHECK_UNHEALTHY_AFTER_FAILURES") != "" {
+		unhealthyAfterFailures, err := strconv.ParseInt(os.Getenv("HEALTH_CHECK_UNHEALTHY_AFTER_FAILURES"), 10, 64)
+		if err != nil {
+			log.Warn("[ENV] Error parsing HEALTH_CHECK_UNHEALTHY_AFTER_FAILURES: ", err.Error())
+		} else {
+			Config.HealthCheck.UnhealthyAfterFailures = unhealthyAfterFailures
+		}
+	}
+
+	// http server
+	if os.Getenv("HTTP_SERVER_ENABLED") != "" {
+		enabled, err := strconv.ParseBool(os.Getenv("HTTP_SERVER_ENABLED"))
+		if err != nil {
+			log.Warn("[ENV] Error parsing HTTP_SERVER_ENABLED: ", err.Error())
+		} else {
+			Config.HTTPServer.Enabled = enabled
+		}
+	}
+	if os.Getenv("HTTP_SERVER_LISTEN_ADDRESS") != "" {
+		Config.HTTPServer.ListenAddress = os.Getenv("HTTP_SERVER_LISTEN_ADDRESS")
+	}
+
+	// signing policy
+	if os.Getenv("SIGNING_POLICY_PAUSED") != "" {
+		paused, err := strconv.ParseBool(os.Getenv("SIGNING_POLICY_PAUSED"))
+		if err != nil {
+			log.Warn("[ENV] Error parsing SIGNING_POLICY_PAUSED: ", err.Error())
+		} else {
+			Config.SigningPolicy.Paused = paused
+		}
+	}
+	if os.Getenv("SIGNING_POLICY_PAUSE_FILE") != "" {
+		Config.SigningPolicy.PauseFile = os.Getenv("SIGNING_POLICY_PAUSE_FILE")
+	}
+	readSigningLimitsFromENV("SIGNING_POLICY_MINT", &Config.SigningPolicy.Mint)
+	readSigningLimitsFromENV("SIGNING_POLICY_REFUND", &Config.SigningPolicy.Refund)
+
+	// verification
+	if os.Getenv("VERIFICATION_ENABLED") != "" {
+		enabled, err := strconv.ParseBool(os.Getenv("VERIFICATION_ENABLED"))
+		if err != nil {
+			log.Warn("[ENV] Error parsing VERIFICATION_ENABLED: ", err.Error())
+		} else {
+			Config.Verification.Enabled = enabled
+		}
+	}
+
+	// gossip
+	if os.Getenv("GOSSIP_ENABLED") != "" {
+		enabled, err := strconv.ParseBool(os.Getenv("GOSSIP_ENABLED"))
+		if err != nil {
+			log.Warn("[ENV] Error parsing GOSSIP_ENABLED: ", err.Error())
+		} else {
+			Config.Gossip.Enabled = enabled
+		}
+	}
+	if os.Getenv("GOSSIP_LISTEN_ADDRESS") != "" {
+		Config.Gossip.ListenAddress = os.Getenv("GOSSIP_LISTEN_ADDRESS")
+	}
+	if os.Getenv("GOSSIP_TLS_CERT_FILE") != "" {
+		Config.Gossip.TLSCertFile = os.Getenv("GOSSIP_TLS_CERT_FILE")
+	}
+	if os.Getenv("GOSSIP_TLS_KEY_FILE") != "" {
+		Config.Gossip.TLSKeyFile = os.Getenv("GOSSIP_TLS_KEY_FILE")
+	}
+	if os.Getenv("GOSSIP_TLS_CA_FILE") != "" {
+		Config.Gossip.TLSCAFile = os.Getenv("GOSSIP_TLS_CA_FILE")
+	}
+	if os.Getenv("GOSSIP_PEERS") != "" {
+		Config.Gossip.Peers = strings.Split(os.Getenv("GOSSIP_PEERS"), ",")
+	}
+	if os.Getenv("GOSSIP_TIMEOUT_MS") != "" {
+		timeoutMs, err := strconv.ParseInt(os.Getenv("GOSSIP_TIMEOUT_MS"), 10, 64)
+		if err != nil {
+			log.Warn("[ENV] Error parsing GOSSIP_TIMEOUT_MS: ", err.Error())
+		} else {
+			Config.Gossip.TimeoutMillis = timeoutMs
+		}
+	}
+
+	// audit log
+	if os.Getenv("AUDIT_LOG_FILE") != "" {
+		Config.AuditLog.File = os.Getenv("AUDIT_LOG_FILE")
+	}
+	if os.Getenv("AUDIT_LOG_MONGO") != "" {
+		mongo, err := strconv.ParseBool(os.Getenv("AUDIT_LOG_MONGO"))
+		if err != nil {
+			log.Warn("[ENV] Error parsing AUDIT_LOG_MONGO: ", err.Error())
+		} else {
+			Config.AuditLog.Mongo = mongo
+		}
+	}
+
+	// retry
+	if os.Getenv("RETRY_MAX_ATTEMPTS") != "" {
+		maxAttempts, err := strconv.ParseInt(os.Getenv("RETRY_MAX_ATTEMPTS"), 10, 64)
+		if err != nil {
+			log.Warn("[ENV] Error parsing RETRY_MAX_ATTEMPTS: ", err.Error())
+		} else {
+			Config.Retry.MaxAttempts = maxAttempts
+		}
+	}
+	if os.Getenv("RETRY_BACKOFF_MS") != "" {
+		backoffMs, err := strconv.ParseInt(os.Getenv("RETRY_BACKOFF_MS"), 10, 64)
+		if err != nil {
+			log.Warn("[ENV] Error parsing RETRY_BACKOFF_MS: ", err.Error())
+		} else {
+			Config.Retry.BackoffMillis = backoffMs
+		}
+	}
+	if os.Getenv("RETRY_MAX_BACKOFF_MS") != "" {
+		maxBackoffMs, err := strconv.ParseInt(os.Getenv("RETRY_MAX_BACKOFF_MS"), 10, 64)
+		if err != nil {
+			log.Warn("[ENV] Error parsing RETRY_MAX_BACKOFF_MS: ", err.Error())
+		} else {
+			Config.Retry.MaxBackoffMillis = maxBackoffMs
+		}
+	}
 
 	// logging
 	if os.Getenv("LOG_LEVEL") != "" {
@@ -329,3 +549,35 @@ func readConfigFromENV(envFile string) {
 
 	log.Debug("[ENV] Config read from env variables")
 }
+
+func readSigningLimitsFromENV(prefix string, limits *models.SigningLimitsConfig) {
+	amounts := map[string]*int64{
+		"_MAX_AMOUNT":                    &limits.MaxAmount,
+		"_MAX_AMOUNT_PER_HOUR":           &limits.MaxAmountPerHour,
+		"_MAX_AMOUNT_PER_DAY":            &limits.MaxAmountPerDay,
+		"_MAX_RECIPIENT_AMOUNT_PER_HOUR": &limits.MaxRecipientAmountPerHour,
+		"_MAX_RECIPIENT_AMOUNT_PER_DAY":  &limits.MaxRecipientAmountPerDay,
+	}
+	for suffix, value := range amounts {
+		if os.Getenv(prefix+suffix) != "" {
+			amount, err := strconv.ParseInt(os.Getenv(prefix+suffix), 10, 64)
+			if err != nil {
+				log.Warn("[ENV] Error parsing ", prefix+suffix, ": ", err.Error())
+			} else {
+				*value = amount
+			}
+		}
+	}
+
+	lists := map[string]*[]string{
+		"_ALLOWED_RECIPIENTS": &limits.AllowedRecipients,
+		"_DENIED_RECIPIENTS":  &limits.DeniedRecipients,
+		"_ALLOWED_SENDERS":    &limits.AllowedSenders,
+		"_DENIED_SENDERS":     &limits.DeniedSenders,
+	}
+	for suffix, value := range lists {
+		if os.Getenv(prefix+suffix) != "" {
+			*value = strings.Split(os.Getenv(prefix+suffix), ",")
+		}
+	}
+}
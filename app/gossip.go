@@ -0,0 +1,231 @@
+package app
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	GossipServerName = "GOSSIP SERVER"
+
+	GossipPathMints        = "/gossip/mints"
+	GossipPathInvalidMints = "/gossip/invalid-mints"
+	GossipPathBurns        = "/gossip/burns"
+
+	gossipPageLimit int64 = 500
+	gossipMaxPages        = 100
+)
+
+// GossipServer serves the signatures of this validator to its peers in the per-validator database mode.
+// Peers are authenticated by their client certificates, which must be issued by the gossip CA.
+type GossipServer struct {
+	wg        *sync.WaitGroup
+	listener  net.Listener
+	server    *http.Server
+	startedAt time.Time
+	serving   atomic.Bool
+	servedAt  atomic.Int64
+}
+
+func (x *GossipServer) Start(ctx context.Context) {
+	x.server.BaseContext = func(net.Listener) context.Context { return ctx }
+
+	log.Infof("[%s] Listening on %s", GossipServerName, x.listener.Addr())
+	x.serving.Store(true)
+	err := x.server.Serve(x.listener)
+	x.serving.Store(false)
+	if err != nil && err != http.ErrServerClosed {
+		log.Error("[GOSSIP SERVER] Error serving https: ", err)
+	}
+	log.Infof("[%s] Service stopped", GossipServerName)
+	x.wg.Done()
+}
+
+func (x *GossipServer) Stop() {
+	log.Debugf("[%s] Stopping", GossipServerName)
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := x.server.Shutdown(ctx); err != nil {
+		log.Error("[GOSSIP SERVER] Error shutting down https server: ", err)
+	}
+}
+
+// Health reports whether the server is still serving, and when a peer last fetched documents from it
+func (x *GossipServer) Health() models.ServiceHealth {
+	lastSyncTime := x.startedAt
+	if servedAt := x.servedAt.Load(); servedAt > 0 {
+		lastSyncTime = time.UnixMilli(servedAt)
+	}
+	return models.ServiceHealth{
+		Name:         GossipServerName,
+		Healthy:      x.serving.Load(),
+		LastSyncTime: lastSyncTime,
+		NextSyncTime: lastSyncTime,
+	}
+}
+
+func (x *GossipServer) Status() models.RunnerStatus {
+	return models.RunnerStatus{}
+}
+
+func (x *GossipServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+GossipPathMints, x.handleSigned(models.CollectionMints, "signers", func() interface{} { return &[]models.Mint{} }))
+	mux.HandleFunc("GET "+GossipPathInvalidMints, x.handleSigned(models.CollectionInvalidMints, "signatures", func() interface{} { return &[]models.InvalidMint{} }))
+	mux.HandleFunc("GET "+GossipPathBurns, x.handleSigned(models.CollectionBurns, "signatures", func() interface{} { return &[]models.Burn{} }))
+	return mux
+}
+
+// handleSigned lists a page of the documents that are still being signed and carry signatures,
+// in the order they were created so pages do not shift while documents are updated
+func (x *GossipServer) handleSigned(collection string, signaturesField string, newResult func() interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page := int64(1)
+		if value := r.URL.Query().Get("page"); value != "" {
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || parsed < 1 {
+				writeError(w, http.StatusBadRequest, "page must be a positive integer")
+				return
+			}
+			page = parsed
+		}
+
+		filter := bson.M{
+			"status":               bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusSigned}},
+			signaturesField + ".0": bson.M{"$exists": true},
+		}
+
+		result := newResult()
+		sort := bson.D{{Key: "_id", Value: 1}}
+		err := DB.FindManyPaged(r.Context(), collection, filter, sort, (page-1)*gossipPageLimit, gossipPageLimit, result)
+		if err != nil {
+			log.Error("[GOSSIP SERVER] Error listing ", collection, ": ", err)
+			writeError(w, http.StatusInternalServerError, "error listing documents")
+			return
+		}
+
+		x.servedAt.Store(time.Now().UnixMilli())
+		writeJSON(w, http.StatusOK, result)
+	}
+}
+
+// gossipTLSConfig authenticates both ends of a gossip connection with certificates issued by the gossip CA
+func gossipTLSConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(Config.Gossip.TLSCertFile, Config.Gossip.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error loading certificate: %w", err)
+	}
+	ca, err := os.ReadFile(Config.Gossip.TLSCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading ca file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificates found in ca file %s", Config.Gossip.TLSCAFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// FetchPeerDocuments fetches every page of the signed documents a peer serves at path
+func FetchPeerDocuments[T any](ctx context.Context, peer string, path string) ([]T, error) {
+	tlsConfig, err := gossipTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+	defer client.CloseIdleConnections()
+
+	documents := []T{}
+	for page := 1; page <= gossipMaxPages; page++ {
+		var result []T
+		if err := fetchPeerPage(ctx, client, peer, fmt.Sprintf("%s?page=%d", path, page), &result); err != nil {
+			return nil, err
+		}
+		documents = append(documents, result...)
+		if int64(len(result)) < gossipPageLimit {
+			return documents, nil
+		}
+	}
+	log.Warnf("[GOSSIP] Peer %s serves more than %d pages at %s, the rest is fetched on the next run", peer, gossipMaxPages, path)
+	return documents, nil
+}
+
+func fetchPeerPage(ctx context.Context, client *http.Client, peer string, path string, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(Config.Gossip.TimeoutMillis)*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(peer, "/")+path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error fetching from peer %s: %w", peer, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("peer %s responded with status %d", peer, res.StatusCode)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+		return fmt.Errorf("error decoding response from peer %s: %w", peer, err)
+	}
+	return nil
+}
+
+func NewGossipServer(wg *sync.WaitGroup) Service {
+	if !Config.Gossip.Enabled {
+		log.Debug("[GOSSIP SERVER] Disabled")
+		return NewEmptyService(wg)
+	}
+
+	log.Debug("[GOSSIP SERVER] Initializing")
+
+	tlsConfig, err := gossipTLSConfig()
+	if err != nil {
+		log.Fatal("[GOSSIP SERVER] Error loading tls config: ", err)
+	}
+
+	listener, err := net.Listen("tcp", Config.Gossip.ListenAddress)
+	if err != nil {
+		log.Fatal("[GOSSIP SERVER] Error listening on address: ", err)
+	}
+
+	x := &GossipServer{
+		wg:        wg,
+		listener:  tls.NewListener(listener, tlsConfig),
+		startedAt: time.Now(),
+	}
+
+	x.server = &http.Server{
+		Handler:           x.Handler(),
+		ReadHeaderTimeout: shutdownTimeout,
+	}
+
+	log.Info("[GOSSIP SERVER] Initialized")
+
+	return x
+}
@@ -0,0 +1,224 @@
+package app
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// writeGossipCertificates writes a CA and a certificate issued by it for 127.0.0.1, used as both server and client certificate
+func writeGossipCertificates(t *testing.T) {
+	dir := t.TempDir()
+
+	writePEM := func(name string, block *pem.Block) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(path, pem.EncodeToMemory(block), 0600))
+		return path
+	}
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "gossip ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	assert.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "validator"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	Config.Gossip.TLSCAFile = writePEM("ca.pem", &pem.Block{Type: "CERTIFICATE", Bytes: caDER})
+	Config.Gossip.TLSCertFile = writePEM("cert.pem", &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	Config.Gossip.TLSKeyFile = writePEM("key.pem", &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func setGossipConfig(t *testing.T) {
+	oldConfig := Config.Gossip
+	t.Cleanup(func() { Config.Gossip = oldConfig })
+	Config.Gossip = models.GossipConfig{Enabled: true, TimeoutMillis: 1000}
+	writeGossipCertificates(t)
+}
+
+func doGossipRequest(handler http.Handler, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestGossipServerHandler(t *testing.T) {
+	setGossipConfig(t)
+	x := &GossipServer{wg: &sync.WaitGroup{}}
+
+	filter := bson.M{
+		"status":    bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusSigned}},
+		"signers.0": bson.M{"$exists": true},
+	}
+	sort := bson.D{{Key: "_id", Value: 1}}
+
+	t.Run("Mints", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().FindManyPaged(mock.Anything, models.CollectionMints, filter, sort, int64(0), int64(500), mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, _ interface{}, _ int64, _ int64, result interface{}) {
+				*result.(*[]models.Mint) = []models.Mint{{TransactionHash: "hash", Signers: []string{"signer"}}}
+			}).Return(nil)
+
+		rec := doGossipRequest(x.Handler(), GossipPathMints)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var mints []models.Mint
+		assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &mints))
+		assert.Equal(t, "hash", mints[0].TransactionHash)
+		assert.NotEqual(t, int64(0), x.servedAt.Load())
+	})
+
+	t.Run("Second page", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().FindManyPaged(mock.Anything, models.CollectionMints, filter, sort, int64(500), int64(500), mock.Anything).Return(nil)
+
+		rec := doGossipRequest(x.Handler(), GossipPathMints+"?page=2")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Invalid page", func(t *testing.T) {
+		rec := doGossipRequest(x.Handler(), GossipPathMints+"?page=0")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Burns with error", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().FindManyPaged(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything, int64(0), int64(500), mock.Anything).Return(errors.New("error"))
+
+		rec := doGossipRequest(x.Handler(), GossipPathBurns)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestFetchPeerDocuments(t *testing.T) {
+	setGossipConfig(t)
+
+	mockDB := mocks.NewMockDatabase(t)
+	DB = mockDB
+	mockDB.EXPECT().FindManyPaged(mock.Anything, models.CollectionInvalidMints, mock.Anything, mock.Anything, int64(0), int64(500), mock.Anything).
+		Run(func(_ context.Context, _ string, _ interface{}, _ interface{}, _ int64, _ int64, result interface{}) {
+			*result.(*[]models.InvalidMint) = make([]models.InvalidMint, 500)
+			(*result.(*[]models.InvalidMint))[0].TransactionHash = "hash"
+		}).Return(nil)
+	mockDB.EXPECT().FindManyPaged(mock.Anything, models.CollectionInvalidMints, mock.Anything, mock.Anything, int64(500), int64(500), mock.Anything).
+		Run(func(_ context.Context, _ string, _ interface{}, _ interface{}, _ int64, _ int64, result interface{}) {
+			*result.(*[]models.InvalidMint) = []models.InvalidMint{{TransactionHash: "last"}}
+		}).Return(nil)
+
+	tlsConfig, err := gossipTLSConfig()
+	assert.NoError(t, err)
+	peer := httptest.NewUnstartedServer((&GossipServer{}).Handler())
+	peer.TLS = tlsConfig
+	peer.StartTLS()
+	defer peer.Close()
+
+	t.Run("No Error", func(t *testing.T) {
+		invalidMints, err := FetchPeerDocuments[models.InvalidMint](context.Background(), peer.URL+"/", GossipPathInvalidMints)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 501, len(invalidMints))
+		assert.Equal(t, "hash", invalidMints[0].TransactionHash)
+		assert.Equal(t, "last", invalidMints[500].TransactionHash)
+	})
+
+	t.Run("Client without certificate", func(t *testing.T) {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: tlsConfig.RootCAs}}}
+
+		_, err := client.Get(peer.URL + GossipPathInvalidMints)
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Peer error", func(t *testing.T) {
+		failingPeer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writeError(w, http.StatusInternalServerError, "error listing documents")
+		}))
+		failingPeer.TLS = tlsConfig
+		failingPeer.StartTLS()
+		defer failingPeer.Close()
+
+		_, err := FetchPeerDocuments[models.InvalidMint](context.Background(), failingPeer.URL, GossipPathInvalidMints)
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestNewGossipServer(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		Config.Gossip.Enabled = false
+
+		service := NewGossipServer(&sync.WaitGroup{})
+
+		assert.Equal(t, EmptyServiceName, service.Health().Name)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		setGossipConfig(t)
+		Config.Gossip.ListenAddress = "127.0.0.1:0"
+
+		wg := &sync.WaitGroup{}
+		service := NewGossipServer(wg)
+		assert.Equal(t, GossipServerName, service.Health().Name)
+		assert.False(t, service.Health().Healthy)
+
+		wg.Add(1)
+		go service.Start(context.Background())
+		service.Stop()
+		wg.Wait()
+		assert.False(t, service.Health().Healthy)
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	"github.com/dan13ram/wpokt-validator/models"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -37,7 +38,7 @@ func readKeysFromGSM() {
 	//nolint:errcheck
 	defer client.Close()
 
-	if Config.MongoDB.URI == "" && Config.GoogleSecretManager.MongoSecretName == "" {
+	if Config.DatabaseBackend != models.DatabasePostgres && Config.MongoDB.URI == "" && Config.GoogleSecretManager.MongoSecretName == "" {
 		log.Fatalf("[GSM] Mongo secret name is empty")
 	}
 
@@ -50,7 +51,11 @@ func readKeysFromGSM() {
 		log.Info("[GSM] Successfully read mongo uri")
 	}
 
-	if Config.Ethereum.PrivateKey == "" && Config.GoogleSecretManager.EthSecretName == "" {
+	if Config.Ethereum.PrivateKey == "" &&
+		Config.Ethereum.Mnemonic == "" &&
+		Config.Ethereum.RemoteSignerSocket == "" &&
+		Config.Ethereum.GcpKmsKeyName == "" &&
+		Config.GoogleSecretManager.EthSecretName == "" {
 		log.Fatalf("[GSM] Ethereum secret name is empty")
 	}
 
@@ -65,6 +70,7 @@ func readKeysFromGSM() {
 	}
 
 	if Config.Pocket.Mnemonic == "" &&
+		Config.Pocket.RemoteSignerSocket == "" &&
 		Config.Pocket.GcpKmsKeyName == "" &&
 		Config.GoogleSecretManager.PoktSecretName == "" {
 		log.Fatalf("[GSM] Pocket secret name is empty")
@@ -1,7 +1,8 @@
 package app
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"os"
 	"strings"
 	"sync"
@@ -9,6 +10,7 @@ import (
 	"github.com/dan13ram/wpokt-validator/models"
 	log "github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 	"time"
 
 	"encoding/hex"
@@ -29,23 +31,36 @@ type HealthCheckRunner struct {
 	hostname         string
 	validatorId      string
 	services         []Service
+
+	needsAttentionMu sync.RWMutex
+	needsAttention   map[string]int64
 }
 
 func (x *HealthCheckRunner) Status() models.RunnerStatus {
 	return models.RunnerStatus{}
 }
 
-func (x *HealthCheckRunner) Run() {
-	x.PostHealth()
+func (x *HealthCheckRunner) Run(ctx context.Context) error {
+	var err error
+	if !x.CountNeedsAttention(ctx) {
+		err = errors.New("failed to count documents that need attention")
+	}
+	if !x.PostHealth(ctx) {
+		err = errors.Join(err, errors.New("failed to post health"))
+	}
+	if Config.HTTPServer.Enabled && !x.UpdateDocumentMetrics(ctx) {
+		err = errors.Join(err, errors.New("failed to update document metrics"))
+	}
+	return err
 }
 
-func (x *HealthCheckRunner) FindLastHealth() (models.Health, error) {
+func (x *HealthCheckRunner) FindLastHealth(ctx context.Context) (models.Health, error) {
 	var health models.Health
 	filter := bson.M{
 		"validator_id": x.validatorId,
 		"hostname":     x.hostname,
 	}
-	err := DB.FindOne(models.CollectionHealthChecks, filter, &health)
+	err := DB.FindOne(ctx, models.CollectionHealthChecks, filter, &health)
 	return health, err
 }
 
@@ -61,7 +76,67 @@ func (x *HealthCheckRunner) ServiceHealths() []models.ServiceHealth {
 	return serviceHealths
 }
 
-func (x *HealthCheckRunner) PostHealth() bool {
+// Healthy reports whether none of the services is unhealthy
+func (x *HealthCheckRunner) Healthy(serviceHealths []models.ServiceHealth) bool {
+	for _, serviceHealth := range serviceHealths {
+		if !serviceHealth.Healthy {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *HealthCheckRunner) CurrentHealth() models.Health {
+	serviceHealths := x.ServiceHealths()
+	return models.Health{
+		PoktVaultAddress: x.poktVaultAddress,
+		PoktSigners:      x.poktSigners,
+		PoktPublicKey:    x.poktPublicKey,
+		PoktAddress:      x.poktAddress,
+		EthValidators:    x.ethValidators,
+		EthAddress:       x.ethAddress,
+		WPoktAddress:     x.wpoktAddress,
+		Hostname:         x.hostname,
+		ValidatorId:      x.validatorId,
+		Healthy:          x.Healthy(serviceHealths),
+		UpdatedAt:        time.Now(),
+		ServiceHealths:   serviceHealths,
+		MintDisabled:     Config.Pocket.MintDisabled,
+		NeedsAttention:   x.NeedsAttention(),
+	}
+}
+
+// CountNeedsAttention counts the documents of each collection that failed too many times to be retried
+func (x *HealthCheckRunner) CountNeedsAttention(ctx context.Context) bool {
+	log.Debug("[HEALTH] Counting documents that need attention")
+
+	counts := make(map[string]int64)
+	filter := bson.M{"status": models.StatusNeedsAttention}
+	for _, collection := range []string{models.CollectionMints, models.CollectionInvalidMints, models.CollectionBurns} {
+		count, err := DB.CountDocuments(ctx, collection, filter)
+		if err != nil {
+			log.Error("[HEALTH] Error counting documents that need attention in ", collection, ": ", err)
+			return false
+		}
+		if count > 0 {
+			log.Warn("[HEALTH] Documents in ", collection, " need attention: ", count)
+		}
+		counts[collection] = count
+	}
+
+	x.needsAttentionMu.Lock()
+	defer x.needsAttentionMu.Unlock()
+	x.needsAttention = counts
+	return true
+}
+
+func (x *HealthCheckRunner) NeedsAttention() map[string]int64 {
+	x.needsAttentionMu.RLock()
+	defer x.needsAttentionMu.RUnlock()
+	return x.needsAttention
+}
+
+func (x *HealthCheckRunner) PostHealth(ctx context.Context) bool {
 	log.Debug("[HEALTH] Posting health")
 
 	filter := bson.M{
@@ -82,16 +157,19 @@ func (x *HealthCheckRunner) PostHealth() bool {
 		"created_at":         time.Now(),
 	}
 
+	serviceHealths := x.ServiceHealths()
+
 	onUpdate := bson.M{
 		"mint_disabled":   Config.Pocket.MintDisabled,
-		"healthy":         true,
-		"service_healths": x.ServiceHealths(),
+		"needs_attention": x.NeedsAttention(),
+		"healthy":         x.Healthy(serviceHealths),
+		"service_healths": serviceHealths,
 		"updated_at":      time.Now(),
 	}
 
 	update := bson.M{"$set": onUpdate, "$setOnInsert": onInsert}
 
-	_, err := DB.UpsertOne(models.CollectionHealthChecks, filter, update)
+	_, err := DB.UpsertOne(ctx, models.CollectionHealthChecks, filter, update)
 
 	if err != nil {
 		log.Error("[HEALTH] Error posting health: ", err)
@@ -102,10 +180,50 @@ func (x *HealthCheckRunner) PostHealth() bool {
 	return true
 }
 
+type resultStatusCount struct {
+	Status string `bson:"_id"`
+	Count  int64  `bson:"count"`
+}
+
+func (x *HealthCheckRunner) UpdateDocumentMetrics(ctx context.Context) bool {
+	log.Debug("[HEALTH] Updating document metrics")
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$status"},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+
+	success := true
+	for _, collection := range []string{models.CollectionMints, models.CollectionInvalidMints, models.CollectionBurns} {
+		var results []resultStatusCount
+		err := DB.AggregateMany(ctx, collection, pipeline, &results)
+		if err != nil {
+			log.Error("[HEALTH] Error counting documents in ", collection, ": ", err)
+			success = false
+			continue
+		}
+
+		counts := make(map[string]int64)
+		for _, result := range results {
+			counts[result.Status] = result.Count
+		}
+		SetDocumentCounts(collection, counts)
+	}
+
+	log.Debug("[HEALTH] Updated document metrics")
+	return success
+}
+
 func (x *HealthCheckRunner) SetServices(services []Service) {
 	x.services = services
 }
 
+func (x *HealthCheckRunner) Services() []Service {
+	return x.services
+}
+
 func NewHealthCheck() *HealthCheckRunner {
 	log.Debug("[HEALTH] Initializing health")
 
@@ -123,7 +241,7 @@ func NewHealthCheck() *HealthCheckRunner {
 
 	log.Debug("[HEALTH] ETH Address: ", ethSigner.Address)
 
-	validatorId := "wpokt-validator-" + fmt.Sprintf("%02d", poktSigner.SignerIndex+1)
+	validatorId := poktSigner.ValidatorId()
 
 	hostname, err := os.Hostname()
 	if err != nil {
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson"
@@ -53,9 +55,9 @@ func TestFindLastHealth(t *testing.T) {
 			"hostname":     x.hostname,
 		}
 		var health models.Health
-		mockDB.EXPECT().FindOne(models.CollectionHealthChecks, filter, &health).Return(nil)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionHealthChecks, filter, &health).Return(nil)
 
-		_, err := x.FindLastHealth()
+		_, err := x.FindLastHealth(context.Background())
 
 		assert.Nil(t, err)
 	})
@@ -70,9 +72,9 @@ func TestFindLastHealth(t *testing.T) {
 			"hostname":     x.hostname,
 		}
 		var health models.Health
-		mockDB.EXPECT().FindOne(models.CollectionHealthChecks, filter, &health).Return(errors.New("error"))
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionHealthChecks, filter, &health).Return(errors.New("error"))
 
-		_, err := x.FindLastHealth()
+		_, err := x.FindLastHealth(context.Background())
 
 		assert.NotNil(t, err)
 		assert.Equal(t, err.Error(), "error")
@@ -83,7 +85,7 @@ func TestFindLastHealth(t *testing.T) {
 type MockService struct {
 }
 
-func (e *MockService) Start() {}
+func (e *MockService) Start(ctx context.Context) {}
 
 func (e *MockService) Stop() {
 }
@@ -101,6 +103,10 @@ func (e *MockService) Health() models.ServiceHealth {
 	}
 }
 
+func (e *MockService) Status() models.RunnerStatus {
+	return models.RunnerStatus{}
+}
+
 func NewMockService() Service {
 	return &MockService{}
 }
@@ -171,6 +177,7 @@ func TestPostHealth(t *testing.T) {
 
 		onUpdate := bson.M{
 			"mint_disabled":   false,
+			"needs_attention": map[string]int64(nil),
 			"healthy":         true,
 			"service_healths": []models.ServiceHealth{},
 			"updated_at":      nil,
@@ -178,8 +185,8 @@ func TestPostHealth(t *testing.T) {
 
 		update := bson.M{"$set": onUpdate, "$setOnInsert": onInsert}
 
-		call := mockDB.EXPECT().UpsertOne(models.CollectionHealthChecks, filter, mock.Anything)
-		call.Run(func(_ string, _ interface{}, arg interface{}) {
+		call := mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionHealthChecks, filter, mock.Anything)
+		call.Run(func(_ context.Context, _ string, _ interface{}, arg interface{}) {
 
 			updateArg := arg.(bson.M)
 
@@ -191,7 +198,7 @@ func TestPostHealth(t *testing.T) {
 		})
 		call.Return(primitive.NewObjectID(), nil)
 
-		success := x.PostHealth()
+		success := x.PostHealth(context.Background())
 		assert.True(t, success)
 	})
 
@@ -207,10 +214,10 @@ func TestPostHealth(t *testing.T) {
 		mockDB := mocks.NewMockDatabase(t)
 		DB = mockDB
 
-		call := mockDB.EXPECT().UpsertOne(mock.Anything, mock.Anything, mock.Anything)
+		call := mockDB.EXPECT().UpsertOne(mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 		call.Return(primitive.NewObjectID(), errors.New("error"))
 
-		success := x.PostHealth()
+		success := x.PostHealth(context.Background())
 		assert.False(t, success)
 	})
 
@@ -226,10 +233,105 @@ func TestPostHealth(t *testing.T) {
 		mockDB := mocks.NewMockDatabase(t)
 		DB = mockDB
 
-		call := mockDB.EXPECT().UpsertOne(mock.Anything, mock.Anything, mock.Anything)
+		mockDB.EXPECT().CountDocuments(mock.Anything, mock.Anything, mock.Anything).Return(0, nil).Times(3)
+		call := mockDB.EXPECT().UpsertOne(mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 		call.Return(primitive.NewObjectID(), errors.New("error"))
 
-		x.Run()
+		err := x.Run(context.Background())
+
+		assert.NotNil(t, err)
+	})
+
+}
+
+func TestHealthCountNeedsAttention(t *testing.T) {
+	t.Run("No Error", func(t *testing.T) {
+		x := NewTestHealthCheck()
+
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		filter := bson.M{"status": models.StatusNeedsAttention}
+		mockDB.EXPECT().CountDocuments(mock.Anything, models.CollectionMints, filter).Return(2, nil)
+		mockDB.EXPECT().CountDocuments(mock.Anything, models.CollectionInvalidMints, filter).Return(0, nil)
+		mockDB.EXPECT().CountDocuments(mock.Anything, models.CollectionBurns, filter).Return(1, nil)
+
+		success := x.CountNeedsAttention(context.Background())
+
+		assert.True(t, success)
+		assert.Equal(t, map[string]int64{
+			models.CollectionMints:        2,
+			models.CollectionInvalidMints: 0,
+			models.CollectionBurns:        1,
+		}, x.CurrentHealth().NeedsAttention)
+	})
+
+	t.Run("With Error", func(t *testing.T) {
+		x := NewTestHealthCheck()
+
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().CountDocuments(mock.Anything, models.CollectionMints, mock.Anything).Return(0, errors.New("error"))
+
+		success := x.CountNeedsAttention(context.Background())
+
+		assert.False(t, success)
+		assert.Nil(t, x.NeedsAttention())
+	})
+}
+
+func TestHealthUpdateDocumentMetrics(t *testing.T) {
+
+	t.Run("No Error", func(t *testing.T) {
+		x := NewTestHealthCheck()
+
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().AggregateMany(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				counts := result.(*[]resultStatusCount)
+				*counts = append(*counts, resultStatusCount{Status: models.StatusPending, Count: 2})
+			}).Return(nil).Times(3)
+
+		success := x.UpdateDocumentMetrics(context.Background())
+
+		assert.True(t, success)
+		assert.Equal(t, float64(2), testutil.ToFloat64(metricDocuments.WithLabelValues(models.CollectionBurns, models.StatusPending)))
+	})
+
+	t.Run("With Error", func(t *testing.T) {
+		x := NewTestHealthCheck()
+
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().AggregateMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(errors.New("error"))
+		mockDB.EXPECT().AggregateMany(mock.Anything, models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(nil)
+		mockDB.EXPECT().AggregateMany(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(nil)
+
+		success := x.UpdateDocumentMetrics(context.Background())
+
+		assert.False(t, success)
+	})
+
+	t.Run("Via Run", func(t *testing.T) {
+		Config.HTTPServer.Enabled = true
+		defer func() { Config.HTTPServer.Enabled = false }()
+
+		x := NewTestHealthCheck()
+
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().CountDocuments(mock.Anything, mock.Anything, mock.Anything).Return(0, nil).Times(3)
+		mockDB.EXPECT().UpsertOne(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil)
+		mockDB.EXPECT().AggregateMany(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(3)
+
+		err := x.Run(context.Background())
+
+		assert.Nil(t, err)
 	})
 
 }
@@ -0,0 +1,158 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// LockRenewInterval is how often a held lock is renewed, well within the lock ttl
+var LockRenewInterval = 20 * time.Second
+
+// ErrLockLost is the cause of a lock context being cancelled when the lock could not be renewed
+var ErrLockLost = errors.New("lock lost")
+
+// ErrFenced is returned by Fence when the document was written by another lock holder after it was read
+var ErrFenced = errors.New("document was written by another lock holder")
+
+// Lock is a lock on a resource that is renewed until it is released
+type Lock struct {
+	ResourceID string
+	ID         string
+	Token      int64
+
+	cancel context.CancelCauseFunc
+	done   chan struct{}
+}
+
+type lockKey struct{}
+
+// AcquireLock locks a resource for exclusive access and renews the lock until it is released.
+// The returned context is cancelled with ErrLockLost if a renewal fails,
+// so the work done under the lock stops before another validator can take the lock over.
+// Fence uses the first lock acquired in a context, locks acquired under it only stop the work when they are lost.
+func AcquireLock(ctx context.Context, resourceID string) (*Lock, context.Context, error) {
+	return acquireLock(ctx, resourceID, false)
+}
+
+// AcquireSharedLock locks a resource for shared access and renews the lock like AcquireLock,
+// it has no fencing token and is not used by Fence
+func AcquireSharedLock(ctx context.Context, resourceID string) (*Lock, context.Context, error) {
+	return acquireLock(ctx, resourceID, true)
+}
+
+func acquireLock(ctx context.Context, resourceID string, shared bool) (*Lock, context.Context, error) {
+	var lockID string
+	var err error
+	if shared {
+		lockID, err = DB.SLock(ctx, resourceID)
+	} else {
+		lockID, err = DB.XLock(ctx, resourceID)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var token int64
+	if !shared {
+		token, err = DB.NextFencingToken(ctx, resourceID)
+		if err != nil {
+			//nolint:errcheck
+			DB.Unlock(ctx, lockID)
+			return nil, nil, fmt.Errorf("error getting fencing token: %w", err)
+		}
+	}
+
+	lockCtx, cancel := context.WithCancelCause(ctx)
+	l := &Lock{
+		ResourceID: resourceID,
+		ID:         lockID,
+		Token:      token,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+	if _, ok := ctx.Value(lockKey{}).(*Lock); !ok && !shared {
+		lockCtx = context.WithValue(lockCtx, lockKey{}, l)
+	}
+
+	go l.heartbeat(lockCtx)
+	return l, lockCtx, nil
+}
+
+func (l *Lock) heartbeat(ctx context.Context) {
+	defer close(l.done)
+
+	ticker := time.NewTicker(LockRenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := DB.RenewLock(ctx, l.ID); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				log.WithError(err).WithField("resource_id", l.ResourceID).Error("[LOCK] Error renewing lock, aborting work done under it")
+				l.cancel(ErrLockLost)
+				return
+			}
+			log.WithField("resource_id", l.ResourceID).Debug("[LOCK] Renewed lock")
+		}
+	}
+}
+
+// Release stops renewing the lock and unlocks the resource
+func (l *Lock) Release(ctx context.Context) error {
+	l.cancel(context.Canceled)
+	<-l.done
+	return DB.Unlock(ctx, l.ID)
+}
+
+// Fence guards the final update of the work done under the lock held in ctx with a compare-and-swap on the fencing token.
+// The update only applies if the document still has the fencing token it was read with, so it fails if another holder
+// wrote the document after it was read, even a holder whose lock expired while this one read it.
+// The update records the token of this holder. It fails if the lock has been lost,
+// or if the document was read with the token of a newer holder.
+func Fence(ctx context.Context, filter bson.M, update bson.M, readToken *int64) (bson.M, bson.M, error) {
+	l, ok := ctx.Value(lockKey{}).(*Lock)
+	if !ok {
+		return filter, update, nil
+	}
+	if ctx.Err() != nil {
+		return nil, nil, context.Cause(ctx)
+	}
+
+	var token interface{}
+	if readToken != nil {
+		if *readToken > l.Token {
+			return nil, nil, ErrFenced
+		}
+		token = *readToken
+	}
+	fencedFilter := bson.M{
+		"$and": []bson.M{
+			filter,
+			{"fencing_token": token},
+		},
+	}
+
+	set := bson.M{"fencing_token": l.Token}
+	if s, ok := update["$set"].(bson.M); ok {
+		for k, v := range s {
+			set[k] = v
+		}
+	}
+	fencedUpdate := bson.M{"$set": set}
+	for k, v := range update {
+		if k != "$set" {
+			fencedUpdate[k] = v
+		}
+	}
+	return fencedFilter, fencedUpdate, nil
+}
@@ -0,0 +1,199 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestAcquireLock(t *testing.T) {
+
+	t.Run("No Error", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().XLock(mock.Anything, "burns/id").Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, "burns/id").Return(3, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+
+		lock, lockCtx, err := AcquireLock(context.Background(), "burns/id")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "lockId", lock.ID)
+		assert.Equal(t, int64(3), lock.Token)
+		assert.Nil(t, lockCtx.Err())
+
+		err = lock.Release(context.Background())
+
+		assert.Nil(t, err)
+		assert.NotNil(t, lockCtx.Err())
+		assert.False(t, errors.Is(context.Cause(lockCtx), ErrLockLost))
+	})
+
+	t.Run("Error Locking", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().XLock(mock.Anything, "burns/id").Return("", errors.New("error"))
+
+		lock, _, err := AcquireLock(context.Background(), "burns/id")
+
+		assert.NotNil(t, err)
+		assert.Nil(t, lock)
+	})
+
+	t.Run("Error Getting Token", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().XLock(mock.Anything, "burns/id").Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, "burns/id").Return(0, errors.New("error"))
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+
+		lock, _, err := AcquireLock(context.Background(), "burns/id")
+
+		assert.NotNil(t, err)
+		assert.Nil(t, lock)
+	})
+
+}
+
+func TestLockHeartbeat(t *testing.T) {
+	oldInterval := LockRenewInterval
+	LockRenewInterval = time.Millisecond
+	defer func() { LockRenewInterval = oldInterval }()
+
+	mockDB := mocks.NewMockDatabase(t)
+	DB = mockDB
+
+	mockDB.EXPECT().XLock(mock.Anything, "burns/id").Return("lockId", nil)
+	mockDB.EXPECT().NextFencingToken(mock.Anything, "burns/id").Return(1, nil)
+	first := mockDB.EXPECT().RenewLock(mock.Anything, "lockId").Return(nil).Once()
+	mockDB.EXPECT().RenewLock(mock.Anything, "lockId").Return(errors.New("error")).Once().NotBefore(first)
+	mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+
+	lock, lockCtx, err := AcquireLock(context.Background(), "burns/id")
+	assert.Nil(t, err)
+
+	select {
+	case <-lockCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("lock context was not cancelled")
+	}
+	assert.ErrorIs(t, context.Cause(lockCtx), ErrLockLost)
+
+	_, _, err = Fence(lockCtx, bson.M{}, bson.M{}, nil)
+	assert.ErrorIs(t, err, ErrLockLost)
+
+	err = lock.Release(context.Background())
+	assert.Nil(t, err)
+}
+
+func TestAcquireSharedLock(t *testing.T) {
+	mockDB := mocks.NewMockDatabase(t)
+	DB = mockDB
+
+	mockDB.EXPECT().XLock(mock.Anything, "burns/id").Return("lockId", nil)
+	mockDB.EXPECT().NextFencingToken(mock.Anything, "burns/id").Return(3, nil)
+	mockDB.EXPECT().SLock(mock.Anything, "sequence").Return("sharedLockId", nil)
+	mockDB.EXPECT().Unlock(mock.Anything, "sharedLockId").Return(nil)
+	mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+
+	lock, lockCtx, err := AcquireLock(context.Background(), "burns/id")
+	assert.Nil(t, err)
+
+	shared, sharedCtx, err := AcquireSharedLock(lockCtx, "sequence")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "sharedLockId", shared.ID)
+	assert.Equal(t, int64(0), shared.Token)
+	assert.Equal(t, lock, sharedCtx.Value(lockKey{}))
+
+	assert.Nil(t, shared.Release(context.Background()))
+	assert.Nil(t, lockCtx.Err())
+	assert.Nil(t, lock.Release(context.Background()))
+}
+
+func TestFence(t *testing.T) {
+	filter := bson.M{"_id": "id"}
+	update := bson.M{
+		"$set":         bson.M{"status": "signed"},
+		"$setOnInsert": bson.M{"created_at": "now"},
+	}
+	token := func(token int64) *int64 { return &token }
+
+	t.Run("Without Lock", func(t *testing.T) {
+		gotFilter, gotUpdate, err := Fence(context.Background(), filter, update, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, filter, gotFilter)
+		assert.Equal(t, update, gotUpdate)
+	})
+
+	t.Run("Never Written", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), lockKey{}, &Lock{Token: 5})
+
+		gotFilter, gotUpdate, err := Fence(ctx, filter, update, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, bson.M{
+			"$and": []bson.M{
+				filter,
+				{"fencing_token": nil},
+			},
+		}, gotFilter)
+		assert.Equal(t, bson.M{
+			"$set":         bson.M{"status": "signed", "fencing_token": int64(5)},
+			"$setOnInsert": bson.M{"created_at": "now"},
+		}, gotUpdate)
+		assert.Equal(t, bson.M{"status": "signed"}, update["$set"])
+	})
+
+	t.Run("Written By Older Holder", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), lockKey{}, &Lock{Token: 5})
+
+		gotFilter, gotUpdate, err := Fence(ctx, filter, update, token(3))
+
+		assert.Nil(t, err)
+		assert.Equal(t, bson.M{
+			"$and": []bson.M{
+				filter,
+				{"fencing_token": int64(3)},
+			},
+		}, gotFilter)
+		assert.Equal(t, int64(5), gotUpdate["$set"].(bson.M)["fencing_token"])
+	})
+
+	t.Run("Written By Newer Holder", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), lockKey{}, &Lock{Token: 5})
+
+		_, _, err := Fence(ctx, filter, update, token(6))
+
+		assert.ErrorIs(t, err, ErrFenced)
+	})
+
+	t.Run("Nested Lock", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().XLock(mock.Anything, "sequence").Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, "sequence").Return(9, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+
+		ctx := context.WithValue(context.Background(), lockKey{}, &Lock{Token: 5})
+		lock, lockCtx, err := AcquireLock(ctx, "sequence")
+		assert.Nil(t, err)
+
+		_, gotUpdate, err := Fence(lockCtx, filter, update, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), gotUpdate["$set"].(bson.M)["fencing_token"])
+		assert.Nil(t, lock.Release(context.Background()))
+	})
+}
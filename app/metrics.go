@@ -0,0 +1,186 @@
+package app
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	metricsNamespace = "wpokt_validator"
+
+	ChainEthereum = "ethereum"
+	ChainPocket   = "pocket"
+)
+
+var (
+	metricsRegistry = prometheus.NewRegistry()
+
+	metricRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "runner",
+		Name:      "run_duration_seconds",
+		Help:      "Duration of a single run of a runner service.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{"service"})
+
+	metricRunsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "runner",
+		Name:      "runs_total",
+		Help:      "Number of runs of a runner service by result.",
+	}, []string{"service", "result"})
+
+	metricLastSuccessfulRun = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "runner",
+		Name:      "last_success_timestamp_seconds",
+		Help:      "Unix timestamp of the last successful run of a runner service.",
+	}, []string{"service"})
+
+	metricRPCDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "rpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of chain client requests by chain and method.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 12),
+	}, []string{"chain", "method"})
+
+	metricRPCErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "rpc",
+		Name:      "errors_total",
+		Help:      "Number of failed chain client requests by chain and method.",
+	}, []string{"chain", "method"})
+
+	metricEndpointScore = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "rpc",
+		Name:      "endpoint_score",
+		Help:      "Share of recent requests to a chain endpoint that succeeded, by service.",
+	}, []string{"service", "chain", "endpoint"})
+
+	metricEndpointHealthy = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "rpc",
+		Name:      "endpoint_healthy",
+		Help:      "Whether a chain endpoint is used before the others (1) or cooling down after failures (0), by service.",
+	}, []string{"service", "chain", "endpoint"})
+
+	metricDocuments = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "pipeline",
+		Name:      "documents",
+		Help:      "Number of documents in a pipeline collection by status.",
+	}, []string{"collection", "status"})
+
+	lastSuccessCollector = &sinceLastSuccessCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "runner", "seconds_since_last_success"),
+			"Seconds elapsed since the last successful run of a runner service.",
+			[]string{"service"}, nil,
+		),
+		lastSuccess: make(map[string]time.Time),
+	}
+)
+
+func init() {
+	metricsRegistry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		metricRunDuration,
+		metricRunsTotal,
+		metricLastSuccessfulRun,
+		metricRPCDuration,
+		metricRPCErrors,
+		metricEndpointScore,
+		metricEndpointHealthy,
+		metricDocuments,
+		lastSuccessCollector,
+	)
+}
+
+// sinceLastSuccessCollector reports the time since the last successful run at scrape time
+type sinceLastSuccessCollector struct {
+	desc *prometheus.Desc
+
+	mu          sync.RWMutex
+	lastSuccess map[string]time.Time
+}
+
+func (c *sinceLastSuccessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *sinceLastSuccessCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for service, lastSuccess := range c.lastSuccess {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, time.Since(lastSuccess).Seconds(), service)
+	}
+}
+
+func (c *sinceLastSuccessCollector) set(service string, t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastSuccess[service] = t
+}
+
+// RecordRun records the duration and result of a single run of a runner service
+func RecordRun(service string, duration time.Duration, success bool) {
+	metricRunDuration.WithLabelValues(service).Observe(duration.Seconds())
+
+	if !success {
+		metricRunsTotal.WithLabelValues(service, "failure").Inc()
+		return
+	}
+
+	now := time.Now()
+	metricRunsTotal.WithLabelValues(service, "success").Inc()
+	metricLastSuccessfulRun.WithLabelValues(service).Set(float64(now.Unix()))
+	lastSuccessCollector.set(service, now)
+}
+
+// ObserveRPC records the latency and result of a chain client request, meant to be deferred
+//
+//	defer app.ObserveRPC(app.ChainEthereum, "GetBlockNumber", time.Now(), &err)
+func ObserveRPC(chain string, method string, start time.Time, err *error) {
+	metricRPCDuration.WithLabelValues(chain, method).Observe(time.Since(start).Seconds())
+	if err != nil && *err != nil {
+		metricRPCErrors.WithLabelValues(chain, method).Inc()
+	}
+}
+
+// SetDocumentCounts replaces the per status document counts of a pipeline collection
+func SetDocumentCounts(collection string, counts map[string]int64) {
+	metricDocuments.DeletePartialMatch(prometheus.Labels{"collection": collection})
+	for status, count := range counts {
+		metricDocuments.WithLabelValues(collection, status).Set(float64(count))
+	}
+}
+
+// MetricsHandler serves the validator metrics in the Prometheus exposition format
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{})
+}
+
+// SetEndpointHealth replaces the endpoint scores and health of a runner service
+func SetEndpointHealth(service string, endpoints []models.EndpointHealth) {
+	metricEndpointScore.DeletePartialMatch(prometheus.Labels{"service": service})
+	metricEndpointHealthy.DeletePartialMatch(prometheus.Labels{"service": service})
+	for _, e := range endpoints {
+		metricEndpointScore.WithLabelValues(service, e.Chain, e.Name).Set(e.Score)
+		healthy := 0.0
+		if e.Healthy {
+			healthy = 1
+		}
+		metricEndpointHealthy.WithLabelValues(service, e.Chain, e.Name).Set(healthy)
+	}
+}
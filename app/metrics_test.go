@@ -0,0 +1,87 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordRun(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		before := testutil.ToFloat64(metricRunsTotal.WithLabelValues("test success", "success"))
+
+		RecordRun("test success", time.Second, true)
+
+		assert.Equal(t, before+1, testutil.ToFloat64(metricRunsTotal.WithLabelValues("test success", "success")))
+		assert.NotZero(t, testutil.ToFloat64(metricLastSuccessfulRun.WithLabelValues("test success")))
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		before := testutil.ToFloat64(metricRunsTotal.WithLabelValues("test failure", "failure"))
+
+		RecordRun("test failure", time.Second, false)
+
+		assert.Equal(t, before+1, testutil.ToFloat64(metricRunsTotal.WithLabelValues("test failure", "failure")))
+		assert.Zero(t, testutil.ToFloat64(metricLastSuccessfulRun.WithLabelValues("test failure")))
+	})
+}
+
+func TestObserveRPC(t *testing.T) {
+	t.Run("No Error", func(t *testing.T) {
+		var err error
+		ObserveRPC(ChainEthereum, "TestNoError", time.Now(), &err)
+
+		assert.Zero(t, testutil.ToFloat64(metricRPCErrors.WithLabelValues(ChainEthereum, "TestNoError")))
+	})
+
+	t.Run("With Error", func(t *testing.T) {
+		err := errors.New("error")
+		ObserveRPC(ChainPocket, "TestWithError", time.Now(), &err)
+
+		assert.Equal(t, float64(1), testutil.ToFloat64(metricRPCErrors.WithLabelValues(ChainPocket, "TestWithError")))
+	})
+}
+
+func TestSetDocumentCounts(t *testing.T) {
+	SetDocumentCounts("test", map[string]int64{
+		models.StatusPending: 3,
+		models.StatusSigned:  1,
+	})
+	assert.Equal(t, float64(3), testutil.ToFloat64(metricDocuments.WithLabelValues("test", models.StatusPending)))
+
+	SetDocumentCounts("test", map[string]int64{
+		models.StatusSigned: 2,
+	})
+	assert.Equal(t, float64(2), testutil.ToFloat64(metricDocuments.WithLabelValues("test", models.StatusSigned)))
+	assert.Zero(t, testutil.ToFloat64(metricDocuments.WithLabelValues("test", models.StatusPending)))
+}
+
+func TestSetEndpointHealth(t *testing.T) {
+	SetEndpointHealth("TestService", []models.EndpointHealth{
+		{Chain: ChainPocket, Name: "#0 node", Healthy: true, Score: 0.8},
+		{Chain: ChainPocket, Name: "#1 node", Healthy: false, Score: 0.2},
+	})
+	assert.Equal(t, 0.8, testutil.ToFloat64(metricEndpointScore.WithLabelValues("TestService", ChainPocket, "#0 node")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metricEndpointHealthy.WithLabelValues("TestService", ChainPocket, "#0 node")))
+	assert.Zero(t, testutil.ToFloat64(metricEndpointHealthy.WithLabelValues("TestService", ChainPocket, "#1 node")))
+}
+
+func TestMetricsHandler(t *testing.T) {
+	RecordRun("test handler", time.Second, true)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec := httptest.NewRecorder()
+	MetricsHandler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.True(t, strings.Contains(body, "wpokt_validator_runner_runs_total"))
+	assert.True(t, strings.Contains(body, `wpokt_validator_runner_seconds_since_last_success{service="test handler"}`))
+}
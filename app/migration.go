@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+)
+
+func latestVersion(migrations []models.Migration) int64 {
+	var latest int64
+	for _, m := range migrations {
+		latest = max(latest, m.Version)
+	}
+	return latest
+}
+
+func latestAppliedVersion(applied []models.SchemaMigration) int64 {
+	var latest int64
+	for _, m := range applied {
+		latest = max(latest, m.Version)
+	}
+	return latest
+}
+
+// CheckSchemaVersion fails if the database has migrations applied that this binary does not know about
+func CheckSchemaVersion(ctx context.Context, db Database) error {
+	applied, err := db.AppliedMigrations(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading applied migrations: %w", err)
+	}
+	if current, latest := latestAppliedVersion(applied), latestVersion(db.Migrations()); current > latest {
+		return fmt.Errorf("database schema version %d is ahead of this binary, which supports up to version %d", current, latest)
+	}
+	return nil
+}
+
+// PendingMigrations returns the migrations of this binary that have not been applied, in order
+func PendingMigrations(ctx context.Context, db Database) ([]models.Migration, error) {
+	applied, err := db.AppliedMigrations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading applied migrations: %w", err)
+	}
+	done := make(map[int64]bool)
+	for _, m := range applied {
+		done[m.Version] = true
+	}
+
+	pending := []models.Migration{}
+	for _, m := range db.Migrations() {
+		if !done[m.Version] {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// Migrate applies the pending migrations, refusing to run against a schema newer than this binary
+func Migrate(ctx context.Context, db Database) ([]models.Migration, error) {
+	if err := CheckSchemaVersion(ctx, db); err != nil {
+		return nil, err
+	}
+
+	pending, err := PendingMigrations(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, m := range pending {
+		log.Debugf("[DB] Applying migration %d: %s", m.Version, m.Description)
+		if err := db.ApplyMigration(ctx, m); err != nil {
+			return pending[:i], fmt.Errorf("error applying migration %d: %w", m.Version, err)
+		}
+		log.Infof("[DB] Applied migration %d: %s", m.Version, m.Description)
+	}
+	return pending, nil
+}
+
+// MigrateDB brings the schema of the database up to date before the validator starts
+func MigrateDB() {
+	if _, err := Migrate(context.Background(), DB); err != nil {
+		log.Fatal("[DB] Failed to migrate database: ", err)
+	}
+	log.Info("[DB] Database schema is up to date")
+}
@@ -0,0 +1,91 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testMigrations = []models.Migration{
+	{Version: 1, Description: "first"},
+	{Version: 2, Description: "second"},
+	{Version: 3, Description: "third"},
+}
+
+func TestMigrate(t *testing.T) {
+
+	t.Run("Applies Pending In Order", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		mockDB.EXPECT().Migrations().Return(testMigrations)
+		mockDB.EXPECT().AppliedMigrations(mock.Anything).Return([]models.SchemaMigration{{Version: 1, AppliedAt: time.Now()}}, nil)
+		first := mockDB.EXPECT().ApplyMigration(mock.Anything, testMigrations[1]).Return(nil).Once()
+		mockDB.EXPECT().ApplyMigration(mock.Anything, testMigrations[2]).Return(nil).Once().NotBefore(first)
+
+		applied, err := Migrate(context.Background(), mockDB)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testMigrations[1:], applied)
+	})
+
+	t.Run("Up To Date", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		mockDB.EXPECT().Migrations().Return(testMigrations)
+		mockDB.EXPECT().AppliedMigrations(mock.Anything).Return([]models.SchemaMigration{{Version: 1}, {Version: 2}, {Version: 3}}, nil)
+
+		applied, err := Migrate(context.Background(), mockDB)
+
+		assert.Nil(t, err)
+		assert.Empty(t, applied)
+	})
+
+	t.Run("Schema Ahead", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		mockDB.EXPECT().Migrations().Return(testMigrations)
+		mockDB.EXPECT().AppliedMigrations(mock.Anything).Return([]models.SchemaMigration{{Version: 1}, {Version: 4}}, nil)
+
+		applied, err := Migrate(context.Background(), mockDB)
+
+		assert.ErrorContains(t, err, "database schema version 4 is ahead of this binary, which supports up to version 3")
+		assert.Nil(t, applied)
+	})
+
+	t.Run("Apply Error", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		mockDB.EXPECT().Migrations().Return(testMigrations)
+		mockDB.EXPECT().AppliedMigrations(mock.Anything).Return([]models.SchemaMigration{}, nil)
+		mockDB.EXPECT().ApplyMigration(mock.Anything, testMigrations[0]).Return(nil).Once()
+		mockDB.EXPECT().ApplyMigration(mock.Anything, testMigrations[1]).Return(errors.New("error")).Once()
+
+		applied, err := Migrate(context.Background(), mockDB)
+
+		assert.ErrorContains(t, err, "error applying migration 2")
+		assert.Equal(t, testMigrations[:1], applied)
+	})
+
+	t.Run("Read Error", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		mockDB.EXPECT().AppliedMigrations(mock.Anything).Return(nil, errors.New("error"))
+
+		_, err := Migrate(context.Background(), mockDB)
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestMigrationVersions(t *testing.T) {
+	for name, migrations := range map[string][]models.Migration{
+		"mongodb":  (&MongoDatabase{}).Migrations(),
+		"postgres": (&PostgresDatabase{}).Migrations(),
+	} {
+		for i, m := range migrations {
+			assert.Equal(t, int64(i+1), m.Version, name)
+			assert.NotEmpty(t, m.Description, name)
+		}
+	}
+}
@@ -3,7 +3,11 @@
 package mocks
 
 import (
+	context "context"
+
+	models "github.com/dan13ram/wpokt-validator/models"
 	mock "github.com/stretchr/testify/mock"
+
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -20,17 +24,17 @@ func (_m *MockDatabase) EXPECT() *MockDatabase_Expecter {
 	return &MockDatabase_Expecter{mock: &_m.Mock}
 }
 
-// AggregateMany provides a mock function with given fields: collection, pipeline, result
-func (_m *MockDatabase) AggregateMany(collection string, pipeline interface{}, result interface{}) error {
-	ret := _m.Called(collection, pipeline, result)
+// AggregateMany provides a mock function with given fields: ctx, collection, pipeline, result
+func (_m *MockDatabase) AggregateMany(ctx context.Context, collection string, pipeline interface{}, result interface{}) error {
+	ret := _m.Called(ctx, collection, pipeline, result)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AggregateMany")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, interface{}, interface{}) error); ok {
-		r0 = rf(collection, pipeline, result)
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, collection, pipeline, result)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -44,16 +48,17 @@ type MockDatabase_AggregateMany_Call struct {
 }
 
 // AggregateMany is a helper method to define mock.On call
+//   - ctx context.Context
 //   - collection string
 //   - pipeline interface{}
 //   - result interface{}
-func (_e *MockDatabase_Expecter) AggregateMany(collection interface{}, pipeline interface{}, result interface{}) *MockDatabase_AggregateMany_Call {
-	return &MockDatabase_AggregateMany_Call{Call: _e.mock.On("AggregateMany", collection, pipeline, result)}
+func (_e *MockDatabase_Expecter) AggregateMany(ctx interface{}, collection interface{}, pipeline interface{}, result interface{}) *MockDatabase_AggregateMany_Call {
+	return &MockDatabase_AggregateMany_Call{Call: _e.mock.On("AggregateMany", ctx, collection, pipeline, result)}
 }
 
-func (_c *MockDatabase_AggregateMany_Call) Run(run func(collection string, pipeline interface{}, result interface{})) *MockDatabase_AggregateMany_Call {
+func (_c *MockDatabase_AggregateMany_Call) Run(run func(ctx context.Context, collection string, pipeline interface{}, result interface{})) *MockDatabase_AggregateMany_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(interface{}), args[2].(interface{}))
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}), args[3].(interface{}))
 	})
 	return _c
 }
@@ -63,22 +68,22 @@ func (_c *MockDatabase_AggregateMany_Call) Return(_a0 error) *MockDatabase_Aggre
 	return _c
 }
 
-func (_c *MockDatabase_AggregateMany_Call) RunAndReturn(run func(string, interface{}, interface{}) error) *MockDatabase_AggregateMany_Call {
+func (_c *MockDatabase_AggregateMany_Call) RunAndReturn(run func(context.Context, string, interface{}, interface{}) error) *MockDatabase_AggregateMany_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// AggregateOne provides a mock function with given fields: collection, pipeline, result
-func (_m *MockDatabase) AggregateOne(collection string, pipeline interface{}, result interface{}) error {
-	ret := _m.Called(collection, pipeline, result)
+// AggregateOne provides a mock function with given fields: ctx, collection, pipeline, result
+func (_m *MockDatabase) AggregateOne(ctx context.Context, collection string, pipeline interface{}, result interface{}) error {
+	ret := _m.Called(ctx, collection, pipeline, result)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AggregateOne")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, interface{}, interface{}) error); ok {
-		r0 = rf(collection, pipeline, result)
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, collection, pipeline, result)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -92,16 +97,17 @@ type MockDatabase_AggregateOne_Call struct {
 }
 
 // AggregateOne is a helper method to define mock.On call
+//   - ctx context.Context
 //   - collection string
 //   - pipeline interface{}
 //   - result interface{}
-func (_e *MockDatabase_Expecter) AggregateOne(collection interface{}, pipeline interface{}, result interface{}) *MockDatabase_AggregateOne_Call {
-	return &MockDatabase_AggregateOne_Call{Call: _e.mock.On("AggregateOne", collection, pipeline, result)}
+func (_e *MockDatabase_Expecter) AggregateOne(ctx interface{}, collection interface{}, pipeline interface{}, result interface{}) *MockDatabase_AggregateOne_Call {
+	return &MockDatabase_AggregateOne_Call{Call: _e.mock.On("AggregateOne", ctx, collection, pipeline, result)}
 }
 
-func (_c *MockDatabase_AggregateOne_Call) Run(run func(collection string, pipeline interface{}, result interface{})) *MockDatabase_AggregateOne_Call {
+func (_c *MockDatabase_AggregateOne_Call) Run(run func(ctx context.Context, collection string, pipeline interface{}, result interface{})) *MockDatabase_AggregateOne_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(interface{}), args[2].(interface{}))
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}), args[3].(interface{}))
 	})
 	return _c
 }
@@ -111,7 +117,112 @@ func (_c *MockDatabase_AggregateOne_Call) Return(_a0 error) *MockDatabase_Aggreg
 	return _c
 }
 
-func (_c *MockDatabase_AggregateOne_Call) RunAndReturn(run func(string, interface{}, interface{}) error) *MockDatabase_AggregateOne_Call {
+func (_c *MockDatabase_AggregateOne_Call) RunAndReturn(run func(context.Context, string, interface{}, interface{}) error) *MockDatabase_AggregateOne_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppliedMigrations provides a mock function with given fields: ctx
+func (_m *MockDatabase) AppliedMigrations(ctx context.Context) ([]models.SchemaMigration, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppliedMigrations")
+	}
+
+	var r0 []models.SchemaMigration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.SchemaMigration, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.SchemaMigration); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SchemaMigration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_AppliedMigrations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppliedMigrations'
+type MockDatabase_AppliedMigrations_Call struct {
+	*mock.Call
+}
+
+// AppliedMigrations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatabase_Expecter) AppliedMigrations(ctx interface{}) *MockDatabase_AppliedMigrations_Call {
+	return &MockDatabase_AppliedMigrations_Call{Call: _e.mock.On("AppliedMigrations", ctx)}
+}
+
+func (_c *MockDatabase_AppliedMigrations_Call) Run(run func(ctx context.Context)) *MockDatabase_AppliedMigrations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_AppliedMigrations_Call) Return(_a0 []models.SchemaMigration, _a1 error) *MockDatabase_AppliedMigrations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_AppliedMigrations_Call) RunAndReturn(run func(context.Context) ([]models.SchemaMigration, error)) *MockDatabase_AppliedMigrations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApplyMigration provides a mock function with given fields: ctx, migration
+func (_m *MockDatabase) ApplyMigration(ctx context.Context, migration models.Migration) error {
+	ret := _m.Called(ctx, migration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyMigration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Migration) error); ok {
+		r0 = rf(ctx, migration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_ApplyMigration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyMigration'
+type MockDatabase_ApplyMigration_Call struct {
+	*mock.Call
+}
+
+// ApplyMigration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - migration models.Migration
+func (_e *MockDatabase_Expecter) ApplyMigration(ctx interface{}, migration interface{}) *MockDatabase_ApplyMigration_Call {
+	return &MockDatabase_ApplyMigration_Call{Call: _e.mock.On("ApplyMigration", ctx, migration)}
+}
+
+func (_c *MockDatabase_ApplyMigration_Call) Run(run func(ctx context.Context, migration models.Migration)) *MockDatabase_ApplyMigration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Migration))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ApplyMigration_Call) Return(_a0 error) *MockDatabase_ApplyMigration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_ApplyMigration_Call) RunAndReturn(run func(context.Context, models.Migration) error) *MockDatabase_ApplyMigration_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -161,6 +272,64 @@ func (_c *MockDatabase_Connect_Call) RunAndReturn(run func() error) *MockDatabas
 	return _c
 }
 
+// CountDocuments provides a mock function with given fields: ctx, collection, filter
+func (_m *MockDatabase) CountDocuments(ctx context.Context, collection string, filter interface{}) (int64, error) {
+	ret := _m.Called(ctx, collection, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountDocuments")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}) (int64, error)); ok {
+		return rf(ctx, collection, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}) int64); ok {
+		r0 = rf(ctx, collection, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, interface{}) error); ok {
+		r1 = rf(ctx, collection, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_CountDocuments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountDocuments'
+type MockDatabase_CountDocuments_Call struct {
+	*mock.Call
+}
+
+// CountDocuments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collection string
+//   - filter interface{}
+func (_e *MockDatabase_Expecter) CountDocuments(ctx interface{}, collection interface{}, filter interface{}) *MockDatabase_CountDocuments_Call {
+	return &MockDatabase_CountDocuments_Call{Call: _e.mock.On("CountDocuments", ctx, collection, filter)}
+}
+
+func (_c *MockDatabase_CountDocuments_Call) Run(run func(ctx context.Context, collection string, filter interface{})) *MockDatabase_CountDocuments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_CountDocuments_Call) Return(_a0 int64, _a1 error) *MockDatabase_CountDocuments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_CountDocuments_Call) RunAndReturn(run func(context.Context, string, interface{}) (int64, error)) *MockDatabase_CountDocuments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Disconnect provides a mock function with no fields
 func (_m *MockDatabase) Disconnect() error {
 	ret := _m.Called()
@@ -206,17 +375,17 @@ func (_c *MockDatabase_Disconnect_Call) RunAndReturn(run func() error) *MockData
 	return _c
 }
 
-// FindMany provides a mock function with given fields: collection, filter, result
-func (_m *MockDatabase) FindMany(collection string, filter interface{}, result interface{}) error {
-	ret := _m.Called(collection, filter, result)
+// FindMany provides a mock function with given fields: ctx, collection, filter, result
+func (_m *MockDatabase) FindMany(ctx context.Context, collection string, filter interface{}, result interface{}) error {
+	ret := _m.Called(ctx, collection, filter, result)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindMany")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, interface{}, interface{}) error); ok {
-		r0 = rf(collection, filter, result)
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, collection, filter, result)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -230,16 +399,17 @@ type MockDatabase_FindMany_Call struct {
 }
 
 // FindMany is a helper method to define mock.On call
+//   - ctx context.Context
 //   - collection string
 //   - filter interface{}
 //   - result interface{}
-func (_e *MockDatabase_Expecter) FindMany(collection interface{}, filter interface{}, result interface{}) *MockDatabase_FindMany_Call {
-	return &MockDatabase_FindMany_Call{Call: _e.mock.On("FindMany", collection, filter, result)}
+func (_e *MockDatabase_Expecter) FindMany(ctx interface{}, collection interface{}, filter interface{}, result interface{}) *MockDatabase_FindMany_Call {
+	return &MockDatabase_FindMany_Call{Call: _e.mock.On("FindMany", ctx, collection, filter, result)}
 }
 
-func (_c *MockDatabase_FindMany_Call) Run(run func(collection string, filter interface{}, result interface{})) *MockDatabase_FindMany_Call {
+func (_c *MockDatabase_FindMany_Call) Run(run func(ctx context.Context, collection string, filter interface{}, result interface{})) *MockDatabase_FindMany_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(interface{}), args[2].(interface{}))
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}), args[3].(interface{}))
 	})
 	return _c
 }
@@ -249,22 +419,74 @@ func (_c *MockDatabase_FindMany_Call) Return(_a0 error) *MockDatabase_FindMany_C
 	return _c
 }
 
-func (_c *MockDatabase_FindMany_Call) RunAndReturn(run func(string, interface{}, interface{}) error) *MockDatabase_FindMany_Call {
+func (_c *MockDatabase_FindMany_Call) RunAndReturn(run func(context.Context, string, interface{}, interface{}) error) *MockDatabase_FindMany_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindManySorted provides a mock function with given fields: collection, filter, sort, result
-func (_m *MockDatabase) FindManySorted(collection string, filter interface{}, sort interface{}, result interface{}) error {
-	ret := _m.Called(collection, filter, sort, result)
+// FindManyPaged provides a mock function with given fields: ctx, collection, filter, sort, skip, limit, result
+func (_m *MockDatabase) FindManyPaged(ctx context.Context, collection string, filter interface{}, sort interface{}, skip int64, limit int64, result interface{}) error {
+	ret := _m.Called(ctx, collection, filter, sort, skip, limit, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindManyPaged")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, interface{}, int64, int64, interface{}) error); ok {
+		r0 = rf(ctx, collection, filter, sort, skip, limit, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_FindManyPaged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindManyPaged'
+type MockDatabase_FindManyPaged_Call struct {
+	*mock.Call
+}
+
+// FindManyPaged is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collection string
+//   - filter interface{}
+//   - sort interface{}
+//   - skip int64
+//   - limit int64
+//   - result interface{}
+func (_e *MockDatabase_Expecter) FindManyPaged(ctx interface{}, collection interface{}, filter interface{}, sort interface{}, skip interface{}, limit interface{}, result interface{}) *MockDatabase_FindManyPaged_Call {
+	return &MockDatabase_FindManyPaged_Call{Call: _e.mock.On("FindManyPaged", ctx, collection, filter, sort, skip, limit, result)}
+}
+
+func (_c *MockDatabase_FindManyPaged_Call) Run(run func(ctx context.Context, collection string, filter interface{}, sort interface{}, skip int64, limit int64, result interface{})) *MockDatabase_FindManyPaged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}), args[3].(interface{}), args[4].(int64), args[5].(int64), args[6].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_FindManyPaged_Call) Return(_a0 error) *MockDatabase_FindManyPaged_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_FindManyPaged_Call) RunAndReturn(run func(context.Context, string, interface{}, interface{}, int64, int64, interface{}) error) *MockDatabase_FindManyPaged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindManySorted provides a mock function with given fields: ctx, collection, filter, sort, result
+func (_m *MockDatabase) FindManySorted(ctx context.Context, collection string, filter interface{}, sort interface{}, result interface{}) error {
+	ret := _m.Called(ctx, collection, filter, sort, result)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindManySorted")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, interface{}, interface{}, interface{}) error); ok {
-		r0 = rf(collection, filter, sort, result)
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, collection, filter, sort, result)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -278,17 +500,18 @@ type MockDatabase_FindManySorted_Call struct {
 }
 
 // FindManySorted is a helper method to define mock.On call
+//   - ctx context.Context
 //   - collection string
 //   - filter interface{}
 //   - sort interface{}
 //   - result interface{}
-func (_e *MockDatabase_Expecter) FindManySorted(collection interface{}, filter interface{}, sort interface{}, result interface{}) *MockDatabase_FindManySorted_Call {
-	return &MockDatabase_FindManySorted_Call{Call: _e.mock.On("FindManySorted", collection, filter, sort, result)}
+func (_e *MockDatabase_Expecter) FindManySorted(ctx interface{}, collection interface{}, filter interface{}, sort interface{}, result interface{}) *MockDatabase_FindManySorted_Call {
+	return &MockDatabase_FindManySorted_Call{Call: _e.mock.On("FindManySorted", ctx, collection, filter, sort, result)}
 }
 
-func (_c *MockDatabase_FindManySorted_Call) Run(run func(collection string, filter interface{}, sort interface{}, result interface{})) *MockDatabase_FindManySorted_Call {
+func (_c *MockDatabase_FindManySorted_Call) Run(run func(ctx context.Context, collection string, filter interface{}, sort interface{}, result interface{})) *MockDatabase_FindManySorted_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(interface{}), args[2].(interface{}), args[3].(interface{}))
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}), args[3].(interface{}), args[4].(interface{}))
 	})
 	return _c
 }
@@ -298,22 +521,22 @@ func (_c *MockDatabase_FindManySorted_Call) Return(_a0 error) *MockDatabase_Find
 	return _c
 }
 
-func (_c *MockDatabase_FindManySorted_Call) RunAndReturn(run func(string, interface{}, interface{}, interface{}) error) *MockDatabase_FindManySorted_Call {
+func (_c *MockDatabase_FindManySorted_Call) RunAndReturn(run func(context.Context, string, interface{}, interface{}, interface{}) error) *MockDatabase_FindManySorted_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindOne provides a mock function with given fields: collection, filter, result
-func (_m *MockDatabase) FindOne(collection string, filter interface{}, result interface{}) error {
-	ret := _m.Called(collection, filter, result)
+// FindOne provides a mock function with given fields: ctx, collection, filter, result
+func (_m *MockDatabase) FindOne(ctx context.Context, collection string, filter interface{}, result interface{}) error {
+	ret := _m.Called(ctx, collection, filter, result)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindOne")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, interface{}, interface{}) error); ok {
-		r0 = rf(collection, filter, result)
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, collection, filter, result)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -327,16 +550,17 @@ type MockDatabase_FindOne_Call struct {
 }
 
 // FindOne is a helper method to define mock.On call
+//   - ctx context.Context
 //   - collection string
 //   - filter interface{}
 //   - result interface{}
-func (_e *MockDatabase_Expecter) FindOne(collection interface{}, filter interface{}, result interface{}) *MockDatabase_FindOne_Call {
-	return &MockDatabase_FindOne_Call{Call: _e.mock.On("FindOne", collection, filter, result)}
+func (_e *MockDatabase_Expecter) FindOne(ctx interface{}, collection interface{}, filter interface{}, result interface{}) *MockDatabase_FindOne_Call {
+	return &MockDatabase_FindOne_Call{Call: _e.mock.On("FindOne", ctx, collection, filter, result)}
 }
 
-func (_c *MockDatabase_FindOne_Call) Run(run func(collection string, filter interface{}, result interface{})) *MockDatabase_FindOne_Call {
+func (_c *MockDatabase_FindOne_Call) Run(run func(ctx context.Context, collection string, filter interface{}, result interface{})) *MockDatabase_FindOne_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(interface{}), args[2].(interface{}))
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}), args[3].(interface{}))
 	})
 	return _c
 }
@@ -346,14 +570,14 @@ func (_c *MockDatabase_FindOne_Call) Return(_a0 error) *MockDatabase_FindOne_Cal
 	return _c
 }
 
-func (_c *MockDatabase_FindOne_Call) RunAndReturn(run func(string, interface{}, interface{}) error) *MockDatabase_FindOne_Call {
+func (_c *MockDatabase_FindOne_Call) RunAndReturn(run func(context.Context, string, interface{}, interface{}) error) *MockDatabase_FindOne_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InsertOne provides a mock function with given fields: collection, data
-func (_m *MockDatabase) InsertOne(collection string, data interface{}) (primitive.ObjectID, error) {
-	ret := _m.Called(collection, data)
+// InsertOne provides a mock function with given fields: ctx, collection, data
+func (_m *MockDatabase) InsertOne(ctx context.Context, collection string, data interface{}) (primitive.ObjectID, error) {
+	ret := _m.Called(ctx, collection, data)
 
 	if len(ret) == 0 {
 		panic("no return value specified for InsertOne")
@@ -361,19 +585,19 @@ func (_m *MockDatabase) InsertOne(collection string, data interface{}) (primitiv
 
 	var r0 primitive.ObjectID
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, interface{}) (primitive.ObjectID, error)); ok {
-		return rf(collection, data)
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}) (primitive.ObjectID, error)); ok {
+		return rf(ctx, collection, data)
 	}
-	if rf, ok := ret.Get(0).(func(string, interface{}) primitive.ObjectID); ok {
-		r0 = rf(collection, data)
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}) primitive.ObjectID); ok {
+		r0 = rf(ctx, collection, data)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(primitive.ObjectID)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, interface{}) error); ok {
-		r1 = rf(collection, data)
+	if rf, ok := ret.Get(1).(func(context.Context, string, interface{}) error); ok {
+		r1 = rf(ctx, collection, data)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -387,15 +611,16 @@ type MockDatabase_InsertOne_Call struct {
 }
 
 // InsertOne is a helper method to define mock.On call
+//   - ctx context.Context
 //   - collection string
 //   - data interface{}
-func (_e *MockDatabase_Expecter) InsertOne(collection interface{}, data interface{}) *MockDatabase_InsertOne_Call {
-	return &MockDatabase_InsertOne_Call{Call: _e.mock.On("InsertOne", collection, data)}
+func (_e *MockDatabase_Expecter) InsertOne(ctx interface{}, collection interface{}, data interface{}) *MockDatabase_InsertOne_Call {
+	return &MockDatabase_InsertOne_Call{Call: _e.mock.On("InsertOne", ctx, collection, data)}
 }
 
-func (_c *MockDatabase_InsertOne_Call) Run(run func(collection string, data interface{})) *MockDatabase_InsertOne_Call {
+func (_c *MockDatabase_InsertOne_Call) Run(run func(ctx context.Context, collection string, data interface{})) *MockDatabase_InsertOne_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(interface{}))
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}))
 	})
 	return _c
 }
@@ -405,14 +630,165 @@ func (_c *MockDatabase_InsertOne_Call) Return(_a0 primitive.ObjectID, _a1 error)
 	return _c
 }
 
-func (_c *MockDatabase_InsertOne_Call) RunAndReturn(run func(string, interface{}) (primitive.ObjectID, error)) *MockDatabase_InsertOne_Call {
+func (_c *MockDatabase_InsertOne_Call) RunAndReturn(run func(context.Context, string, interface{}) (primitive.ObjectID, error)) *MockDatabase_InsertOne_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SLock provides a mock function with given fields: resourceID
-func (_m *MockDatabase) SLock(resourceID string) (string, error) {
-	ret := _m.Called(resourceID)
+// Migrations provides a mock function with no fields
+func (_m *MockDatabase) Migrations() []models.Migration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Migrations")
+	}
+
+	var r0 []models.Migration
+	if rf, ok := ret.Get(0).(func() []models.Migration); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Migration)
+		}
+	}
+
+	return r0
+}
+
+// MockDatabase_Migrations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Migrations'
+type MockDatabase_Migrations_Call struct {
+	*mock.Call
+}
+
+// Migrations is a helper method to define mock.On call
+func (_e *MockDatabase_Expecter) Migrations() *MockDatabase_Migrations_Call {
+	return &MockDatabase_Migrations_Call{Call: _e.mock.On("Migrations")}
+}
+
+func (_c *MockDatabase_Migrations_Call) Run(run func()) *MockDatabase_Migrations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockDatabase_Migrations_Call) Return(_a0 []models.Migration) *MockDatabase_Migrations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_Migrations_Call) RunAndReturn(run func() []models.Migration) *MockDatabase_Migrations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NextFencingToken provides a mock function with given fields: ctx, resourceID
+func (_m *MockDatabase) NextFencingToken(ctx context.Context, resourceID string) (int64, error) {
+	ret := _m.Called(ctx, resourceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextFencingToken")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, resourceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, resourceID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, resourceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_NextFencingToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NextFencingToken'
+type MockDatabase_NextFencingToken_Call struct {
+	*mock.Call
+}
+
+// NextFencingToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceID string
+func (_e *MockDatabase_Expecter) NextFencingToken(ctx interface{}, resourceID interface{}) *MockDatabase_NextFencingToken_Call {
+	return &MockDatabase_NextFencingToken_Call{Call: _e.mock.On("NextFencingToken", ctx, resourceID)}
+}
+
+func (_c *MockDatabase_NextFencingToken_Call) Run(run func(ctx context.Context, resourceID string)) *MockDatabase_NextFencingToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_NextFencingToken_Call) Return(_a0 int64, _a1 error) *MockDatabase_NextFencingToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_NextFencingToken_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *MockDatabase_NextFencingToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenewLock provides a mock function with given fields: ctx, lockID
+func (_m *MockDatabase) RenewLock(ctx context.Context, lockID string) error {
+	ret := _m.Called(ctx, lockID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewLock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, lockID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_RenewLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewLock'
+type MockDatabase_RenewLock_Call struct {
+	*mock.Call
+}
+
+// RenewLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lockID string
+func (_e *MockDatabase_Expecter) RenewLock(ctx interface{}, lockID interface{}) *MockDatabase_RenewLock_Call {
+	return &MockDatabase_RenewLock_Call{Call: _e.mock.On("RenewLock", ctx, lockID)}
+}
+
+func (_c *MockDatabase_RenewLock_Call) Run(run func(ctx context.Context, lockID string)) *MockDatabase_RenewLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_RenewLock_Call) Return(_a0 error) *MockDatabase_RenewLock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_RenewLock_Call) RunAndReturn(run func(context.Context, string) error) *MockDatabase_RenewLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SLock provides a mock function with given fields: ctx, resourceID
+func (_m *MockDatabase) SLock(ctx context.Context, resourceID string) (string, error) {
+	ret := _m.Called(ctx, resourceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SLock")
@@ -420,17 +796,17 @@ func (_m *MockDatabase) SLock(resourceID string) (string, error) {
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
-		return rf(resourceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, resourceID)
 	}
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(resourceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, resourceID)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(resourceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, resourceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -444,14 +820,15 @@ type MockDatabase_SLock_Call struct {
 }
 
 // SLock is a helper method to define mock.On call
+//   - ctx context.Context
 //   - resourceID string
-func (_e *MockDatabase_Expecter) SLock(resourceID interface{}) *MockDatabase_SLock_Call {
-	return &MockDatabase_SLock_Call{Call: _e.mock.On("SLock", resourceID)}
+func (_e *MockDatabase_Expecter) SLock(ctx interface{}, resourceID interface{}) *MockDatabase_SLock_Call {
+	return &MockDatabase_SLock_Call{Call: _e.mock.On("SLock", ctx, resourceID)}
 }
 
-func (_c *MockDatabase_SLock_Call) Run(run func(resourceID string)) *MockDatabase_SLock_Call {
+func (_c *MockDatabase_SLock_Call) Run(run func(ctx context.Context, resourceID string)) *MockDatabase_SLock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -461,22 +838,22 @@ func (_c *MockDatabase_SLock_Call) Return(_a0 string, _a1 error) *MockDatabase_S
 	return _c
 }
 
-func (_c *MockDatabase_SLock_Call) RunAndReturn(run func(string) (string, error)) *MockDatabase_SLock_Call {
+func (_c *MockDatabase_SLock_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockDatabase_SLock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Unlock provides a mock function with given fields: lockID
-func (_m *MockDatabase) Unlock(lockID string) error {
-	ret := _m.Called(lockID)
+// Unlock provides a mock function with given fields: ctx, lockID
+func (_m *MockDatabase) Unlock(ctx context.Context, lockID string) error {
+	ret := _m.Called(ctx, lockID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Unlock")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(lockID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, lockID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -490,14 +867,15 @@ type MockDatabase_Unlock_Call struct {
 }
 
 // Unlock is a helper method to define mock.On call
+//   - ctx context.Context
 //   - lockID string
-func (_e *MockDatabase_Expecter) Unlock(lockID interface{}) *MockDatabase_Unlock_Call {
-	return &MockDatabase_Unlock_Call{Call: _e.mock.On("Unlock", lockID)}
+func (_e *MockDatabase_Expecter) Unlock(ctx interface{}, lockID interface{}) *MockDatabase_Unlock_Call {
+	return &MockDatabase_Unlock_Call{Call: _e.mock.On("Unlock", ctx, lockID)}
 }
 
-func (_c *MockDatabase_Unlock_Call) Run(run func(lockID string)) *MockDatabase_Unlock_Call {
+func (_c *MockDatabase_Unlock_Call) Run(run func(ctx context.Context, lockID string)) *MockDatabase_Unlock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -507,14 +885,14 @@ func (_c *MockDatabase_Unlock_Call) Return(_a0 error) *MockDatabase_Unlock_Call
 	return _c
 }
 
-func (_c *MockDatabase_Unlock_Call) RunAndReturn(run func(string) error) *MockDatabase_Unlock_Call {
+func (_c *MockDatabase_Unlock_Call) RunAndReturn(run func(context.Context, string) error) *MockDatabase_Unlock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateOne provides a mock function with given fields: collection, filter, update
-func (_m *MockDatabase) UpdateOne(collection string, filter interface{}, update interface{}) (primitive.ObjectID, error) {
-	ret := _m.Called(collection, filter, update)
+// UpdateOne provides a mock function with given fields: ctx, collection, filter, update
+func (_m *MockDatabase) UpdateOne(ctx context.Context, collection string, filter interface{}, update interface{}) (primitive.ObjectID, error) {
+	ret := _m.Called(ctx, collection, filter, update)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateOne")
@@ -522,19 +900,19 @@ func (_m *MockDatabase) UpdateOne(collection string, filter interface{}, update
 
 	var r0 primitive.ObjectID
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, interface{}, interface{}) (primitive.ObjectID, error)); ok {
-		return rf(collection, filter, update)
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, interface{}) (primitive.ObjectID, error)); ok {
+		return rf(ctx, collection, filter, update)
 	}
-	if rf, ok := ret.Get(0).(func(string, interface{}, interface{}) primitive.ObjectID); ok {
-		r0 = rf(collection, filter, update)
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, interface{}) primitive.ObjectID); ok {
+		r0 = rf(ctx, collection, filter, update)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(primitive.ObjectID)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, interface{}, interface{}) error); ok {
-		r1 = rf(collection, filter, update)
+	if rf, ok := ret.Get(1).(func(context.Context, string, interface{}, interface{}) error); ok {
+		r1 = rf(ctx, collection, filter, update)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -548,16 +926,17 @@ type MockDatabase_UpdateOne_Call struct {
 }
 
 // UpdateOne is a helper method to define mock.On call
+//   - ctx context.Context
 //   - collection string
 //   - filter interface{}
 //   - update interface{}
-func (_e *MockDatabase_Expecter) UpdateOne(collection interface{}, filter interface{}, update interface{}) *MockDatabase_UpdateOne_Call {
-	return &MockDatabase_UpdateOne_Call{Call: _e.mock.On("UpdateOne", collection, filter, update)}
+func (_e *MockDatabase_Expecter) UpdateOne(ctx interface{}, collection interface{}, filter interface{}, update interface{}) *MockDatabase_UpdateOne_Call {
+	return &MockDatabase_UpdateOne_Call{Call: _e.mock.On("UpdateOne", ctx, collection, filter, update)}
 }
 
-func (_c *MockDatabase_UpdateOne_Call) Run(run func(collection string, filter interface{}, update interface{})) *MockDatabase_UpdateOne_Call {
+func (_c *MockDatabase_UpdateOne_Call) Run(run func(ctx context.Context, collection string, filter interface{}, update interface{})) *MockDatabase_UpdateOne_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(interface{}), args[2].(interface{}))
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}), args[3].(interface{}))
 	})
 	return _c
 }
@@ -567,14 +946,14 @@ func (_c *MockDatabase_UpdateOne_Call) Return(_a0 primitive.ObjectID, _a1 error)
 	return _c
 }
 
-func (_c *MockDatabase_UpdateOne_Call) RunAndReturn(run func(string, interface{}, interface{}) (primitive.ObjectID, error)) *MockDatabase_UpdateOne_Call {
+func (_c *MockDatabase_UpdateOne_Call) RunAndReturn(run func(context.Context, string, interface{}, interface{}) (primitive.ObjectID, error)) *MockDatabase_UpdateOne_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpsertOne provides a mock function with given fields: collection, filter, update
-func (_m *MockDatabase) UpsertOne(collection string, filter interface{}, update interface{}) (primitive.ObjectID, error) {
-	ret := _m.Called(collection, filter, update)
+// UpsertOne provides a mock function with given fields: ctx, collection, filter, update
+func (_m *MockDatabase) UpsertOne(ctx context.Context, collection string, filter interface{}, update interface{}) (primitive.ObjectID, error) {
+	ret := _m.Called(ctx, collection, filter, update)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpsertOne")
@@ -582,19 +961,19 @@ func (_m *MockDatabase) UpsertOne(collection string, filter interface{}, update
 
 	var r0 primitive.ObjectID
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, interface{}, interface{}) (primitive.ObjectID, error)); ok {
-		return rf(collection, filter, update)
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, interface{}) (primitive.ObjectID, error)); ok {
+		return rf(ctx, collection, filter, update)
 	}
-	if rf, ok := ret.Get(0).(func(string, interface{}, interface{}) primitive.ObjectID); ok {
-		r0 = rf(collection, filter, update)
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, interface{}) primitive.ObjectID); ok {
+		r0 = rf(ctx, collection, filter, update)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(primitive.ObjectID)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, interface{}, interface{}) error); ok {
-		r1 = rf(collection, filter, update)
+	if rf, ok := ret.Get(1).(func(context.Context, string, interface{}, interface{}) error); ok {
+		r1 = rf(ctx, collection, filter, update)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -608,16 +987,17 @@ type MockDatabase_UpsertOne_Call struct {
 }
 
 // UpsertOne is a helper method to define mock.On call
+//   - ctx context.Context
 //   - collection string
 //   - filter interface{}
 //   - update interface{}
-func (_e *MockDatabase_Expecter) UpsertOne(collection interface{}, filter interface{}, update interface{}) *MockDatabase_UpsertOne_Call {
-	return &MockDatabase_UpsertOne_Call{Call: _e.mock.On("UpsertOne", collection, filter, update)}
+func (_e *MockDatabase_Expecter) UpsertOne(ctx interface{}, collection interface{}, filter interface{}, update interface{}) *MockDatabase_UpsertOne_Call {
+	return &MockDatabase_UpsertOne_Call{Call: _e.mock.On("UpsertOne", ctx, collection, filter, update)}
 }
 
-func (_c *MockDatabase_UpsertOne_Call) Run(run func(collection string, filter interface{}, update interface{})) *MockDatabase_UpsertOne_Call {
+func (_c *MockDatabase_UpsertOne_Call) Run(run func(ctx context.Context, collection string, filter interface{}, update interface{})) *MockDatabase_UpsertOne_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(interface{}), args[2].(interface{}))
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}), args[3].(interface{}))
 	})
 	return _c
 }
@@ -627,14 +1007,61 @@ func (_c *MockDatabase_UpsertOne_Call) Return(_a0 primitive.ObjectID, _a1 error)
 	return _c
 }
 
-func (_c *MockDatabase_UpsertOne_Call) RunAndReturn(run func(string, interface{}, interface{}) (primitive.ObjectID, error)) *MockDatabase_UpsertOne_Call {
+func (_c *MockDatabase_UpsertOne_Call) RunAndReturn(run func(context.Context, string, interface{}, interface{}) (primitive.ObjectID, error)) *MockDatabase_UpsertOne_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockDatabase) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_WithTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTransaction'
+type MockDatabase_WithTransaction_Call struct {
+	*mock.Call
+}
+
+// WithTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockDatabase_Expecter) WithTransaction(ctx interface{}, fn interface{}) *MockDatabase_WithTransaction_Call {
+	return &MockDatabase_WithTransaction_Call{Call: _e.mock.On("WithTransaction", ctx, fn)}
+}
+
+func (_c *MockDatabase_WithTransaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockDatabase_WithTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_WithTransaction_Call) Return(_a0 error) *MockDatabase_WithTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_WithTransaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockDatabase_WithTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// XLock provides a mock function with given fields: resourceID
-func (_m *MockDatabase) XLock(resourceID string) (string, error) {
-	ret := _m.Called(resourceID)
+// XLock provides a mock function with given fields: ctx, resourceID
+func (_m *MockDatabase) XLock(ctx context.Context, resourceID string) (string, error) {
+	ret := _m.Called(ctx, resourceID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for XLock")
@@ -642,17 +1069,17 @@ func (_m *MockDatabase) XLock(resourceID string) (string, error) {
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
-		return rf(resourceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, resourceID)
 	}
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(resourceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, resourceID)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(resourceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, resourceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -666,14 +1093,15 @@ type MockDatabase_XLock_Call struct {
 }
 
 // XLock is a helper method to define mock.On call
+//   - ctx context.Context
 //   - resourceID string
-func (_e *MockDatabase_Expecter) XLock(resourceID interface{}) *MockDatabase_XLock_Call {
-	return &MockDatabase_XLock_Call{Call: _e.mock.On("XLock", resourceID)}
+func (_e *MockDatabase_Expecter) XLock(ctx interface{}, resourceID interface{}) *MockDatabase_XLock_Call {
+	return &MockDatabase_XLock_Call{Call: _e.mock.On("XLock", ctx, resourceID)}
 }
 
-func (_c *MockDatabase_XLock_Call) Run(run func(resourceID string)) *MockDatabase_XLock_Call {
+func (_c *MockDatabase_XLock_Call) Run(run func(ctx context.Context, resourceID string)) *MockDatabase_XLock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -683,7 +1111,7 @@ func (_c *MockDatabase_XLock_Call) Return(_a0 string, _a1 error) *MockDatabase_X
 	return _c
 }
 
-func (_c *MockDatabase_XLock_Call) RunAndReturn(run func(string) (string, error)) *MockDatabase_XLock_Call {
+func (_c *MockDatabase_XLock_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockDatabase_XLock_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,238 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	SigningKindMint   = "mint"
+	SigningKindRefund = "refund"
+)
+
+var (
+	ErrSigningPaused = errors.New("signing is paused")
+	ErrSigningPolicy = errors.New("signing policy violation")
+)
+
+// SigningRequest describes a signature a runner is about to produce
+type SigningRequest struct {
+	Kind      string
+	Id        string
+	Sender    string
+	Recipient string
+	Amount    math.Int
+}
+
+var timeNow = time.Now
+
+type signedAmount struct {
+	kind      string
+	recipient string
+	amount    math.Int
+	signedAt  time.Time
+}
+
+// signingLedger remembers what this validator signed in the last day, independently of the database.
+// It is rebuilt from the audit log file on startup.
+type signingLedger struct {
+	mu      sync.Mutex
+	entries map[string]signedAmount
+}
+
+var ledger = &signingLedger{entries: map[string]signedAmount{}}
+
+// SigningReservation holds the amount of a request in the rolling limits while it is signed
+type SigningReservation struct {
+	key      string
+	id       string
+	reserved bool
+}
+
+type reservationKey struct{}
+
+// ReserveSigning checks the request against Config.SigningPolicy and reserves its amount in the rolling limits,
+// so two requests signed at the same time cannot both pass a limit. Release the reservation if the request is not signed.
+// A retried request is only authorized again for the recipient and amount it was signed for.
+// Signatures recorded under the returned context are marked with the request in the audit log.
+func ReserveSigning(ctx context.Context, req SigningRequest) (*SigningReservation, context.Context, error) {
+	config := Config.SigningPolicy
+
+	if config.Paused {
+		return nil, nil, ErrSigningPaused
+	}
+	if config.PauseFile != "" {
+		if _, err := os.Stat(config.PauseFile); err == nil {
+			return nil, nil, ErrSigningPaused
+		}
+	}
+
+	limits := config.Mint
+	if req.Kind == SigningKindRefund {
+		limits = config.Refund
+	}
+
+	if len(limits.AllowedRecipients) > 0 && !containsFold(limits.AllowedRecipients, req.Recipient) {
+		return nil, nil, fmt.Errorf("%w: recipient %s is not allowed", ErrSigningPolicy, req.Recipient)
+	}
+	if containsFold(limits.DeniedRecipients, req.Recipient) {
+		return nil, nil, fmt.Errorf("%w: recipient %s is denied", ErrSigningPolicy, req.Recipient)
+	}
+	if len(limits.AllowedSenders) > 0 && !containsFold(limits.AllowedSenders, req.Sender) {
+		return nil, nil, fmt.Errorf("%w: sender %s is not allowed", ErrSigningPolicy, req.Sender)
+	}
+	if containsFold(limits.DeniedSenders, req.Sender) {
+		return nil, nil, fmt.Errorf("%w: sender %s is denied", ErrSigningPolicy, req.Sender)
+	}
+	if exceeds(req.Amount, limits.MaxAmount) {
+		return nil, nil, fmt.Errorf("%w: amount %s exceeds maximum of %d", ErrSigningPolicy, req.Amount, limits.MaxAmount)
+	}
+
+	reservation, err := ledger.reserve(req, limits)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reservation, context.WithValue(ctx, reservationKey{}, reservation), nil
+}
+
+// Release gives back the amount of a request that was not signed, a request signed before stays counted
+func (r *SigningReservation) Release() {
+	if !r.reserved {
+		return
+	}
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+	delete(ledger.entries, r.key)
+}
+
+func ledgerKey(kind string, id string) string {
+	return kind + ":" + id
+}
+
+func (l *signingLedger) reserve(req SigningRequest, limits models.SigningLimitsConfig) (*SigningReservation, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := timeNow()
+	key := ledgerKey(req.Kind, req.Id)
+	reservation := &SigningReservation{key: key, id: req.Id}
+
+	// a retried signature was already counted, but only for what it was signed for
+	if entry, ok := l.entries[key]; ok {
+		if !entry.amount.Equal(req.Amount) || !strings.EqualFold(entry.recipient, req.Recipient) {
+			return nil, fmt.Errorf("%w: %s was signed for %s to %s before", ErrSigningPolicy, req.Id, entry.amount, entry.recipient)
+		}
+		return reservation, nil
+	}
+
+	hourAmount := req.Amount
+	dayAmount := req.Amount
+	recipientHourAmount := req.Amount
+	recipientDayAmount := req.Amount
+
+	for k, entry := range l.entries {
+		age := now.Sub(entry.signedAt)
+		if age >= 24*time.Hour {
+			delete(l.entries, k)
+			continue
+		}
+		if entry.kind != req.Kind {
+			continue
+		}
+
+		sameRecipient := strings.EqualFold(entry.recipient, req.Recipient)
+		dayAmount = dayAmount.Add(entry.amount)
+		if sameRecipient {
+			recipientDayAmount = recipientDayAmount.Add(entry.amount)
+		}
+		if age < time.Hour {
+			hourAmount = hourAmount.Add(entry.amount)
+			if sameRecipient {
+				recipientHourAmount = recipientHourAmount.Add(entry.amount)
+			}
+		}
+	}
+
+	if exceeds(hourAmount, limits.MaxAmountPerHour) {
+		return nil, fmt.Errorf("%w: hourly volume %s exceeds maximum of %d", ErrSigningPolicy, hourAmount, limits.MaxAmountPerHour)
+	}
+	if exceeds(dayAmount, limits.MaxAmountPerDay) {
+		return nil, fmt.Errorf("%w: daily volume %s exceeds maximum of %d", ErrSigningPolicy, dayAmount, limits.MaxAmountPerDay)
+	}
+	if exceeds(recipientHourAmount, limits.MaxRecipientAmountPerHour) {
+		return nil, fmt.Errorf("%w: hourly volume %s for recipient %s exceeds maximum of %d", ErrSigningPolicy, recipientHourAmount, req.Recipient, limits.MaxRecipientAmountPerHour)
+	}
+	if exceeds(recipientDayAmount, limits.MaxRecipientAmountPerDay) {
+		return nil, fmt.Errorf("%w: daily volume %s for recipient %s exceeds maximum of %d", ErrSigningPolicy, recipientDayAmount, req.Recipient, limits.MaxRecipientAmountPerDay)
+	}
+
+	l.entries[key] = signedAmount{
+		kind:      req.Kind,
+		recipient: req.Recipient,
+		amount:    req.Amount,
+		signedAt:  now,
+	}
+	reservation.reserved = true
+	return reservation, nil
+}
+
+// restore counts a signature of the audit log that is less than a day old, entries written before
+// the audit log recorded the request are counted without being matched to a retried request
+func (l *signingLedger) restore(entry models.AuditEntry) {
+	if timeNow().Sub(entry.SignedAt) >= 24*time.Hour {
+		return
+	}
+	amount, ok := math.NewIntFromString(strings.TrimSuffix(entry.Amount, Config.Pocket.CoinDenom))
+	if !ok {
+		log.Warnf("[AUDIT] Invalid amount %q in audit entry %d, not counted towards the signing policy", entry.Amount, entry.Index)
+		return
+	}
+
+	key := ledgerKey(entry.Kind, entry.RequestId)
+	if entry.RequestId == "" {
+		key = ledgerKey(entry.Kind, fmt.Sprintf("audit-%d", entry.Index))
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries[key] = signedAmount{
+		kind:      entry.Kind,
+		recipient: entry.Recipient,
+		amount:    amount,
+		signedAt:  entry.SignedAt,
+	}
+}
+
+// signingRequestId returns the id of the request reserved in ctx
+func signingRequestId(ctx context.Context) string {
+	if r, ok := ctx.Value(reservationKey{}).(*SigningReservation); ok {
+		return r.id
+	}
+	return ""
+}
+
+func hasRollingLimits(limits models.SigningLimitsConfig) bool {
+	return limits.MaxAmountPerHour > 0 || limits.MaxAmountPerDay > 0 || limits.MaxRecipientAmountPerHour > 0 || limits.MaxRecipientAmountPerDay > 0
+}
+
+func exceeds(amount math.Int, limit int64) bool {
+	return limit > 0 && amount.GT(math.NewInt(limit))
+}
+
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package app
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// resetSigningPolicy sets the policy and an empty signing ledger
+func resetSigningPolicy(t *testing.T, config models.SigningPolicyConfig) {
+	Config.SigningPolicy = config
+	timeNow = time.Now
+	ledger = &signingLedger{entries: map[string]signedAmount{}}
+}
+
+func newSigningRequest(id string, recipient string, amount int64) SigningRequest {
+	return SigningRequest{
+		Kind:      SigningKindMint,
+		Id:        id,
+		Sender:    "pokt1sender",
+		Recipient: recipient,
+		Amount:    math.NewInt(amount),
+	}
+}
+
+// sign reserves the request like a signer does before a signature that is produced
+func sign(req SigningRequest) error {
+	_, _, err := ReserveSigning(context.Background(), req)
+	return err
+}
+
+func TestReserveSigning(t *testing.T) {
+	defer func() { Config.SigningPolicy = models.SigningPolicyConfig{}; timeNow = time.Now }()
+
+	t.Run("No limits", func(t *testing.T) {
+		resetSigningPolicy(t, models.SigningPolicyConfig{})
+		assert.NoError(t, sign(newSigningRequest("1", "0xrecipient", 1000000)))
+	})
+
+	t.Run("Paused", func(t *testing.T) {
+		resetSigningPolicy(t, models.SigningPolicyConfig{Paused: true})
+		assert.ErrorIs(t, sign(newSigningRequest("1", "0xrecipient", 1)), ErrSigningPaused)
+	})
+
+	t.Run("Pause file", func(t *testing.T) {
+		pauseFile := filepath.Join(t.TempDir(), "paused")
+		resetSigningPolicy(t, models.SigningPolicyConfig{PauseFile: pauseFile})
+		assert.NoError(t, sign(newSigningRequest("1", "0xrecipient", 1)))
+
+		assert.NoError(t, os.WriteFile(pauseFile, nil, 0600))
+		assert.ErrorIs(t, sign(newSigningRequest("2", "0xrecipient", 1)), ErrSigningPaused)
+	})
+
+	t.Run("Max amount", func(t *testing.T) {
+		resetSigningPolicy(t, models.SigningPolicyConfig{Mint: models.SigningLimitsConfig{MaxAmount: 100}})
+		assert.NoError(t, sign(newSigningRequest("1", "0xrecipient", 100)))
+		assert.ErrorIs(t, sign(newSigningRequest("2", "0xrecipient", 101)), ErrSigningPolicy)
+	})
+
+	t.Run("Limits apply per kind", func(t *testing.T) {
+		resetSigningPolicy(t, models.SigningPolicyConfig{Refund: models.SigningLimitsConfig{MaxAmount: 100}})
+		assert.NoError(t, sign(newSigningRequest("1", "0xrecipient", 101)))
+
+		req := newSigningRequest("2", "pokt1recipient", 101)
+		req.Kind = SigningKindRefund
+		assert.ErrorIs(t, sign(req), ErrSigningPolicy)
+	})
+
+	t.Run("Recipient lists", func(t *testing.T) {
+		resetSigningPolicy(t, models.SigningPolicyConfig{Mint: models.SigningLimitsConfig{
+			AllowedRecipients: []string{"0xAllowed", "0xDenied"},
+			DeniedRecipients:  []string{"0xdenied"},
+		}})
+		assert.NoError(t, sign(newSigningRequest("1", "0xallowed", 1)))
+		assert.ErrorIs(t, sign(newSigningRequest("2", "0xother", 1)), ErrSigningPolicy)
+		assert.ErrorIs(t, sign(newSigningRequest("3", "0xdenied", 1)), ErrSigningPolicy)
+	})
+
+	t.Run("Sender lists", func(t *testing.T) {
+		resetSigningPolicy(t, models.SigningPolicyConfig{Mint: models.SigningLimitsConfig{DeniedSenders: []string{"pokt1sender"}}})
+		assert.ErrorIs(t, sign(newSigningRequest("1", "0xrecipient", 1)), ErrSigningPolicy)
+
+		resetSigningPolicy(t, models.SigningPolicyConfig{Mint: models.SigningLimitsConfig{AllowedSenders: []string{"pokt1other"}}})
+		assert.ErrorIs(t, sign(newSigningRequest("1", "0xrecipient", 1)), ErrSigningPolicy)
+	})
+
+	t.Run("Rolling windows", func(t *testing.T) {
+		resetSigningPolicy(t, models.SigningPolicyConfig{Mint: models.SigningLimitsConfig{
+			MaxAmountPerHour:          300,
+			MaxAmountPerDay:           500,
+			MaxRecipientAmountPerHour: 200,
+		}})
+		now := time.Now()
+		timeNow = func() time.Time { return now }
+
+		assert.NoError(t, sign(newSigningRequest("1", "0xa", 150)))
+		assert.ErrorIs(t, sign(newSigningRequest("2", "0xa", 100)), ErrSigningPolicy)
+		assert.NoError(t, sign(newSigningRequest("3", "0xb", 150)))
+		assert.ErrorIs(t, sign(newSigningRequest("4", "0xc", 1)), ErrSigningPolicy)
+
+		// a retried signature is not counted twice, but only for what it was signed for
+		assert.NoError(t, sign(newSigningRequest("1", "0xa", 150)))
+		assert.ErrorIs(t, sign(newSigningRequest("1", "0xa", 151)), ErrSigningPolicy)
+		assert.ErrorIs(t, sign(newSigningRequest("1", "0xb", 150)), ErrSigningPolicy)
+
+		now = now.Add(time.Hour)
+		assert.NoError(t, sign(newSigningRequest("5", "0xc", 200)))
+		assert.ErrorIs(t, sign(newSigningRequest("6", "0xd", 1)), ErrSigningPolicy)
+
+		now = now.Add(24 * time.Hour)
+		assert.NoError(t, sign(newSigningRequest("6", "0xd", 200)))
+	})
+
+	t.Run("Released reservation is not counted", func(t *testing.T) {
+		resetSigningPolicy(t, models.SigningPolicyConfig{Mint: models.SigningLimitsConfig{MaxAmountPerDay: 100}})
+
+		reservation, _, err := ReserveSigning(context.Background(), newSigningRequest("1", "0xa", 100))
+		assert.NoError(t, err)
+		assert.ErrorIs(t, sign(newSigningRequest("2", "0xa", 100)), ErrSigningPolicy)
+
+		reservation.Release()
+		assert.NoError(t, sign(newSigningRequest("2", "0xa", 100)))
+		assert.ErrorIs(t, sign(newSigningRequest("3", "0xa", 1)), ErrSigningPolicy)
+	})
+
+	t.Run("Released retry stays counted", func(t *testing.T) {
+		resetSigningPolicy(t, models.SigningPolicyConfig{Mint: models.SigningLimitsConfig{MaxAmountPerDay: 100}})
+
+		assert.NoError(t, sign(newSigningRequest("1", "0xa", 100)))
+		reservation, _, err := ReserveSigning(context.Background(), newSigningRequest("1", "0xa", 100))
+		assert.NoError(t, err)
+		reservation.Release()
+
+		assert.ErrorIs(t, sign(newSigningRequest("2", "0xa", 1)), ErrSigningPolicy)
+	})
+
+	t.Run("Concurrent requests", func(t *testing.T) {
+		resetSigningPolicy(t, models.SigningPolicyConfig{Mint: models.SigningLimitsConfig{MaxAmountPerDay: 500}})
+
+		var wg sync.WaitGroup
+		var signed atomic.Int64
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if sign(newSigningRequest(strconv.Itoa(i), "0xa", 100)) == nil {
+					signed.Add(1)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		assert.Equal(t, int64(5), signed.Load())
+	})
+}
+
+func TestSigningLedgerFromAuditLog(t *testing.T) {
+	defer func() {
+		auditLog = nil
+		Config.AuditLog = models.AuditLogConfig{}
+		Config.SigningPolicy = models.SigningPolicyConfig{}
+		timeNow = time.Now
+	}()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	Config.AuditLog = models.AuditLogConfig{File: path}
+	resetSigningPolicy(t, models.SigningPolicyConfig{Mint: models.SigningLimitsConfig{MaxAmountPerDay: 300}})
+	InitAuditLog()
+
+	record := func(req SigningRequest) {
+		_, signCtx, err := ReserveSigning(context.Background(), req)
+		assert.NoError(t, err)
+		assert.NoError(t, RecordSignature(signCtx, models.AuditEntry{Kind: req.Kind, Recipient: req.Recipient, Amount: req.Amount.String()}))
+	}
+	record(newSigningRequest("1", "0xa", 100))
+	record(newSigningRequest("2", "0xa", 100))
+	assert.NoError(t, auditLog.Close())
+
+	last, err := VerifyAuditLog(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", last.RequestId)
+
+	// a restart starts from the audit log, not from an empty ledger
+	ledger = &signingLedger{entries: map[string]signedAmount{}}
+	InitAuditLog()
+
+	assert.NoError(t, sign(newSigningRequest("1", "0xa", 100)))
+	assert.ErrorIs(t, sign(newSigningRequest("1", "0xb", 100)), ErrSigningPolicy)
+	assert.ErrorIs(t, sign(newSigningRequest("3", "0xa", 101)), ErrSigningPolicy)
+	assert.NoError(t, sign(newSigningRequest("3", "0xa", 100)))
+
+	// signatures older than a day are not counted
+	ledger = &signingLedger{entries: map[string]signedAmount{}}
+	timeNow = func() time.Time { return time.Now().Add(24 * time.Hour) }
+	assert.NoError(t, auditLog.Close())
+	InitAuditLog()
+	assert.Empty(t, ledger.entries)
+	assert.NoError(t, auditLog.Close())
+}
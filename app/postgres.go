@@ -0,0 +1,777 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+	lock "github.com/square/mongo-lock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// PostgresDatabase stores every collection as a table of mongo extended json documents,
+// so the runners can use the same filters and updates as with mongo
+type PostgresDatabase struct {
+	db      *sql.DB
+	uri     string
+	timeout time.Duration
+
+	mu    sync.Mutex
+	locks map[string]postgresLock
+
+	logger *log.Entry
+}
+
+// postgresLock is an advisory lock held by a dedicated connection,
+// it is released by the server if the connection is lost
+type postgresLock struct {
+	conn     *sql.Conn
+	resource string
+	shared   bool
+}
+
+type postgresMigration struct {
+	models.Migration
+	statements []string
+}
+
+var postgresMigrations = []postgresMigration{
+	{
+		Migration: models.Migration{Version: 1, Description: "create mints, invalid mints, burns and health checks"},
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS "mints" (id TEXT PRIMARY KEY, doc JSONB NOT NULL)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "mints_transaction_hash" ON "mints" ((doc -> 'transaction_hash'))`,
+			`CREATE TABLE IF NOT EXISTS "invalidMints" (id TEXT PRIMARY KEY, doc JSONB NOT NULL)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "invalidMints_transaction_hash" ON "invalidMints" ((doc -> 'transaction_hash'))`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "invalidMints_sequence" ON "invalidMints" ((doc -> 'sequence')) WHERE jsonb_typeof(doc -> 'sequence') = 'number'`,
+			`CREATE TABLE IF NOT EXISTS "burns" (id TEXT PRIMARY KEY, doc JSONB NOT NULL)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "burns_transaction_hash_log_index" ON "burns" ((doc -> 'transaction_hash'), (doc -> 'log_index'))`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "burns_sequence" ON "burns" ((doc -> 'sequence')) WHERE jsonb_typeof(doc -> 'sequence') = 'number'`,
+			`CREATE TABLE IF NOT EXISTS "healthchecks" (id TEXT PRIMARY KEY, doc JSONB NOT NULL)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "healthchecks_validator_id_hostname" ON "healthchecks" ((doc -> 'validator_id'), (doc -> 'hostname'))`,
+		},
+	},
+	{
+		Migration: models.Migration{Version: 2, Description: "create checkpoints, discrepancies and audit log"},
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS "checkpoints" (id TEXT PRIMARY KEY, doc JSONB NOT NULL)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "checkpoints_validator_id_service_address" ON "checkpoints" ((doc -> 'validator_id'), (doc -> 'service'), (doc -> 'address'))`,
+			`CREATE TABLE IF NOT EXISTS "discrepancies" (id TEXT PRIMARY KEY, doc JSONB NOT NULL)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "discrepancies_validator_id_collection_transaction_hash_field" ON "discrepancies" ((doc -> 'validator_id'), (doc -> 'collection'), (doc -> 'transaction_hash'), (doc -> 'field'))`,
+			`CREATE TABLE IF NOT EXISTS "audit_log" (id TEXT PRIMARY KEY, doc JSONB NOT NULL)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "audit_log_signer_hash" ON "audit_log" ((doc -> 'signer'), (doc -> 'hash'))`,
+		},
+	},
+	{
+		Migration: models.Migration{Version: 3, Description: "create fencing tokens"},
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS "fencing_tokens" (resource TEXT PRIMARY KEY, token BIGINT NOT NULL)`,
+		},
+	},
+	{
+		Migration: models.Migration{Version: 4, Description: "create admin actions"},
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS "admin_actions" (id TEXT PRIMARY KEY, doc JSONB NOT NULL)`,
+			`CREATE INDEX IF NOT EXISTS "admin_actions_collection_document_id" ON "admin_actions" ((doc -> 'collection'), (doc -> 'document_id'))`,
+		},
+	},
+	{
+		Migration: models.Migration{Version: 5, Description: "create signing ledger"},
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS "signing_ledger" (id TEXT PRIMARY KEY, doc JSONB NOT NULL)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "signing_ledger_signer_kind_request_id" ON "signing_ledger" ((doc -> 'signer'), (doc -> 'kind'), (doc -> 'request_id'))`,
+		},
+	},
+	{
+		Migration: models.Migration{Version: 6, Description: "create deposits"},
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS "deposits" (id TEXT PRIMARY KEY, doc JSONB NOT NULL)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "deposits_transaction_hash" ON "deposits" ((doc -> 'transaction_hash'))`,
+		},
+	},
+	{
+		Migration: models.Migration{Version: 7, Description: "drop signing ledger"},
+		statements: []string{
+			`DROP TABLE IF EXISTS "signing_ledger"`,
+		},
+	},
+}
+
+// Connect connects to the database
+func (d *PostgresDatabase) Connect() error {
+	d.logger.Debug("[DB] Connecting to database")
+
+	db, err := sql.Open("postgres", d.uri)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		//nolint:errcheck
+		db.Close()
+		return err
+	}
+	d.db = db
+
+	d.logger.Info("[DB] Connected to postgres database")
+	return nil
+}
+
+// Migrations returns the migrations known to this binary
+func (d *PostgresDatabase) Migrations() []models.Migration {
+	migrations := make([]models.Migration, len(postgresMigrations))
+	for i, m := range postgresMigrations {
+		migrations[i] = m.Migration
+	}
+	return migrations
+}
+
+// AppliedMigrations returns the migrations recorded in the database
+func (d *PostgresDatabase) AppliedMigrations(ctx context.Context) ([]models.SchemaMigration, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	applied := []models.SchemaMigration{}
+	var exists bool
+	if err := d.db.QueryRowContext(ctx, `SELECT to_regclass('"schema_migrations"') IS NOT NULL`).Scan(&exists); err != nil || !exists {
+		return applied, err
+	}
+
+	rows, err := d.db.QueryContext(ctx, `SELECT version, description, applied_at FROM "schema_migrations" ORDER BY version`)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:errcheck
+	defer rows.Close()
+	for rows.Next() {
+		var m models.SchemaMigration
+		if err := rows.Scan(&m.Version, &m.Description, &m.AppliedAt); err != nil {
+			return nil, err
+		}
+		applied = append(applied, m)
+	}
+	return applied, rows.Err()
+}
+
+// ApplyMigration runs a migration and records it in one transaction, one validator at a time
+func (d *PostgresDatabase) ApplyMigration(ctx context.Context, migration models.Migration) error {
+	var statements []string
+	for _, m := range postgresMigrations {
+		if m.Version == migration.Version {
+			statements = m.statements
+		}
+	}
+	if statements == nil {
+		return fmt.Errorf("unknown migration %d", migration.Version)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtextextended('wpokt-validator/migrations', 0))`); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS "schema_migrations" (version BIGINT PRIMARY KEY, description TEXT NOT NULL, applied_at TIMESTAMPTZ NOT NULL)`); err != nil {
+		return err
+	}
+
+	var applied bool
+	if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM "schema_migrations" WHERE version = $1)`, migration.Version).Scan(&applied); err != nil {
+		return err
+	}
+	if applied {
+		// applied by another validator while waiting for the lock
+		return nil
+	}
+
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO "schema_migrations" (version, description, applied_at) VALUES ($1, $2, $3)`, migration.Version, migration.Description, time.Now()); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Disconnect disconnects from the database, releasing any locks still held
+func (d *PostgresDatabase) Disconnect() error {
+	d.logger.Debug("[DB] Disconnecting from database")
+
+	d.mu.Lock()
+	for lockID, l := range d.locks {
+		//nolint:errcheck
+		l.conn.Close()
+		delete(d.locks, lockID)
+	}
+	d.mu.Unlock()
+
+	err := d.db.Close()
+	d.logger.Info("[DB] Disconnected from database")
+	return err
+}
+
+// PostgresTransactionAttempts is how many times a transaction is run before a serialization failure is returned
+var PostgresTransactionAttempts = 5
+
+type postgresTxKey struct{}
+
+// postgresQuerier is either the database or the transaction of a WithTransaction call
+type postgresQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func postgresTx(ctx context.Context) *sql.Tx {
+	tx, _ := ctx.Value(postgresTxKey{}).(*sql.Tx)
+	return tx
+}
+
+func (d *PostgresDatabase) querier(ctx context.Context) postgresQuerier {
+	if tx := postgresTx(ctx); tx != nil {
+		return tx
+	}
+	return d.db
+}
+
+// isSerializationFailure reports errors that are resolved by running the transaction again
+func isSerializationFailure(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && (pqErr.Code == "40001" || pqErr.Code == "40P01")
+}
+
+// WithTransaction runs fn in a serializable transaction, retrying it on serialization failures.
+// Operations that use the ctx passed to fn are part of the transaction.
+func (d *PostgresDatabase) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if postgresTx(ctx) != nil {
+		// already in a transaction
+		return fn(ctx)
+	}
+
+	var err error
+	for attempt := 1; attempt <= PostgresTransactionAttempts; attempt++ {
+		err = d.runTransaction(ctx, fn)
+		if !isSerializationFailure(err) {
+			return err
+		}
+		d.logger.Debugf("[DB] Retrying transaction after attempt %d: %s", attempt, err)
+	}
+	return err
+}
+
+func (d *PostgresDatabase) runTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := d.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, postgresTxKey{}, tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func postgresTable(collection string) string {
+	return pq.QuoteIdentifier(collection)
+}
+
+// postgresError reports unique violations as mongo duplicate key errors, which the runners check for
+func postgresError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000, Message: pqErr.Message}}}
+	}
+	return err
+}
+
+func decodeDocument(raw []byte, result interface{}) error {
+	return bson.UnmarshalExtJSON(raw, false, result)
+}
+
+// decodeDocuments decodes every row into a new element of the slice that result points to
+func decodeDocuments(rows *sql.Rows, result interface{}) error {
+	//nolint:errcheck
+	defer rows.Close()
+
+	slice := reflect.ValueOf(result)
+	if slice.Kind() != reflect.Ptr || slice.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("result must be a pointer to a slice")
+	}
+	slice = slice.Elem()
+	elems := reflect.MakeSlice(slice.Type(), 0, 0)
+
+	for rows.Next() {
+		var raw []byte
+		if err := rows.Scan(&raw); err != nil {
+			return err
+		}
+		elem := reflect.New(slice.Type().Elem())
+		if err := decodeDocument(raw, elem.Interface()); err != nil {
+			return err
+		}
+		elems = reflect.Append(elems, elem.Elem())
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	slice.Set(elems)
+	return nil
+}
+
+// documentID returns the _id of a document, adding a new one if it has none
+func documentID(doc bson.D) (bson.D, primitive.ObjectID, error) {
+	for _, e := range doc {
+		if e.Key == "_id" {
+			id, ok := e.Value.(primitive.ObjectID)
+			if !ok {
+				return nil, primitive.NilObjectID, fmt.Errorf("unsupported _id type %T", e.Value)
+			}
+			return doc, id, nil
+		}
+	}
+	id := primitive.NewObjectID()
+	return append(bson.D{{Key: "_id", Value: id}}, doc...), id, nil
+}
+
+func (d *PostgresDatabase) insertDocument(ctx context.Context, q postgresQuerier, collection string, doc bson.D) (primitive.ObjectID, error) {
+	doc, id, err := documentID(doc)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	bz, err := bson.MarshalExtJSON(doc, false, false)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	_, err = q.ExecContext(ctx, fmt.Sprintf("INSERT INTO %s (id, doc) VALUES ($1, $2::jsonb)", postgresTable(collection)), id.Hex(), string(bz))
+	if err != nil {
+		return primitive.NilObjectID, postgresError(err)
+	}
+	return id, nil
+}
+
+// method for insert single value in a collection
+func (d *PostgresDatabase) InsertOne(ctx context.Context, collection string, data interface{}) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	doc, err := normalize(data)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return d.insertDocument(ctx, d.querier(ctx), collection, doc)
+}
+
+func (d *PostgresDatabase) selectQuery(collection string, filter interface{}, sort interface{}, skip int64, limit int64) (string, []interface{}, error) {
+	f, err := normalize(filter)
+	if err != nil {
+		return "", nil, err
+	}
+	s, err := normalize(sort)
+	if err != nil {
+		return "", nil, err
+	}
+
+	q := &pgQuery{}
+	where, err := q.where("doc", f)
+	if err != nil {
+		return "", nil, err
+	}
+	orderBy, err := q.orderBy(s)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query := fmt.Sprintf("SELECT doc FROM %s WHERE %s%s", postgresTable(collection), where, orderBy)
+	if limit > 0 {
+		query += " LIMIT " + q.arg(limit)
+	}
+	if skip > 0 {
+		query += " OFFSET " + q.arg(skip)
+	}
+	return query, q.args, nil
+}
+
+// method for find single value in a collection
+func (d *PostgresDatabase) FindOne(ctx context.Context, collection string, filter interface{}, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	query, args, err := d.selectQuery(collection, filter, nil, 0, 1)
+	if err != nil {
+		return err
+	}
+
+	var raw []byte
+	err = d.querier(ctx).QueryRowContext(ctx, query, args...).Scan(&raw)
+	if err == sql.ErrNoRows {
+		return mongo.ErrNoDocuments
+	}
+	if err != nil {
+		return err
+	}
+	return decodeDocument(raw, result)
+}
+
+func (d *PostgresDatabase) findMany(ctx context.Context, collection string, filter interface{}, sort interface{}, skip int64, limit int64, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	query, args, err := d.selectQuery(collection, filter, sort, skip, limit)
+	if err != nil {
+		return err
+	}
+
+	rows, err := d.querier(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	return decodeDocuments(rows, result)
+}
+
+// method for find multiple values in a collection
+func (d *PostgresDatabase) FindMany(ctx context.Context, collection string, filter interface{}, result interface{}) error {
+	return d.findMany(ctx, collection, filter, nil, 0, 0, result)
+}
+
+// method for find and sort multiple values in a collection
+func (d *PostgresDatabase) FindManySorted(ctx context.Context, collection string, filter interface{}, sort interface{}, result interface{}) error {
+	return d.findMany(ctx, collection, filter, sort, 0, 0, result)
+}
+
+// method for find, sort and paginate multiple values in a collection
+func (d *PostgresDatabase) FindManyPaged(ctx context.Context, collection string, filter interface{}, sort interface{}, skip int64, limit int64, result interface{}) error {
+	return d.findMany(ctx, collection, filter, sort, skip, limit, result)
+}
+
+// method for counting the documents matching a filter in a collection
+func (d *PostgresDatabase) CountDocuments(ctx context.Context, collection string, filter interface{}) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	f, err := normalize(filter)
+	if err != nil {
+		return 0, err
+	}
+	q := &pgQuery{}
+	where, err := q.where("doc", f)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	err = d.querier(ctx).QueryRowContext(ctx, fmt.Sprintf("SELECT count(*) FROM %s WHERE %s", postgresTable(collection), where), q.args...).Scan(&count)
+	return count, err
+}
+
+func (d *PostgresDatabase) aggregate(ctx context.Context, collection string, pipeline interface{}) (*sql.Rows, error) {
+	stages, err := normalizeArray(pipeline)
+	if err != nil {
+		return nil, err
+	}
+	q := &pgQuery{}
+	query, err := q.aggregate(postgresTable(collection), stages)
+	if err != nil {
+		return nil, err
+	}
+	return d.querier(ctx).QueryContext(ctx, query, q.args...)
+}
+
+// Aggregate One
+func (d *PostgresDatabase) AggregateOne(ctx context.Context, collection string, pipeline interface{}, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	rows, err := d.aggregate(ctx, collection, pipeline)
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck
+	defer rows.Close()
+
+	var num int
+	for rows.Next() {
+		num++
+		var raw []byte
+		if err := rows.Scan(&raw); err != nil {
+			return err
+		}
+		if err := decodeDocument(raw, result); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if num > 1 {
+		return fmt.Errorf("expected 1 result, got %d", num)
+	}
+	if num == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// Aggregate Many
+func (d *PostgresDatabase) AggregateMany(ctx context.Context, collection string, pipeline interface{}, result interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	rows, err := d.aggregate(ctx, collection, pipeline)
+	if err != nil {
+		return err
+	}
+	return decodeDocuments(rows, result)
+}
+
+// updateOne applies the update to the first document matching the filter inside a transaction,
+// inserting a new document when upsert is set and nothing matches
+func (d *PostgresDatabase) updateOne(ctx context.Context, collection string, filter interface{}, update interface{}, upsert bool) (primitive.ObjectID, error) {
+	if tx := postgresTx(ctx); tx != nil {
+		return d.updateOneInTx(ctx, tx, collection, filter, update, upsert)
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	//nolint:errcheck
+	defer tx.Rollback()
+
+	id, err := d.updateOneInTx(ctx, tx, collection, filter, update, upsert)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return id, tx.Commit()
+}
+
+func (d *PostgresDatabase) updateOneInTx(ctx context.Context, tx *sql.Tx, collection string, filter interface{}, update interface{}, upsert bool) (primitive.ObjectID, error) {
+	f, err := normalize(filter)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	u, err := normalize(update)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	q := &pgQuery{}
+	where, err := q.where("doc", f)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	var id string
+	var raw []byte
+	err = tx.QueryRowContext(ctx, fmt.Sprintf("SELECT id, doc FROM %s WHERE %s LIMIT 1 FOR UPDATE", postgresTable(collection), where), q.args...).Scan(&id, &raw)
+	if err == sql.ErrNoRows {
+		if !upsert {
+			return primitive.NilObjectID, mongo.ErrNoDocuments
+		}
+		doc, err := upsertDocument(f)
+		if err != nil {
+			return primitive.NilObjectID, err
+		}
+		if doc, err = applyUpdate(doc, u, true); err != nil {
+			return primitive.NilObjectID, err
+		}
+		insertedID, err := d.insertDocument(ctx, tx, collection, doc)
+		if err != nil {
+			return primitive.NilObjectID, err
+		}
+		return insertedID, nil
+	}
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	var doc bson.D
+	if err := decodeDocument(raw, &doc); err != nil {
+		return primitive.NilObjectID, err
+	}
+	if doc, err = applyUpdate(doc, u, false); err != nil {
+		return primitive.NilObjectID, err
+	}
+	doc, updatedID, err := documentID(doc)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	bz, err := bson.MarshalExtJSON(doc, false, false)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET doc = $1::jsonb WHERE id = $2", postgresTable(collection)), string(bz), id); err != nil {
+		return primitive.NilObjectID, postgresError(err)
+	}
+	return updatedID, nil
+}
+
+// method for update single value in a collection
+func (d *PostgresDatabase) UpdateOne(ctx context.Context, collection string, filter interface{}, update interface{}) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return d.updateOne(ctx, collection, filter, update, false)
+}
+
+// method for upsert single value in a collection
+func (d *PostgresDatabase) UpsertOne(ctx context.Context, collection string, filter interface{}, update interface{}) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	id, err := d.updateOne(ctx, collection, filter, update, true)
+	if mongo.IsDuplicateKeyError(err) && postgresTx(ctx) == nil {
+		// another validator inserted the document first, update it instead
+		return d.updateOne(ctx, collection, filter, update, true)
+	}
+	return id, err
+}
+
+func (d *PostgresDatabase) tryLock(ctx context.Context, resourceID string, shared bool) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	lockID, err := randomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	query := "SELECT pg_try_advisory_lock(hashtextextended($1, 0))"
+	if shared {
+		query = "SELECT pg_try_advisory_lock_shared(hashtextextended($1, 0))"
+	}
+	var locked bool
+	if err := conn.QueryRowContext(ctx, query, resourceID).Scan(&locked); err != nil {
+		//nolint:errcheck
+		conn.Close()
+		return "", err
+	}
+	if !locked {
+		//nolint:errcheck
+		conn.Close()
+		return "", lock.ErrAlreadyLocked
+	}
+
+	d.mu.Lock()
+	d.locks[lockID] = postgresLock{conn: conn, resource: resourceID, shared: shared}
+	d.mu.Unlock()
+
+	return lockID, nil
+}
+
+// XLock locks a resource for exclusive access
+func (d *PostgresDatabase) XLock(ctx context.Context, resourceID string) (string, error) {
+	return d.tryLock(ctx, resourceID, false)
+}
+
+// SLock locks a resource for shared access
+func (d *PostgresDatabase) SLock(ctx context.Context, resourceID string) (string, error) {
+	return d.tryLock(ctx, resourceID, true)
+}
+
+// Unlock unlocks a resource, even if ctx has already been cancelled
+func (d *PostgresDatabase) Unlock(ctx context.Context, lockID string) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), d.timeout)
+	defer cancel()
+
+	d.mu.Lock()
+	l, ok := d.locks[lockID]
+	delete(d.locks, lockID)
+	d.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("lock %s not found", lockID)
+	}
+
+	query := "SELECT pg_advisory_unlock(hashtextextended($1, 0))"
+	if l.shared {
+		query = "SELECT pg_advisory_unlock_shared(hashtextextended($1, 0))"
+	}
+	var unlocked bool
+	err := l.conn.QueryRowContext(ctx, query, l.resource).Scan(&unlocked)
+	if err == nil && !unlocked {
+		err = fmt.Errorf("lock %s was not held", lockID)
+	}
+	if err != nil {
+		// drop the connection so the server releases the lock with the session
+		//nolint:errcheck
+		l.conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+	}
+	//nolint:errcheck
+	l.conn.Close()
+	return err
+}
+
+// RenewLock checks that the connection holding the lock is still open, the lock does not expire otherwise
+func (d *PostgresDatabase) RenewLock(ctx context.Context, lockID string) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	d.mu.Lock()
+	l, ok := d.locks[lockID]
+	d.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("lock %s not found", lockID)
+	}
+	return l.conn.PingContext(ctx)
+}
+
+// NextFencingToken increments and returns the fencing token of a resource
+func (d *PostgresDatabase) NextFencingToken(ctx context.Context, resourceID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	var token int64
+	err := d.db.QueryRowContext(ctx, `INSERT INTO "fencing_tokens" (resource, token) VALUES ($1, 1)
+		ON CONFLICT (resource) DO UPDATE SET token = "fencing_tokens".token + 1 RETURNING token`, resourceID).Scan(&token)
+	return token, err
+}
+
+func newPostgresDatabase() *PostgresDatabase {
+	return &PostgresDatabase{
+		uri:     Config.Postgres.URI,
+		timeout: time.Duration(Config.Postgres.TimeoutMillis) * time.Millisecond,
+		locks:   make(map[string]postgresLock),
+		logger:  log.WithFields(log.Fields{"module": "database"}),
+	}
+}
+
+func initPostgresDB() Database {
+	db := newPostgresDatabase()
+
+	err := db.Connect()
+	if err != nil {
+		db.logger.Fatal("[DB] Failed to connect to database: ", err)
+	}
+
+	return db
+}
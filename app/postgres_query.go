@@ -0,0 +1,579 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// The postgres backend stores every document as mongo extended json in a jsonb column,
+// and translates the subset of the mongo query language used by the runners into sql.
+
+// pgQuery collects the arguments of a statement while its sql is built
+type pgQuery struct {
+	args    []interface{}
+	aliases int
+}
+
+func (q *pgQuery) arg(v interface{}) string {
+	q.args = append(q.args, v)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// normalize converts a filter, update, sort or pipeline into bson.D and bson.A values
+func normalize(v interface{}) (bson.D, error) {
+	if v == nil {
+		return bson.D{}, nil
+	}
+	bz, err := bson.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(bz, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// normalizeArray converts a pipeline or another list into bson.A values
+func normalizeArray(v interface{}) (bson.A, error) {
+	doc, err := normalize(bson.M{"v": v})
+	if err != nil {
+		return nil, err
+	}
+	arr, ok := doc[0].Value.(bson.A)
+	if !ok {
+		return nil, fmt.Errorf("expected an array, got %T", doc[0].Value)
+	}
+	return arr, nil
+}
+
+// jsonValue encodes a single value the same way it is stored in a document
+func jsonValue(v interface{}) (string, error) {
+	bz, err := bson.MarshalExtJSON(bson.D{{Key: "v", Value: v}}, false, false)
+	if err != nil {
+		return "", err
+	}
+	var wrapped map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &wrapped); err != nil {
+		return "", err
+	}
+	return string(wrapped["v"]), nil
+}
+
+func isOperatorDoc(doc bson.D) bool {
+	return len(doc) > 0 && strings.HasPrefix(doc[0].Key, "$")
+}
+
+func (q *pgQuery) path(base string, key string) string {
+	return fmt.Sprintf("(%s #> %s::text[])", base, q.arg(pq.Array(strings.Split(key, "."))))
+}
+
+// where translates a filter into a condition on the jsonb expression base
+func (q *pgQuery) where(base string, filter bson.D) (string, error) {
+	if len(filter) == 0 {
+		return "TRUE", nil
+	}
+
+	conds := make([]string, 0, len(filter))
+	for _, e := range filter {
+		switch e.Key {
+		case "$and", "$or", "$nor":
+			filters, ok := e.Value.(bson.A)
+			if !ok || len(filters) == 0 {
+				return "", fmt.Errorf("%s expects a non-empty array", e.Key)
+			}
+			subs := make([]string, len(filters))
+			for i, f := range filters {
+				doc, ok := f.(bson.D)
+				if !ok {
+					return "", fmt.Errorf("%s expects documents", e.Key)
+				}
+				sub, err := q.where(base, doc)
+				if err != nil {
+					return "", err
+				}
+				subs[i] = sub
+			}
+			switch e.Key {
+			case "$and":
+				conds = append(conds, "("+strings.Join(subs, " AND ")+")")
+			case "$or":
+				conds = append(conds, "("+strings.Join(subs, " OR ")+")")
+			default:
+				conds = append(conds, "NOT ("+strings.Join(subs, " OR ")+")")
+			}
+		default:
+			if strings.HasPrefix(e.Key, "$") {
+				return "", fmt.Errorf("unsupported query operator %s", e.Key)
+			}
+			cond, err := q.field(q.path(base, e.Key), e.Value)
+			if err != nil {
+				return "", err
+			}
+			conds = append(conds, cond)
+		}
+	}
+	return "(" + strings.Join(conds, " AND ") + ")", nil
+}
+
+func (q *pgQuery) field(x string, value interface{}) (string, error) {
+	ops, ok := value.(bson.D)
+	if !ok || !isOperatorDoc(ops) {
+		return q.eq(x, value)
+	}
+
+	conds := make([]string, 0, len(ops))
+	for _, op := range ops {
+		var cond string
+		var err error
+		switch op.Key {
+		case "$eq":
+			cond, err = q.eq(x, op.Value)
+		case "$ne":
+			cond, err = q.eq(x, op.Value)
+			cond = "NOT " + cond
+		case "$in", "$nin":
+			cond, err = q.in(x, op.Value)
+			if op.Key == "$nin" {
+				cond = "NOT " + cond
+			}
+		case "$exists":
+			cond = x + " IS NOT NULL"
+			if exists, ok := op.Value.(bool); ok && !exists {
+				cond = x + " IS NULL"
+			}
+		case "$gt", "$gte", "$lt", "$lte":
+			cond, err = q.compare(x, op.Key, op.Value)
+		case "$elemMatch":
+			sub, ok := op.Value.(bson.D)
+			if !ok {
+				return "", fmt.Errorf("$elemMatch expects a document")
+			}
+			q.aliases++
+			alias := fmt.Sprintf("e%d", q.aliases)
+			var where string
+			where, err = q.where(alias+".value", sub)
+			cond = fmt.Sprintf("EXISTS (SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof(%s) = 'array' THEN %s ELSE '[]'::jsonb END) AS %s WHERE %s)", x, x, alias, where)
+		default:
+			return "", fmt.Errorf("unsupported query operator %s", op.Key)
+		}
+		if err != nil {
+			return "", err
+		}
+		conds = append(conds, cond)
+	}
+	return "(" + strings.Join(conds, " AND ") + ")", nil
+}
+
+// eq matches a value or an array containing the value, like mongo does
+func (q *pgQuery) eq(x string, value interface{}) (string, error) {
+	if value == nil {
+		return fmt.Sprintf("(%s IS NULL OR %s = 'null'::jsonb)", x, x), nil
+	}
+	v, err := jsonValue(value)
+	if err != nil {
+		return "", err
+	}
+	p := q.arg(v)
+	return fmt.Sprintf("COALESCE(%s = %s::jsonb OR %s @> jsonb_build_array(%s::jsonb), FALSE)", x, p, x, p), nil
+}
+
+func (q *pgQuery) in(x string, value interface{}) (string, error) {
+	values, ok := value.(bson.A)
+	if !ok {
+		return "", fmt.Errorf("$in and $nin expect an array")
+	}
+	if len(values) == 0 {
+		return "FALSE", nil
+	}
+	conds := make([]string, len(values))
+	for i, v := range values {
+		cond, err := q.eq(x, v)
+		if err != nil {
+			return "", err
+		}
+		conds[i] = cond
+	}
+	return "(" + strings.Join(conds, " OR ") + ")", nil
+}
+
+var comparisonOperators = map[string]string{"$gt": ">", "$gte": ">=", "$lt": "<", "$lte": "<="}
+
+func (q *pgQuery) compare(x string, op string, value interface{}) (string, error) {
+	sqlOp := comparisonOperators[op]
+	switch v := value.(type) {
+	case int32, int64, float64:
+		return fmt.Sprintf("COALESCE(CASE WHEN jsonb_typeof(%s) = 'number' THEN (%s)::numeric %s %s::numeric END, FALSE)", x, x, sqlOp, q.arg(fmt.Sprint(v))), nil
+	case string:
+		// byte order like mongo, the default collation of the database could order hex strings and addresses differently
+		return fmt.Sprintf("COALESCE(jsonb_typeof(%s) = 'string' AND (%s #>> '{}') COLLATE \"C\" %s %s, FALSE)", x, x, sqlOp, q.arg(v)), nil
+	case primitive.DateTime:
+		return fmt.Sprintf("COALESCE(%s::timestamptz %s %s::timestamptz, FALSE)", dateExpr(x), sqlOp, q.arg(v.Time().UTC().Format("2006-01-02T15:04:05.000Z"))), nil
+	}
+	return "", fmt.Errorf("unsupported value for %s: %T", op, value)
+}
+
+// dateExpr reads a date stored as relaxed extended json, other values read as null
+func dateExpr(x string) string {
+	return fmt.Sprintf("(CASE WHEN jsonb_typeof(%s -> '$date') = 'string' THEN (%s ->> '$date')::timestamptz END)", x, x)
+}
+
+// orderBy translates a sort document, dates are compared as timestamps
+func (q *pgQuery) orderBy(sort bson.D) (string, error) {
+	if len(sort) == 0 {
+		return "", nil
+	}
+	terms := make([]string, 0, 2*len(sort))
+	for _, e := range sort {
+		direction := "ASC NULLS FIRST"
+		switch v := e.Value.(type) {
+		case int32:
+			if v < 0 {
+				direction = "DESC NULLS LAST"
+			}
+		case int64:
+			if v < 0 {
+				direction = "DESC NULLS LAST"
+			}
+		case float64:
+			if v < 0 {
+				direction = "DESC NULLS LAST"
+			}
+		default:
+			return "", fmt.Errorf("unsupported sort direction for %s: %v", e.Key, e.Value)
+		}
+		x := q.path("doc", e.Key)
+		terms = append(terms, dateExpr(x)+" "+direction, x+" "+direction)
+	}
+	return " ORDER BY " + strings.Join(terms, ", "), nil
+}
+
+// aggregate translates a pipeline of an optional $match followed by a $group into a query
+// that returns every group as a json document
+func (q *pgQuery) aggregate(table string, pipeline bson.A) (string, error) {
+	where := "TRUE"
+	var group bson.D
+	for _, s := range pipeline {
+		stage, ok := s.(bson.D)
+		if !ok || len(stage) != 1 {
+			return "", fmt.Errorf("invalid pipeline stage")
+		}
+		switch stage[0].Key {
+		case "$match":
+			if group != nil {
+				return "", fmt.Errorf("$match after $group is not supported")
+			}
+			match, ok := stage[0].Value.(bson.D)
+			if !ok {
+				return "", fmt.Errorf("$match expects a document")
+			}
+			cond, err := q.where("doc", match)
+			if err != nil {
+				return "", err
+			}
+			where = cond
+		case "$group":
+			if group != nil {
+				return "", fmt.Errorf("only one $group is supported")
+			}
+			if group, ok = stage[0].Value.(bson.D); !ok {
+				return "", fmt.Errorf("$group expects a document")
+			}
+		default:
+			return "", fmt.Errorf("unsupported pipeline stage %s", stage[0].Key)
+		}
+	}
+	if group == nil {
+		return "", fmt.Errorf("pipeline requires a $group stage")
+	}
+
+	fields := []string{}
+	groupBy := "()"
+	for _, e := range group {
+		if e.Key == "_id" {
+			idExpr := "'null'::jsonb"
+			if e.Value != nil {
+				ref, err := fieldRef(e.Value)
+				if err != nil {
+					return "", err
+				}
+				idExpr = q.path("doc", ref)
+				groupBy = idExpr
+			}
+			fields = append(fields, fmt.Sprintf("'_id', %s", idExpr))
+			continue
+		}
+
+		acc, ok := e.Value.(bson.D)
+		if !ok || len(acc) != 1 {
+			return "", fmt.Errorf("invalid accumulator for %s", e.Key)
+		}
+		var expr string
+		switch acc[0].Key {
+		case "$max", "$min", "$sum":
+			fn := strings.TrimPrefix(acc[0].Key, "$")
+			if ref, err := fieldRef(acc[0].Value); err == nil {
+				x := q.path("doc", ref)
+				expr = fmt.Sprintf("%s(CASE WHEN jsonb_typeof(%s) = 'number' THEN (%s)::numeric END)", fn, x, x)
+			} else if acc[0].Key == "$sum" {
+				v, err := jsonValue(acc[0].Value)
+				if err != nil {
+					return "", err
+				}
+				expr = fmt.Sprintf("sum(%s::numeric)", q.arg(v))
+			} else {
+				return "", err
+			}
+			if acc[0].Key == "$sum" {
+				expr = "COALESCE(" + expr + ", 0)"
+			}
+		default:
+			return "", fmt.Errorf("unsupported accumulator %s", acc[0].Key)
+		}
+		fields = append(fields, fmt.Sprintf("%s::text, to_jsonb(%s)", q.arg(e.Key), expr))
+	}
+
+	return fmt.Sprintf("SELECT jsonb_build_object(%s) FROM %s WHERE %s GROUP BY %s HAVING count(*) > 0",
+		strings.Join(fields, ", "), table, where, groupBy), nil
+}
+
+func fieldRef(v interface{}) (string, error) {
+	ref, ok := v.(string)
+	if !ok || !strings.HasPrefix(ref, "$") {
+		return "", fmt.Errorf("expected a field reference, got %v", v)
+	}
+	return strings.TrimPrefix(ref, "$"), nil
+}
+
+// applyUpdate applies the update operators to a document, $setOnInsert only applies when inserting
+func applyUpdate(doc bson.D, update bson.D, insert bool) (bson.D, error) {
+	var err error
+	for _, op := range update {
+		fields, ok := op.Value.(bson.D)
+		if !ok {
+			return nil, fmt.Errorf("%s expects a document", op.Key)
+		}
+		for _, f := range fields {
+			path := strings.Split(f.Key, ".")
+			current, exists := getPath(doc, path)
+			switch op.Key {
+			case "$set":
+				doc, err = setPath(doc, path, f.Value)
+			case "$setOnInsert":
+				if insert {
+					doc, err = setPath(doc, path, f.Value)
+				}
+			case "$unset":
+				doc = unsetPath(doc, path)
+			case "$inc":
+				if !exists || current == nil {
+					doc, err = setPath(doc, path, f.Value)
+					break
+				}
+				var sum interface{}
+				if sum, err = addNumbers(current, f.Value); err == nil {
+					doc, err = setPath(doc, path, sum)
+				}
+			case "$max", "$min":
+				if !exists || current == nil {
+					doc, err = setPath(doc, path, f.Value)
+					break
+				}
+				var cmp int
+				if cmp, err = compareValues(f.Value, current); err == nil && ((op.Key == "$max" && cmp > 0) || (op.Key == "$min" && cmp < 0)) {
+					doc, err = setPath(doc, path, f.Value)
+				}
+			default:
+				return nil, fmt.Errorf("unsupported update operator %s", op.Key)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", op.Key, f.Key, err)
+			}
+		}
+	}
+	return doc, nil
+}
+
+// upsertDocument starts a new document from the equality conditions of a filter
+func upsertDocument(filter bson.D) (bson.D, error) {
+	doc := bson.D{}
+	var err error
+	for _, e := range filter {
+		if strings.HasPrefix(e.Key, "$") {
+			continue
+		}
+		if ops, ok := e.Value.(bson.D); ok && isOperatorDoc(ops) {
+			continue
+		}
+		if doc, err = setPath(doc, strings.Split(e.Key, "."), e.Value); err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+func getPath(doc interface{}, path []string) (interface{}, bool) {
+	if len(path) == 0 {
+		return doc, true
+	}
+	switch d := doc.(type) {
+	case bson.D:
+		for _, e := range d {
+			if e.Key == path[0] {
+				return getPath(e.Value, path[1:])
+			}
+		}
+	case bson.A:
+		if i, err := strconv.Atoi(path[0]); err == nil && i >= 0 && i < len(d) {
+			return getPath(d[i], path[1:])
+		}
+	}
+	return nil, false
+}
+
+func setPath(doc bson.D, path []string, value interface{}) (bson.D, error) {
+	for i, e := range doc {
+		if e.Key != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			doc[i].Value = value
+			return doc, nil
+		}
+		switch child := e.Value.(type) {
+		case bson.D:
+			updated, err := setPath(child, path[1:], value)
+			doc[i].Value = updated
+			return doc, err
+		case bson.A:
+			index, err := strconv.Atoi(path[1])
+			if err != nil || index < 0 || index >= len(child) {
+				return nil, fmt.Errorf("invalid array index %s", path[1])
+			}
+			if len(path) == 2 {
+				child[index] = value
+				return doc, nil
+			}
+			nested, ok := child[index].(bson.D)
+			if !ok {
+				return nil, fmt.Errorf("cannot set a field of a %T", child[index])
+			}
+			child[index], err = setPath(nested, path[2:], value)
+			return doc, err
+		default:
+			if child != nil {
+				return nil, fmt.Errorf("cannot set a field of a %T", child)
+			}
+			nested, err := setPath(bson.D{}, path[1:], value)
+			doc[i].Value = nested
+			return doc, err
+		}
+	}
+
+	if len(path) == 1 {
+		return append(doc, bson.E{Key: path[0], Value: value}), nil
+	}
+	nested, err := setPath(bson.D{}, path[1:], value)
+	return append(doc, bson.E{Key: path[0], Value: nested}), err
+}
+
+func unsetPath(doc bson.D, path []string) bson.D {
+	for i, e := range doc {
+		if e.Key != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return append(doc[:i], doc[i+1:]...)
+		}
+		if child, ok := e.Value.(bson.D); ok {
+			doc[i].Value = unsetPath(child, path[1:])
+		}
+		return doc
+	}
+	return doc
+}
+
+func addNumbers(a interface{}, b interface{}) (interface{}, error) {
+	if x, ok := a.(int32); ok {
+		if y, ok := b.(int32); ok {
+			return x + y, nil
+		}
+	}
+	x, xInt, okX := number(a)
+	y, yInt, okY := number(b)
+	if !okX || !okY {
+		return nil, fmt.Errorf("cannot add %T and %T", a, b)
+	}
+	if xInt && yInt {
+		return int64(x) + int64(y), nil
+	}
+	return x + y, nil
+}
+
+func number(v interface{}) (float64, bool, bool) {
+	switch n := v.(type) {
+	case int32:
+		return float64(n), true, true
+	case int64:
+		return float64(n), true, true
+	case float64:
+		return n, false, true
+	}
+	return 0, false, false
+}
+
+func compareValues(a interface{}, b interface{}) (int, error) {
+	switch x := a.(type) {
+	case int32, int64:
+		if y, ok := b.(int32); ok {
+			return compareInts(toInt64(x), int64(y)), nil
+		}
+		if y, ok := b.(int64); ok {
+			return compareInts(toInt64(x), y), nil
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), nil
+		}
+	case primitive.DateTime:
+		if y, ok := b.(primitive.DateTime); ok {
+			return compareInts(int64(x), int64(y)), nil
+		}
+	}
+	x, _, okX := number(a)
+	y, _, okY := number(b)
+	if !okX || !okY {
+		return 0, fmt.Errorf("cannot compare %T and %T", a, b)
+	}
+	switch {
+	case x < y:
+		return -1, nil
+	case x > y:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+func toInt64(v interface{}) int64 {
+	if n, ok := v.(int32); ok {
+		return int64(n)
+	}
+	return v.(int64)
+}
+
+func compareInts(x int64, y int64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
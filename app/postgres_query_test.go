@@ -0,0 +1,224 @@
+package app
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestPostgresWhere(t *testing.T) {
+	t.Run("Equality", func(t *testing.T) {
+		q := &pgQuery{}
+		filter, _ := normalize(bson.M{"status": "pending"})
+
+		where, err := q.where("doc", filter)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "(COALESCE((doc #> $1::text[]) = $2::jsonb OR (doc #> $1::text[]) @> jsonb_build_array($2::jsonb), FALSE))", where)
+		assert.Equal(t, []interface{}{pq.Array([]string{"status"}), `"pending"`}, q.args)
+	})
+
+	t.Run("Operators", func(t *testing.T) {
+		q := &pgQuery{}
+		filter, _ := normalize(bson.D{
+			{Key: "sequence", Value: bson.M{"$ne": nil}},
+			{Key: "signatures.0", Value: bson.M{"$exists": false}},
+			{Key: "status", Value: bson.M{"$in": []string{"pending", "confirmed"}}},
+			{Key: "$or", Value: []bson.M{{"signers": bson.M{"$nin": []string{"a"}}}, {"confirmations": bson.M{"$gte": 3}}}},
+		})
+
+		where, err := q.where("doc", filter)
+
+		assert.Nil(t, err)
+		assert.Contains(t, where, "NOT ((doc #> $1::text[]) IS NULL OR (doc #> $1::text[]) = 'null'::jsonb)")
+		assert.Contains(t, where, "(doc #> $2::text[]) IS NULL")
+		assert.Contains(t, where, "NOT (COALESCE((doc #> $6::text[]) = $7::jsonb")
+		assert.Contains(t, where, "THEN ((doc #> $8::text[]))::numeric >= $9::numeric END, FALSE)")
+		assert.Equal(t, pq.Array([]string{"signatures", "0"}), q.args[1])
+		assert.Equal(t, "3", q.args[8])
+	})
+
+	t.Run("Elem Match", func(t *testing.T) {
+		q := &pgQuery{}
+		filter, _ := normalize(bson.M{"signatures": bson.M{"$elemMatch": bson.M{"signer": "a"}}})
+
+		where, err := q.where("doc", filter)
+
+		assert.Nil(t, err)
+		assert.True(t, strings.HasPrefix(where, "((EXISTS (SELECT 1 FROM jsonb_array_elements("))
+		assert.Contains(t, where, "AS e1 WHERE (COALESCE((e1.value #> $2::text[])")
+	})
+
+	t.Run("Values", func(t *testing.T) {
+		q := &pgQuery{}
+		id := primitive.NewObjectID()
+		date := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		filter, _ := normalize(bson.D{{Key: "_id", Value: id}, {Key: "updated_at", Value: bson.M{"$lt": date}}})
+
+		_, err := q.where("doc", filter)
+
+		assert.Nil(t, err)
+		assert.Equal(t, `{"$oid":"`+id.Hex()+`"}`, q.args[1])
+		assert.Equal(t, "2024-01-02T03:04:05.000Z", q.args[3])
+	})
+
+	t.Run("String Comparison", func(t *testing.T) {
+		q := &pgQuery{}
+		filter, _ := normalize(bson.M{"recipient_address": bson.M{"$gt": "0xAbC"}})
+
+		where, err := q.where("doc", filter)
+
+		assert.Nil(t, err)
+		assert.Contains(t, where, `((doc #> $1::text[]) #>> '{}') COLLATE "C" > $2`)
+		assert.Equal(t, "0xAbC", q.args[1])
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		q := &pgQuery{}
+		filter, _ := normalize(bson.M{"status": bson.M{"$regex": "pending"}})
+
+		_, err := q.where("doc", filter)
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestPostgresOrderBy(t *testing.T) {
+	q := &pgQuery{}
+	sort, _ := normalize(bson.D{{Key: "created_at", Value: -1}})
+
+	orderBy, err := q.orderBy(sort)
+
+	assert.Nil(t, err)
+	assert.Contains(t, orderBy, "::timestamptz END) DESC NULLS LAST, (doc #> $1::text[]) DESC NULLS LAST")
+}
+
+func TestPostgresAggregate(t *testing.T) {
+	t.Run("Max", func(t *testing.T) {
+		q := &pgQuery{}
+		pipeline, _ := normalizeArray(mongo.Pipeline{
+			{{Key: "$match", Value: bson.M{"vault_address": "vault"}}},
+			{{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: nil},
+				{Key: "max_sequence", Value: bson.D{{Key: "$max", Value: "$sequence"}}},
+			}}},
+		})
+
+		query, err := q.aggregate(`"burns"`, pipeline)
+
+		assert.Nil(t, err)
+		assert.Equal(t, `SELECT jsonb_build_object('_id', 'null'::jsonb, $4::text, to_jsonb(max(CASE WHEN jsonb_typeof((doc #> $3::text[])) = 'number' THEN ((doc #> $3::text[]))::numeric END))) FROM "burns" WHERE (COALESCE((doc #> $1::text[]) = $2::jsonb OR (doc #> $1::text[]) @> jsonb_build_array($2::jsonb), FALSE)) GROUP BY () HAVING count(*) > 0`, query)
+	})
+
+	t.Run("Count By Status", func(t *testing.T) {
+		q := &pgQuery{}
+		pipeline, _ := normalizeArray(mongo.Pipeline{
+			{{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$status"},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}}},
+		})
+
+		query, err := q.aggregate(`"mints"`, pipeline)
+
+		assert.Nil(t, err)
+		assert.Equal(t, `SELECT jsonb_build_object('_id', (doc #> $1::text[]), $3::text, to_jsonb(COALESCE(sum($2::numeric), 0))) FROM "mints" WHERE TRUE GROUP BY (doc #> $1::text[]) HAVING count(*) > 0`, query)
+	})
+
+	t.Run("Without Group", func(t *testing.T) {
+		q := &pgQuery{}
+		pipeline, _ := normalizeArray(mongo.Pipeline{{{Key: "$match", Value: bson.M{}}}})
+
+		_, err := q.aggregate(`"mints"`, pipeline)
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestApplyUpdate(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Update", func(t *testing.T) {
+		doc, _ := normalize(bson.M{"status": "pending", "attempts": int64(1), "sequence": nil, "data": bson.M{"nonce": "1"}, "signatures": []string{"a"}})
+		update, _ := normalize(bson.M{
+			"$set":         bson.M{"status": "signed", "data.nonce": "2", "signatures.0": "b"},
+			"$setOnInsert": bson.M{"created_at": now},
+			"$inc":         bson.M{"attempts": 1},
+			"$max":         bson.M{"sequence": int64(7)},
+		})
+
+		doc, err := applyUpdate(doc, update, false)
+
+		assert.Nil(t, err)
+		m := doc.Map()
+		assert.Equal(t, "signed", m["status"])
+		assert.Equal(t, int64(2), m["attempts"])
+		assert.Equal(t, int64(7), m["sequence"])
+		assert.Equal(t, bson.D{{Key: "nonce", Value: "2"}}, m["data"])
+		assert.Equal(t, bson.A{"b"}, m["signatures"])
+		assert.NotContains(t, m, "created_at")
+	})
+
+	t.Run("Max Keeps Larger", func(t *testing.T) {
+		doc := bson.D{{Key: "height", Value: int64(10)}}
+		update := bson.D{{Key: "$max", Value: bson.D{{Key: "height", Value: int32(5)}}}}
+
+		doc, err := applyUpdate(doc, update, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(10), doc.Map()["height"])
+	})
+
+	t.Run("Upsert", func(t *testing.T) {
+		filter, _ := normalize(bson.M{"validator_id": "v", "hostname": "h", "status": bson.M{"$ne": "failed"}})
+		update, _ := normalize(bson.M{"$set": bson.M{"healthy": true}, "$setOnInsert": bson.M{"created_at": now}})
+
+		doc, err := upsertDocument(filter)
+		assert.Nil(t, err)
+		doc, err = applyUpdate(doc, update, true)
+
+		assert.Nil(t, err)
+		m := doc.Map()
+		assert.Equal(t, "v", m["validator_id"])
+		assert.Equal(t, "h", m["hostname"])
+		assert.Equal(t, true, m["healthy"])
+		assert.Contains(t, m, "created_at")
+		assert.NotContains(t, m, "status")
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		update := bson.D{{Key: "$push", Value: bson.D{{Key: "signatures", Value: "a"}}}}
+
+		_, err := applyUpdate(bson.D{}, update, false)
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestDocumentID(t *testing.T) {
+	doc, id, err := documentID(bson.D{{Key: "status", Value: "pending"}})
+
+	assert.Nil(t, err)
+	assert.False(t, id.IsZero())
+	assert.Equal(t, bson.E{Key: "_id", Value: id}, doc[0])
+
+	_, existing, err := documentID(doc)
+
+	assert.Nil(t, err)
+	assert.Equal(t, id, existing)
+}
+
+func TestPostgresError(t *testing.T) {
+	err := postgresError(&pq.Error{Code: "23505", Message: "duplicate key value violates unique constraint"})
+	assert.True(t, mongo.IsDuplicateKeyError(err))
+
+	err = postgresError(errors.New("error"))
+	assert.False(t, mongo.IsDuplicateKeyError(err))
+}
@@ -0,0 +1,506 @@
+package app
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+	lock "github.com/square/mongo-lock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func newTestPostgres(t *testing.T) (*PostgresDatabase, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		assert.Nil(t, mock.ExpectationsWereMet())
+		//nolint:errcheck
+		db.Close()
+	})
+	return &PostgresDatabase{
+		db:      db,
+		timeout: time.Second,
+		locks:   make(map[string]postgresLock),
+		logger:  log.WithFields(log.Fields{"module": "database"}),
+	}, mock
+}
+
+// sqlText matches a query by its text instead of as a regular expression
+func sqlText(query string) string {
+	return regexp.QuoteMeta(query)
+}
+
+func docRows(docs ...string) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"doc"})
+	for _, doc := range docs {
+		rows.AddRow([]byte(doc))
+	}
+	return rows
+}
+
+// docArg matches a document argument that contains every given json fragment
+type docArg []string
+
+func (a docArg) Match(v driver.Value) bool {
+	doc, ok := v.(string)
+	if !ok {
+		return false
+	}
+	for _, fragment := range a {
+		if !strings.Contains(doc, fragment) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPostgresInsertOne(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	t.Run("No Error", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectExec(sqlText(`INSERT INTO "mints" (id, doc) VALUES ($1, $2::jsonb)`)).
+			WithArgs(id.Hex(), docArg{`"_id":{"$oid":"` + id.Hex() + `"}`, `"status":"pending"`}).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		insertedID, err := d.InsertOne(context.Background(), models.CollectionMints, models.Mint{Id: &id, Status: models.StatusPending})
+
+		assert.Nil(t, err)
+		assert.Equal(t, id, insertedID)
+	})
+
+	t.Run("New Id", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectExec(sqlText(`INSERT INTO "healthchecks"`)).
+			WithArgs(sqlmock.AnyArg(), docArg{`"_id":{"$oid":"`, `"hostname":"host"`}).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		insertedID, err := d.InsertOne(context.Background(), models.CollectionHealthChecks, bson.M{"hostname": "host"})
+
+		assert.Nil(t, err)
+		assert.False(t, insertedID.IsZero())
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectExec(sqlText(`INSERT INTO "mints"`)).WillReturnError(&pq.Error{Code: "23505", Message: "duplicate key"})
+
+		_, err := d.InsertOne(context.Background(), models.CollectionMints, models.Mint{Id: &id})
+
+		assert.True(t, mongo.IsDuplicateKeyError(err))
+	})
+}
+
+func TestPostgresFindOne(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	t.Run("Found", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectQuery(sqlText(`SELECT doc FROM "mints" WHERE `)).
+			WithArgs(pq.Array([]string{"_id"}), `{"$oid":"`+id.Hex()+`"}`, int64(1)).
+			WillReturnRows(docRows(`{"_id":{"$oid":"` + id.Hex() + `"},"status":"signed","nonce":"3"}`))
+
+		var mint models.Mint
+		err := d.FindOne(context.Background(), models.CollectionMints, bson.M{"_id": id}, &mint)
+
+		assert.Nil(t, err)
+		assert.Equal(t, id, *mint.Id)
+		assert.Equal(t, models.StatusSigned, mint.Status)
+		assert.Equal(t, "3", mint.Nonce)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectQuery(sqlText(`SELECT doc FROM "mints" WHERE `)).WillReturnRows(docRows())
+
+		var mint models.Mint
+		err := d.FindOne(context.Background(), models.CollectionMints, bson.M{"_id": id}, &mint)
+
+		assert.Equal(t, mongo.ErrNoDocuments, err)
+	})
+}
+
+func TestPostgresFindManyPaged(t *testing.T) {
+	d, mock := newTestPostgres(t)
+
+	mock.ExpectQuery(sqlText(`SELECT doc FROM "burns" WHERE `)+".*"+sqlText(` ORDER BY `)+".*"+sqlText(` LIMIT $4 OFFSET $5`)).
+		WithArgs(pq.Array([]string{"status"}), `"pending"`, pq.Array([]string{"created_at"}), int64(2), int64(4)).
+		WillReturnRows(docRows(`{"transaction_hash":"0x1","status":"pending"}`, `{"transaction_hash":"0x2","status":"pending"}`))
+
+	var burns []models.Burn
+	err := d.FindManyPaged(context.Background(), models.CollectionBurns, bson.M{"status": models.StatusPending}, bson.D{{Key: "created_at", Value: 1}}, 4, 2, &burns)
+
+	assert.Nil(t, err)
+	assert.Len(t, burns, 2)
+	assert.Equal(t, "0x2", burns[1].TransactionHash)
+}
+
+func TestPostgresCountDocuments(t *testing.T) {
+	d, mock := newTestPostgres(t)
+
+	mock.ExpectQuery(sqlText(`SELECT count(*) FROM "invalidMints" WHERE `)).
+		WithArgs(pq.Array([]string{"status"}), `"failed"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(7)))
+
+	count, err := d.CountDocuments(context.Background(), models.CollectionInvalidMints, bson.M{"status": models.StatusFailed})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), count)
+}
+
+func TestPostgresUpdateOne(t *testing.T) {
+	id := primitive.NewObjectID()
+	stored := `{"_id":{"$oid":"` + id.Hex() + `"},"status":"pending","attempts":1}`
+
+	t.Run("No Error", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(sqlText(`SELECT id, doc FROM "mints" WHERE `) + ".*" + sqlText(` LIMIT 1 FOR UPDATE`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "doc"}).AddRow(id.Hex(), []byte(stored)))
+		mock.ExpectExec(sqlText(`UPDATE "mints" SET doc = $1::jsonb WHERE id = $2`)).
+			WithArgs(docArg{`"status":"signed"`, `"attempts":2`}, id.Hex()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		update := bson.M{"$set": bson.M{"status": models.StatusSigned}, "$inc": bson.M{"attempts": int64(1)}}
+		updatedID, err := d.UpdateOne(context.Background(), models.CollectionMints, bson.M{"_id": id, "status": models.StatusPending}, update)
+
+		assert.Nil(t, err)
+		assert.Equal(t, id, updatedID)
+	})
+
+	t.Run("No Documents", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(sqlText(`SELECT id, doc FROM "mints" WHERE `)).WillReturnRows(sqlmock.NewRows([]string{"id", "doc"}))
+		mock.ExpectRollback()
+
+		_, err := d.UpdateOne(context.Background(), models.CollectionMints, bson.M{"_id": id}, bson.M{"$set": bson.M{"status": models.StatusSigned}})
+
+		assert.Equal(t, mongo.ErrNoDocuments, err)
+	})
+
+	t.Run("In Transaction", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(sqlText(`SELECT id, doc FROM "mints" WHERE `)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "doc"}).AddRow(id.Hex(), []byte(stored)))
+		mock.ExpectExec(sqlText(`UPDATE "mints" SET doc = $1::jsonb WHERE id = $2`)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := d.WithTransaction(context.Background(), func(ctx context.Context) error {
+			_, err := d.UpdateOne(ctx, models.CollectionMints, bson.M{"_id": id}, bson.M{"$set": bson.M{"status": models.StatusSigned}})
+			return err
+		})
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestPostgresUpsertOne(t *testing.T) {
+	id := primitive.NewObjectID()
+	filter := bson.M{"transaction_hash": "0xabc"}
+	update := bson.M{"$set": bson.M{"status": models.StatusConfirmed}}
+
+	t.Run("Insert", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(sqlText(`SELECT id, doc FROM "mints" WHERE `)).WillReturnRows(sqlmock.NewRows([]string{"id", "doc"}))
+		mock.ExpectExec(sqlText(`INSERT INTO "mints" (id, doc) VALUES ($1, $2::jsonb)`)).
+			WithArgs(sqlmock.AnyArg(), docArg{`"transaction_hash":"0xabc"`, `"status":"confirmed"`}).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		insertedID, err := d.UpsertOne(context.Background(), models.CollectionMints, filter, update)
+
+		assert.Nil(t, err)
+		assert.False(t, insertedID.IsZero())
+	})
+
+	t.Run("Inserted By Another Validator", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(sqlText(`SELECT id, doc FROM "mints" WHERE `)).WillReturnRows(sqlmock.NewRows([]string{"id", "doc"}))
+		mock.ExpectExec(sqlText(`INSERT INTO "mints"`)).WillReturnError(&pq.Error{Code: "23505"})
+		mock.ExpectRollback()
+		mock.ExpectBegin()
+		mock.ExpectQuery(sqlText(`SELECT id, doc FROM "mints" WHERE `)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "doc"}).AddRow(id.Hex(), []byte(`{"_id":{"$oid":"`+id.Hex()+`"},"transaction_hash":"0xabc","status":"pending"}`)))
+		mock.ExpectExec(sqlText(`UPDATE "mints" SET doc = $1::jsonb WHERE id = $2`)).
+			WithArgs(docArg{`"status":"confirmed"`}, id.Hex()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		updatedID, err := d.UpsertOne(context.Background(), models.CollectionMints, filter, update)
+
+		assert.Nil(t, err)
+		assert.Equal(t, id, updatedID)
+	})
+}
+
+func TestPostgresLocks(t *testing.T) {
+	resourceID := "mints/0xabc"
+
+	t.Run("Exclusive", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectQuery(sqlText(`SELECT pg_try_advisory_lock(hashtextextended($1, 0))`)).WithArgs(resourceID).
+			WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(true))
+		mock.ExpectQuery(sqlText(`SELECT pg_advisory_unlock(hashtextextended($1, 0))`)).WithArgs(resourceID).
+			WillReturnRows(sqlmock.NewRows([]string{"unlocked"}).AddRow(true))
+
+		lockID, err := d.XLock(context.Background(), resourceID)
+		assert.Nil(t, err)
+		assert.Nil(t, d.RenewLock(context.Background(), lockID))
+
+		assert.Nil(t, d.Unlock(context.Background(), lockID))
+		assert.NotNil(t, d.Unlock(context.Background(), lockID))
+		assert.NotNil(t, d.RenewLock(context.Background(), lockID))
+	})
+
+	t.Run("Shared", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectQuery(sqlText(`SELECT pg_try_advisory_lock_shared(hashtextextended($1, 0))`)).WithArgs(resourceID).
+			WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(true))
+		mock.ExpectQuery(sqlText(`SELECT pg_advisory_unlock_shared(hashtextextended($1, 0))`)).WithArgs(resourceID).
+			WillReturnRows(sqlmock.NewRows([]string{"unlocked"}).AddRow(true))
+
+		lockID, err := d.SLock(context.Background(), resourceID)
+		assert.Nil(t, err)
+
+		assert.Nil(t, d.Unlock(context.Background(), lockID))
+	})
+
+	t.Run("Already Locked", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectQuery(sqlText(`SELECT pg_try_advisory_lock(hashtextextended($1, 0))`)).WithArgs(resourceID).
+			WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(false))
+
+		_, err := d.XLock(context.Background(), resourceID)
+
+		assert.Equal(t, lock.ErrAlreadyLocked, err)
+		assert.Empty(t, d.locks)
+	})
+
+	t.Run("Lock Not Held", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectQuery(sqlText(`SELECT pg_try_advisory_lock(hashtextextended($1, 0))`)).
+			WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(true))
+		mock.ExpectQuery(sqlText(`SELECT pg_advisory_unlock(hashtextextended($1, 0))`)).
+			WillReturnRows(sqlmock.NewRows([]string{"unlocked"}).AddRow(false))
+
+		lockID, err := d.XLock(context.Background(), resourceID)
+		assert.Nil(t, err)
+
+		assert.NotNil(t, d.Unlock(context.Background(), lockID))
+		assert.Empty(t, d.locks)
+	})
+}
+
+func TestPostgresWithTransaction(t *testing.T) {
+	serializationFailure := &pq.Error{Code: "40001"}
+
+	t.Run("Retries Serialization Failures", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		attempts := 0
+		err := d.WithTransaction(context.Background(), func(ctx context.Context) error {
+			attempts++
+			assert.NotNil(t, postgresTx(ctx))
+			if attempts == 1 {
+				return serializationFailure
+			}
+			return nil
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("Gives Up", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+		oldAttempts := PostgresTransactionAttempts
+		defer func() { PostgresTransactionAttempts = oldAttempts }()
+		PostgresTransactionAttempts = 2
+
+		for i := 0; i < 2; i++ {
+			mock.ExpectBegin()
+			mock.ExpectRollback()
+		}
+
+		attempts := 0
+		err := d.WithTransaction(context.Background(), func(ctx context.Context) error {
+			attempts++
+			return serializationFailure
+		})
+
+		assert.Equal(t, serializationFailure, err)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("Other Errors", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		attempts := 0
+		err := d.WithTransaction(context.Background(), func(ctx context.Context) error {
+			attempts++
+			return errors.New("error")
+		})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		err := d.WithTransaction(context.Background(), func(ctx context.Context) error {
+			return d.WithTransaction(ctx, func(nested context.Context) error {
+				assert.Equal(t, postgresTx(ctx), postgresTx(nested))
+				return nil
+			})
+		})
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestPostgresNextFencingToken(t *testing.T) {
+	d, mock := newTestPostgres(t)
+
+	mock.ExpectQuery(sqlText(`INSERT INTO "fencing_tokens" (resource, token) VALUES ($1, 1)`)).WithArgs("burns/1").
+		WillReturnRows(sqlmock.NewRows([]string{"token"}).AddRow(int64(3)))
+
+	token, err := d.NextFencingToken(context.Background(), "burns/1")
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), token)
+}
+
+func TestPostgresMigrations(t *testing.T) {
+	migration := postgresMigrations[2].Migration
+
+	t.Run("Known", func(t *testing.T) {
+		d, _ := newTestPostgres(t)
+
+		migrations := d.Migrations()
+
+		assert.Len(t, migrations, len(postgresMigrations))
+		for i, m := range migrations {
+			assert.Equal(t, int64(i+1), m.Version)
+		}
+	})
+
+	t.Run("Applied", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+		appliedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		mock.ExpectQuery(sqlText(`SELECT to_regclass('"schema_migrations"') IS NOT NULL`)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectQuery(sqlText(`SELECT version, description, applied_at FROM "schema_migrations" ORDER BY version`)).
+			WillReturnRows(sqlmock.NewRows([]string{"version", "description", "applied_at"}).AddRow(int64(1), "create", appliedAt))
+
+		applied, err := d.AppliedMigrations(context.Background())
+
+		assert.Nil(t, err)
+		assert.Equal(t, []models.SchemaMigration{{Version: 1, Description: "create", AppliedAt: appliedAt}}, applied)
+	})
+
+	t.Run("None Applied", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectQuery(sqlText(`SELECT to_regclass('"schema_migrations"') IS NOT NULL`)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		applied, err := d.AppliedMigrations(context.Background())
+
+		assert.Nil(t, err)
+		assert.Empty(t, applied)
+	})
+
+	t.Run("Apply", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlText(`SELECT pg_advisory_xact_lock(hashtextextended('wpokt-validator/migrations', 0))`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(sqlText(`CREATE TABLE IF NOT EXISTS "schema_migrations"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(sqlText(`SELECT EXISTS (SELECT 1 FROM "schema_migrations" WHERE version = $1)`)).WithArgs(migration.Version).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		for _, statement := range postgresMigrations[2].statements {
+			mock.ExpectExec(sqlText(statement)).WillReturnResult(sqlmock.NewResult(0, 0))
+		}
+		mock.ExpectExec(sqlText(`INSERT INTO "schema_migrations" (version, description, applied_at) VALUES ($1, $2, $3)`)).
+			WithArgs(migration.Version, migration.Description, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		assert.Nil(t, d.ApplyMigration(context.Background(), migration))
+	})
+
+	t.Run("Applied By Another Validator", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlText(`SELECT pg_advisory_xact_lock`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(sqlText(`CREATE TABLE IF NOT EXISTS "schema_migrations"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(sqlText(`SELECT EXISTS (SELECT 1 FROM "schema_migrations" WHERE version = $1)`)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectRollback()
+
+		assert.Nil(t, d.ApplyMigration(context.Background(), migration))
+	})
+
+	t.Run("Failed Statement", func(t *testing.T) {
+		d, mock := newTestPostgres(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlText(`SELECT pg_advisory_xact_lock`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(sqlText(`CREATE TABLE IF NOT EXISTS "schema_migrations"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(sqlText(`SELECT EXISTS`)).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		mock.ExpectExec(sqlText(postgresMigrations[2].statements[0])).WillReturnError(errors.New("error"))
+		mock.ExpectRollback()
+
+		assert.NotNil(t, d.ApplyMigration(context.Background(), migration))
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		d, _ := newTestPostgres(t)
+
+		assert.NotNil(t, d.ApplyMigration(context.Background(), models.Migration{Version: 99}))
+	})
+}
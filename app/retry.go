@@ -0,0 +1,122 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrNotConfirmed is returned while a document waits for confirmations before it can be handled
+var ErrNotConfirmed = errors.New("no confirmations yet")
+
+// retryDocument holds the failure state of every validator at a mint, invalid mint or burn
+type retryDocument struct {
+	Retries map[string]models.Retry `bson:"retries"`
+}
+
+// RetryBackoff is how long to wait before the next attempt at a document that failed the given number of times in a row
+func RetryBackoff(attempts int64) time.Duration {
+	backoff := time.Duration(Config.Retry.BackoffMillis) * time.Millisecond
+	maxBackoff := time.Duration(Config.Retry.MaxBackoffMillis) * time.Millisecond
+	for i := int64(1); i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+// RetryDue reports whether a validator with the given failure state may attempt a document again
+func RetryDue(retry models.Retry) bool {
+	if retry.NeedsAttention {
+		return false
+	}
+	return retry.NextRetryAt == nil || !retry.NextRetryAt.After(time.Now())
+}
+
+// isExpectedWait reports whether an attempt was held back by paused signing or by missing confirmations,
+// which are retried without counting as failures. A signing policy violation is counted, it does not pass by waiting.
+func isExpectedWait(cause error) bool {
+	return errors.Is(cause, ErrSigningPaused) || errors.Is(cause, ErrNotConfirmed)
+}
+
+// GiveUpQuorum is how many of the validators must give up on a document before it needs attention,
+// the document can still be signed by the threshold until more than validators - threshold have given up
+func GiveUpQuorum(validators int64, threshold int64) int64 {
+	return validators - threshold + 1
+}
+
+// RecordAttempt records the result of an attempt of the signer, identified by its validator address, at handling a pending document.
+// A failure is counted for the signer and its next attempt is scheduled with exponential backoff,
+// after Retry.MaxAttempts failures in a row the signer gives up on the document.
+// The document moves to needs_attention once quorum signers have given up, see GiveUpQuorum, so failing validators do not hold back the others.
+// A success resets the failures counted before, expected waits are not counted.
+func RecordAttempt(ctx context.Context, collection string, id *primitive.ObjectID, signer string, retry models.Retry, quorum int64, cause error) error {
+	key := "retries." + signer
+
+	if cause != nil && isExpectedWait(cause) {
+		log.WithFields(log.Fields{"collection": collection, "id": id.Hex()}).Debug("[RETRY] Not counting expected wait: ", cause)
+		return nil
+	}
+
+	if cause == nil {
+		if retry.Attempts == 0 {
+			return nil
+		}
+		_, err := DB.UpdateOne(ctx, collection, bson.M{"_id": id}, bson.M{"$unset": bson.M{key: ""}})
+		return err
+	}
+
+	attempts := retry.Attempts + 1
+	now := time.Now()
+	nextRetryAt := now.Add(RetryBackoff(attempts))
+	retry = models.Retry{
+		Attempts:       attempts,
+		LastError:      cause.Error(),
+		NextRetryAt:    &nextRetryAt,
+		NeedsAttention: attempts >= Config.Retry.MaxAttempts,
+	}
+
+	filter := bson.M{
+		"_id":    id,
+		"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+	}
+	update := bson.M{"$set": bson.M{key: retry, "updated_at": now}}
+
+	if !retry.NeedsAttention {
+		_, err := DB.UpdateOne(ctx, collection, filter, update)
+		return err
+	}
+
+	log.WithFields(log.Fields{"collection": collection, "id": id.Hex(), "signer": signer, "attempts": attempts}).
+		Warn("[RETRY] Document failed too many times, giving up on it: ", cause)
+
+	return DB.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := DB.UpdateOne(ctx, collection, filter, update); err != nil {
+			return err
+		}
+
+		var doc retryDocument
+		if err := DB.FindOne(ctx, collection, bson.M{"_id": id}, &doc); err != nil {
+			return fmt.Errorf("error finding retries: %w", err)
+		}
+		var gaveUp int64
+		for _, r := range doc.Retries {
+			if r.NeedsAttention {
+				gaveUp++
+			}
+		}
+		if gaveUp < quorum {
+			return nil
+		}
+
+		log.WithFields(log.Fields{"collection": collection, "id": id.Hex(), "validators": gaveUp}).
+			Warn("[RETRY] A quorum of validators gave up on the document, it needs attention")
+		_, err := DB.UpdateOne(ctx, collection, filter, bson.M{"$set": bson.M{"status": models.StatusNeedsAttention, "updated_at": now}})
+		return err
+	})
+}
@@ -0,0 +1,184 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	Config.Retry.BackoffMillis = 1000
+	Config.Retry.MaxBackoffMillis = 10000
+
+	assert.Equal(t, time.Second, RetryBackoff(1))
+	assert.Equal(t, 2*time.Second, RetryBackoff(2))
+	assert.Equal(t, 8*time.Second, RetryBackoff(4))
+	assert.Equal(t, 10*time.Second, RetryBackoff(5))
+	assert.Equal(t, 10*time.Second, RetryBackoff(100))
+}
+
+func TestRetryDue(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Minute)
+
+	assert.True(t, RetryDue(models.Retry{}))
+	assert.True(t, RetryDue(models.Retry{Attempts: 1, NextRetryAt: &past}))
+	assert.False(t, RetryDue(models.Retry{Attempts: 1, NextRetryAt: &future}))
+	assert.False(t, RetryDue(models.Retry{Attempts: 10, NextRetryAt: &past, NeedsAttention: true}))
+}
+
+func TestGiveUpQuorum(t *testing.T) {
+	assert.Equal(t, int64(2), GiveUpQuorum(3, 2))
+	assert.Equal(t, int64(1), GiveUpQuorum(3, 3))
+	assert.Equal(t, int64(3), GiveUpQuorum(5, 3))
+}
+
+func TestRecordAttempt(t *testing.T) {
+	Config.Retry.MaxAttempts = 3
+	Config.Retry.BackoffMillis = 1000
+	Config.Retry.MaxBackoffMillis = 10000
+
+	id := primitive.NewObjectID()
+	pendingFilter := bson.M{
+		"_id":    &id,
+		"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+	}
+
+	t.Run("Success Without Failures", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		err := RecordAttempt(context.Background(), models.CollectionBurns, &id, "0xaaa", models.Retry{}, 2, nil)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Success After Failures", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		update := bson.M{"$unset": bson.M{"retries.0xaaa": ""}}
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, bson.M{"_id": &id}, update).Return(id, nil)
+
+		err := RecordAttempt(context.Background(), models.CollectionBurns, &id, "0xaaa", models.Retry{Attempts: 2}, 2, nil)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Expected Wait", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		err := RecordAttempt(context.Background(), models.CollectionBurns, &id, "0xaaa", models.Retry{Attempts: 2}, 2, ErrSigningPaused)
+		assert.Nil(t, err)
+
+		err = RecordAttempt(context.Background(), models.CollectionBurns, &id, "0xaaa", models.Retry{Attempts: 2}, 2, fmt.Errorf("burn failed validation: %w", ErrNotConfirmed))
+		assert.Nil(t, err)
+	})
+
+	t.Run("Signing Policy Violation", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, pendingFilter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				retry := update.(bson.M)["$set"].(bson.M)["retries.0xaaa"].(models.Retry)
+				assert.Equal(t, int64(2), retry.Attempts)
+			}).Return(id, nil)
+
+		err := RecordAttempt(context.Background(), models.CollectionBurns, &id, "0xaaa", models.Retry{Attempts: 1}, 2, fmt.Errorf("burn not signed due to signing policy: %w", ErrSigningPolicy))
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, pendingFilter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				retry := set["retries.0xaaa"].(models.Retry)
+				assert.Equal(t, int64(2), retry.Attempts)
+				assert.Equal(t, "error", retry.LastError)
+				assert.WithinDuration(t, time.Now().Add(2*time.Second), *retry.NextRetryAt, time.Second)
+				assert.False(t, retry.NeedsAttention)
+				assert.NotContains(t, set, "status")
+			}).Return(id, nil)
+
+		err := RecordAttempt(context.Background(), models.CollectionBurns, &id, "0xaaa", models.Retry{Attempts: 1}, 2, errors.New("error"))
+
+		assert.Nil(t, err)
+	})
+
+	expectGaveUp := func(mockDB *mocks.MockDatabase, retries map[string]models.Retry) {
+		mockDB.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, pendingFilter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				retry := set["retries.0xaaa"].(models.Retry)
+				assert.Equal(t, int64(3), retry.Attempts)
+				assert.True(t, retry.NeedsAttention)
+				assert.NotContains(t, set, "status")
+			}).Return(id, nil).Once()
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionBurns, bson.M{"_id": &id}, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				result.(*retryDocument).Retries = retries
+			}).Return(nil)
+	}
+
+	t.Run("Failure Gives Up", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		// in a 2 of 3 setup the two other validators can still sign the document
+		expectGaveUp(mockDB, map[string]models.Retry{
+			"0xaaa": {Attempts: 3, NeedsAttention: true},
+			"0xbbb": {Attempts: 1},
+		})
+
+		err := RecordAttempt(context.Background(), models.CollectionBurns, &id, "0xaaa", models.Retry{Attempts: 2}, GiveUpQuorum(3, 2), errors.New("error"))
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Failure Needs Attention", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		expectGaveUp(mockDB, map[string]models.Retry{
+			"0xaaa": {Attempts: 3, NeedsAttention: true},
+			"0xbbb": {Attempts: 3, NeedsAttention: true},
+		})
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, pendingFilter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusNeedsAttention, set["status"])
+			}).Return(id, nil).Once()
+
+		err := RecordAttempt(context.Background(), models.CollectionBurns, &id, "0xaaa", models.Retry{Attempts: 2}, GiveUpQuorum(3, 2), errors.New("error"))
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Error Updating", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, pendingFilter, mock.Anything).Return(primitive.NilObjectID, errors.New("db error"))
+
+		err := RecordAttempt(context.Background(), models.CollectionBurns, &id, "0xaaa", models.Retry{}, 2, errors.New("error"))
+
+		assert.NotNil(t, err)
+	})
+}
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"sync"
 	"time"
 
@@ -8,11 +10,35 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// ErrSyncTxs is returned by runners when one or more documents could not be synced
+var ErrSyncTxs = errors.New("failed to sync txs")
+
 type Runner interface {
-	Run()
+	Run(ctx context.Context) error
 	Status() models.RunnerStatus
 }
 
+// EndpointReporter is implemented by chain clients that spread requests over several endpoints
+type EndpointReporter interface {
+	EndpointHealth() []models.EndpointHealth
+}
+
+// EndpointHealth collects the endpoint health of the clients that report it, for the status of a runner
+func EndpointHealth(clients ...interface{}) []models.EndpointHealth {
+	var endpoints []models.EndpointHealth
+	for _, client := range clients {
+		if reporter, ok := client.(EndpointReporter); ok {
+			endpoints = append(endpoints, reporter.EndpointHealth()...)
+		}
+	}
+	return endpoints
+}
+
+// Subscriber is implemented by runners that also receive events in the background between runs
+type Subscriber interface {
+	Subscribe(ctx context.Context)
+}
+
 type RunnerService struct {
 	wg       *sync.WaitGroup
 	name     string
@@ -23,22 +49,50 @@ type RunnerService struct {
 
 	healthMu sync.RWMutex
 	health   models.ServiceHealth
+	status   models.RunnerStatus
 }
 
-func (x *RunnerService) Start() {
+func (x *RunnerService) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-x.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if subscriber, ok := x.runner.(Subscriber); ok {
+		go subscriber.Subscribe(ctx)
+	}
+
 	log.Infof("[%s] Service started", x.name)
 	stop := false
 	for !stop {
 		log.Infof("[%s] Run started", x.name)
 
-		x.runner.Run()
+		startTime := time.Now()
+
+		err := x.runner.Run(ctx)
 
-		x.updateHealth(x.runner.Status())
+		if ctx.Err() != nil {
+			log.Infof("[%s] Run interrupted", x.name)
+		} else {
+			if err != nil {
+				log.Errorf("[%s] Run failed: %s", x.name, err)
+			}
 
-		log.Infof("[%s] Run complete, next run in %s", x.name, x.interval)
+			x.updateHealth(x.runner.Status(), err)
+
+			RecordRun(x.name, time.Since(startTime), err == nil)
+
+			log.Infof("[%s] Run complete, next run in %s", x.name, x.interval)
+		}
 
 		select {
-		case <-x.stop:
+		case <-ctx.Done():
 			log.Infof("[%s] Service stopped", x.name)
 			x.wg.Done()
 			stop = true
@@ -54,20 +108,58 @@ func (x *RunnerService) Health() models.ServiceHealth {
 	return x.health
 }
 
-func (x *RunnerService) updateHealth(status models.RunnerStatus) {
+func (x *RunnerService) Status() models.RunnerStatus {
+	x.healthMu.RLock()
+	defer x.healthMu.RUnlock()
+
+	return x.status
+}
+
+func (x *RunnerService) updateHealth(status models.RunnerStatus, err error) {
 	x.healthMu.Lock()
 	defer x.healthMu.Unlock()
 
+	x.status = status
+
 	lastSyncTime := time.Now()
 
-	x.health = models.ServiceHealth{
-		Name:           x.name,
-		LastSyncTime:   lastSyncTime,
-		NextSyncTime:   lastSyncTime.Add(x.interval),
-		PoktHeight:     status.PoktHeight,
-		EthBlockNumber: status.EthBlockNumber,
-		Healthy:        true,
+	health := x.health
+	health.Name = x.name
+	health.LastSyncTime = lastSyncTime
+	health.NextSyncTime = lastSyncTime.Add(x.interval)
+	health.PoktHeight = status.PoktHeight
+	health.EthBlockNumber = status.EthBlockNumber
+	health.FailedRange = status.FailedRange
+	health.Endpoints = status.Endpoints
+	SetEndpointHealth(x.name, status.Endpoints)
+
+	if err != nil {
+		health.ConsecutiveFailures++
+		health.ErrorCount++
+		health.LastError = err.Error()
+		health.LastErrorTime = lastSyncTime
+	} else {
+		health.ConsecutiveFailures = 0
+	}
+
+	health.State = serviceState(health.ConsecutiveFailures)
+	health.Healthy = health.State != models.ServiceStateUnhealthy
+
+	x.health = health
+}
+
+// serviceState derives the state of a service from its consecutive failed runs
+func serviceState(consecutiveFailures int64) models.ServiceState {
+	if consecutiveFailures == 0 {
+		return models.ServiceStateHealthy
+	}
+	if consecutiveFailures >= Config.HealthCheck.UnhealthyAfterFailures {
+		return models.ServiceStateUnhealthy
+	}
+	if consecutiveFailures >= Config.HealthCheck.DegradedAfterFailures {
+		return models.ServiceStateDegraded
 	}
+	return models.ServiceStateHealthy
 }
 
 func (x *RunnerService) Stop() {
@@ -94,7 +186,9 @@ func NewRunnerService(
 		interval: interval,
 		stop:     make(chan struct{}),
 		health: models.ServiceHealth{
-			Name: name,
+			Name:    name,
+			Healthy: true,
+			State:   models.ServiceStateHealthy,
 		},
 	}
 }
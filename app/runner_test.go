@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"strconv"
 	"sync"
 	"testing"
@@ -10,18 +12,35 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+type endpointReporter struct{}
+
+func (endpointReporter) EndpointHealth() []models.EndpointHealth {
+	return []models.EndpointHealth{{Name: "#0 node"}}
+}
+
+func TestEndpointHealth(t *testing.T) {
+	endpoints := EndpointHealth(endpointReporter{}, "not a client", endpointReporter{})
+
+	assert.Len(t, endpoints, 2)
+	assert.Nil(t, EndpointHealth("not a client"))
+}
+
 type MockRunner struct {
 	runs int
+	err  error
 }
 
-func (m *MockRunner) Run() {
+func (m *MockRunner) Run(ctx context.Context) error {
 	m.runs += 1
+	return m.err
 }
 
 func (m *MockRunner) Status() models.RunnerStatus {
 	return models.RunnerStatus{
 		PoktHeight:     strconv.Itoa(m.runs),
 		EthBlockNumber: "456",
+		FailedRange:    "123-456",
+		Endpoints:      []models.EndpointHealth{{Chain: ChainPocket, Name: "#0 node", Healthy: true, Score: 1}},
 	}
 }
 
@@ -32,7 +51,7 @@ func TestRunnerService(t *testing.T) {
 	service := NewRunnerService("TestService", mockRunner, wg, interval)
 	wg.Add(1)
 
-	go service.Start()
+	go service.Start(context.Background())
 
 	time.Sleep(600 * time.Millisecond)
 
@@ -42,11 +61,47 @@ func TestRunnerService(t *testing.T) {
 
 	health := service.Health()
 	assert.True(t, health.Healthy)
+	assert.Equal(t, models.ServiceStateHealthy, health.State)
+	assert.Equal(t, int64(0), health.ErrorCount)
 	assert.Equal(t, "TestService", health.Name)
 	runs, err := strconv.Atoi(health.PoktHeight)
 	assert.NoError(t, err)
 	assert.GreaterOrEqual(t, runs, 5)
 	assert.Equal(t, "456", health.EthBlockNumber)
+	assert.Equal(t, "123-456", health.FailedRange)
+	assert.Equal(t, "#0 node", health.Endpoints[0].Name)
+}
+
+func TestRunnerServiceHealth(t *testing.T) {
+	Config.HealthCheck.DegradedAfterFailures = 1
+	Config.HealthCheck.UnhealthyAfterFailures = 2
+
+	mockRunner := &MockRunner{err: errors.New("error")}
+	wg := &sync.WaitGroup{}
+	service := NewRunnerService("TestService", mockRunner, wg, time.Second).(*RunnerService)
+
+	service.updateHealth(mockRunner.Status(), mockRunner.Run(context.Background()))
+	health := service.Health()
+	assert.True(t, health.Healthy)
+	assert.Equal(t, models.ServiceStateDegraded, health.State)
+	assert.Equal(t, int64(1), health.ConsecutiveFailures)
+	assert.Equal(t, "error", health.LastError)
+
+	service.updateHealth(mockRunner.Status(), mockRunner.Run(context.Background()))
+	health = service.Health()
+	assert.False(t, health.Healthy)
+	assert.Equal(t, models.ServiceStateUnhealthy, health.State)
+	assert.Equal(t, int64(2), health.ConsecutiveFailures)
+	assert.Equal(t, int64(2), health.ErrorCount)
+
+	mockRunner.err = nil
+	service.updateHealth(mockRunner.Status(), mockRunner.Run(context.Background()))
+	health = service.Health()
+	assert.True(t, health.Healthy)
+	assert.Equal(t, models.ServiceStateHealthy, health.State)
+	assert.Equal(t, int64(0), health.ConsecutiveFailures)
+	assert.Equal(t, int64(2), health.ErrorCount)
+	assert.Equal(t, "error", health.LastError)
 }
 
 func TestNewRunnerServiceInvalidParameters(t *testing.T) {
@@ -61,3 +116,68 @@ func TestRunnerServiceStop(t *testing.T) {
 	service := NewRunnerService("TestService", mockRunner, wg, 100*time.Millisecond)
 	service.Stop()
 }
+
+type BlockingRunner struct{}
+
+func (m *BlockingRunner) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (m *BlockingRunner) Status() models.RunnerStatus {
+	return models.RunnerStatus{}
+}
+
+func TestRunnerServiceContextCancel(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	service := NewRunnerService("TestService", &BlockingRunner{}, wg, time.Hour)
+	wg.Add(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go service.Start(ctx)
+
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+
+	wg.Wait()
+
+	health := service.Health()
+	assert.True(t, health.Healthy)
+	assert.Equal(t, int64(0), health.ErrorCount)
+}
+
+type SubscribingRunner struct {
+	BlockingRunner
+	subscribed chan struct{}
+	stopped    chan struct{}
+}
+
+func (m *SubscribingRunner) Subscribe(ctx context.Context) {
+	close(m.subscribed)
+	<-ctx.Done()
+	close(m.stopped)
+}
+
+func TestRunnerServiceSubscriber(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	runner := &SubscribingRunner{subscribed: make(chan struct{}), stopped: make(chan struct{})}
+	service := NewRunnerService("TestService", runner, wg, time.Hour)
+	wg.Add(1)
+
+	go service.Start(context.Background())
+
+	select {
+	case <-runner.subscribed:
+	case <-time.After(time.Second):
+		t.Fatal("subscription not started")
+	}
+
+	service.Stop()
+	wg.Wait()
+
+	select {
+	case <-runner.stopped:
+	case <-time.After(time.Second):
+		t.Fatal("subscription not stopped")
+	}
+}
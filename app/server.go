@@ -0,0 +1,212 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	HttpServerName = "HTTP SERVER"
+
+	defaultPageLimit int64 = 50
+	maxPageLimit     int64 = 500
+
+	shutdownTimeout = 5 * time.Second
+)
+
+type ServiceStatus struct {
+	Health models.ServiceHealth `json:"health"`
+	Status models.RunnerStatus  `json:"status"`
+}
+
+type PagedResult struct {
+	Page  int64       `json:"page"`
+	Limit int64       `json:"limit"`
+	Total int64       `json:"total"`
+	Items interface{} `json:"items"`
+}
+
+type HttpServer struct {
+	wg          *sync.WaitGroup
+	listener    net.Listener
+	server      *http.Server
+	healthcheck *HealthCheckRunner
+	startedAt   time.Time
+}
+
+func (x *HttpServer) Start(ctx context.Context) {
+	x.server.BaseContext = func(net.Listener) context.Context { return ctx }
+
+	log.Infof("[%s] Listening on %s", HttpServerName, x.listener.Addr())
+	err := x.server.Serve(x.listener)
+	if err != nil && err != http.ErrServerClosed {
+		log.Error("[HTTP SERVER] Error serving http: ", err)
+	}
+	log.Infof("[%s] Service stopped", HttpServerName)
+	x.wg.Done()
+}
+
+func (x *HttpServer) Stop() {
+	log.Debugf("[%s] Stopping", HttpServerName)
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := x.server.Shutdown(ctx); err != nil {
+		log.Error("[HTTP SERVER] Error shutting down http server: ", err)
+	}
+}
+
+func (x *HttpServer) Health() models.ServiceHealth {
+	return models.ServiceHealth{
+		Name:         HttpServerName,
+		Healthy:      true,
+		LastSyncTime: x.startedAt,
+		NextSyncTime: x.startedAt,
+	}
+}
+
+func (x *HttpServer) Status() models.RunnerStatus {
+	return models.RunnerStatus{}
+}
+
+func (x *HttpServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", x.handleHealth)
+	mux.HandleFunc("GET /services", x.handleServices)
+	mux.Handle("GET /metrics", MetricsHandler())
+	mux.HandleFunc("GET /mints", x.handleList(models.CollectionMints, func() interface{} { return &[]models.Mint{} }))
+	mux.HandleFunc("GET /invalid-mints", x.handleList(models.CollectionInvalidMints, func() interface{} { return &[]models.InvalidMint{} }))
+	mux.HandleFunc("GET /burns", x.handleList(models.CollectionBurns, func() interface{} { return &[]models.Burn{} }))
+	return mux
+}
+
+func (x *HttpServer) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, x.healthcheck.CurrentHealth())
+}
+
+func (x *HttpServer) handleServices(w http.ResponseWriter, r *http.Request) {
+	statuses := []ServiceStatus{}
+	for _, service := range x.healthcheck.Services() {
+		health := service.Health()
+		if health.Name == EmptyServiceName || health.Name == "" {
+			continue
+		}
+		statuses = append(statuses, ServiceStatus{
+			Health: health,
+			Status: service.Status(),
+		})
+	}
+	writeJSON(w, http.StatusOK, statuses)
+}
+
+func (x *HttpServer) handleList(collection string, newResult func() interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, limit, err := parsePagination(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		filter := bson.M{}
+		if status := r.URL.Query().Get("status"); status != "" {
+			filter["status"] = status
+		}
+
+		total, err := DB.CountDocuments(r.Context(), collection, filter)
+		if err != nil {
+			log.Error("[HTTP SERVER] Error counting ", collection, ": ", err)
+			writeError(w, http.StatusInternalServerError, "error counting documents")
+			return
+		}
+
+		result := newResult()
+		sort := bson.D{{Key: "created_at", Value: -1}}
+		err = DB.FindManyPaged(r.Context(), collection, filter, sort, (page-1)*limit, limit, result)
+		if err != nil {
+			log.Error("[HTTP SERVER] Error listing ", collection, ": ", err)
+			writeError(w, http.StatusInternalServerError, "error listing documents")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, PagedResult{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+			Items: result,
+		})
+	}
+}
+
+func parsePagination(r *http.Request) (int64, int64, error) {
+	page := int64(1)
+	limit := defaultPageLimit
+
+	if value := r.URL.Query().Get("page"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed < 1 {
+			return 0, 0, fmt.Errorf("page must be a positive integer")
+		}
+		page = parsed
+	}
+
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			return 0, 0, fmt.Errorf("limit must be between 1 and %d", maxPageLimit)
+		}
+		limit = parsed
+	}
+
+	return page, limit, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Error("[HTTP SERVER] Error encoding response: ", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+func NewHttpServer(healthcheck *HealthCheckRunner, wg *sync.WaitGroup) Service {
+	if !Config.HTTPServer.Enabled {
+		log.Debug("[HTTP SERVER] Disabled")
+		return NewEmptyService(wg)
+	}
+
+	log.Debug("[HTTP SERVER] Initializing")
+
+	listener, err := net.Listen("tcp", Config.HTTPServer.ListenAddress)
+	if err != nil {
+		log.Fatal("[HTTP SERVER] Error listening on address: ", err)
+	}
+
+	x := &HttpServer{
+		wg:          wg,
+		listener:    listener,
+		healthcheck: healthcheck,
+		startedAt:   time.Now(),
+	}
+
+	x.server = &http.Server{
+		Handler:           x.Handler(),
+		ReadHeaderTimeout: shutdownTimeout,
+	}
+
+	log.Info("[HTTP SERVER] Initialized")
+
+	return x
+}
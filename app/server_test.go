@@ -0,0 +1,184 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func NewTestHttpServer() *HttpServer {
+	x := NewTestHealthCheck()
+	wg := &sync.WaitGroup{}
+	x.SetServices([]Service{
+		NewEmptyService(wg),
+		NewMockService(),
+	})
+	return &HttpServer{
+		wg:          wg,
+		healthcheck: x,
+	}
+}
+
+func TestHttpServerHealth(t *testing.T) {
+	x := NewTestHttpServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	rec := httptest.NewRecorder()
+	x.Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var health models.Health
+	err := json.Unmarshal(rec.Body.Bytes(), &health)
+	assert.Nil(t, err)
+	assert.Equal(t, "validatorId", health.ValidatorId)
+	assert.Equal(t, "hostname", health.Hostname)
+	assert.Equal(t, 1, len(health.ServiceHealths))
+	assert.Equal(t, MockServiceName, health.ServiceHealths[0].Name)
+}
+
+func TestHttpServerServices(t *testing.T) {
+	x := NewTestHttpServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/services", nil)
+	rec := httptest.NewRecorder()
+	x.Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var statuses []ServiceStatus
+	err := json.Unmarshal(rec.Body.Bytes(), &statuses)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(statuses))
+	assert.Equal(t, MockServiceName, statuses[0].Health.Name)
+}
+
+func TestHttpServerReadOnly(t *testing.T) {
+	x := NewTestHttpServer()
+
+	req := httptest.NewRequest(http.MethodPost, "/mints", nil)
+	rec := httptest.NewRecorder()
+	x.Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestHttpServerList(t *testing.T) {
+
+	t.Run("No Error", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+		x := NewTestHttpServer()
+
+		filter := bson.M{"status": models.StatusPending}
+		sort := bson.D{{Key: "created_at", Value: -1}}
+
+		mockDB.EXPECT().CountDocuments(mock.Anything, models.CollectionMints, filter).Return(3, nil)
+		mockDB.EXPECT().FindManyPaged(mock.Anything, models.CollectionMints, filter, sort, int64(2), int64(2), mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, _ interface{}, _ int64, _ int64, result interface{}) {
+				mints := result.(*[]models.Mint)
+				*mints = append(*mints, models.Mint{TransactionHash: "0x03"})
+			}).Return(nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/mints?status=pending&page=2&limit=2", nil)
+		rec := httptest.NewRecorder()
+		x.Handler().ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var result struct {
+			Page  int64         `json:"page"`
+			Limit int64         `json:"limit"`
+			Total int64         `json:"total"`
+			Items []models.Mint `json:"items"`
+		}
+		err := json.Unmarshal(rec.Body.Bytes(), &result)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), result.Page)
+		assert.Equal(t, int64(2), result.Limit)
+		assert.Equal(t, int64(3), result.Total)
+		assert.Equal(t, 1, len(result.Items))
+		assert.Equal(t, "0x03", result.Items[0].TransactionHash)
+	})
+
+	t.Run("Invalid Pagination", func(t *testing.T) {
+		x := NewTestHttpServer()
+
+		req := httptest.NewRequest(http.MethodGet, "/burns?limit=1000", nil)
+		rec := httptest.NewRecorder()
+		x.Handler().ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		req = httptest.NewRequest(http.MethodGet, "/burns?page=0", nil)
+		rec = httptest.NewRecorder()
+		x.Handler().ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Count Error", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+		x := NewTestHttpServer()
+
+		mockDB.EXPECT().CountDocuments(mock.Anything, models.CollectionInvalidMints, bson.M{}).Return(0, errors.New("error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/invalid-mints", nil)
+		rec := httptest.NewRecorder()
+		x.Handler().ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("Find Error", func(t *testing.T) {
+		mockDB := mocks.NewMockDatabase(t)
+		DB = mockDB
+		x := NewTestHttpServer()
+
+		mockDB.EXPECT().CountDocuments(mock.Anything, models.CollectionBurns, bson.M{}).Return(0, nil)
+		mockDB.EXPECT().FindManyPaged(mock.Anything, models.CollectionBurns, bson.M{}, mock.Anything, int64(0), defaultPageLimit, mock.Anything).Return(errors.New("error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/burns", nil)
+		rec := httptest.NewRecorder()
+		x.Handler().ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestNewHttpServer(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		Config.HTTPServer.Enabled = false
+		wg := &sync.WaitGroup{}
+		service := NewHttpServer(NewTestHealthCheck(), wg)
+
+		assert.Equal(t, EmptyServiceName, service.Health().Name)
+	})
+
+	t.Run("Start and Stop", func(t *testing.T) {
+		Config.HTTPServer.Enabled = true
+		Config.HTTPServer.ListenAddress = "127.0.0.1:0"
+		defer func() { Config.HTTPServer.Enabled = false }()
+
+		wg := &sync.WaitGroup{}
+		service := NewHttpServer(NewTestHealthCheck(), wg)
+		assert.Equal(t, HttpServerName, service.Health().Name)
+
+		wg.Add(1)
+		go service.Start(context.Background())
+
+		service.Stop()
+		wg.Wait()
+	})
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -8,8 +9,9 @@ import (
 )
 
 type Service interface {
-	Start()
+	Start(ctx context.Context)
 	Health() models.ServiceHealth
+	Status() models.RunnerStatus
 	Stop()
 }
 
@@ -17,7 +19,7 @@ type EmptyService struct {
 	wg *sync.WaitGroup
 }
 
-func (e *EmptyService) Start() {}
+func (e *EmptyService) Start(ctx context.Context) {}
 
 func (e *EmptyService) Stop() {
 	e.wg.Done()
@@ -36,6 +38,10 @@ func (e *EmptyService) Health() models.ServiceHealth {
 	}
 }
 
+func (e *EmptyService) Status() models.RunnerStatus {
+	return models.RunnerStatus{}
+}
+
 func NewEmptyService(wg *sync.WaitGroup) Service {
 	return &EmptyService{
 		wg: wg,
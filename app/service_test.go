@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"io"
 	"sync"
 	"testing"
@@ -24,7 +25,7 @@ func TestEmptyService(t *testing.T) {
 
 		wg.Add(1)
 
-		service.Start()
+		service.Start(context.Background())
 
 		health := service.Health()
 
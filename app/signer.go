@@ -7,12 +7,10 @@ import (
 	"bytes"
 	"sort"
 
-	"crypto/ecdsa"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
 	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/dan13ram/wpokt-validator/common"
-	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	log "github.com/sirupsen/logrus"
 
 	"encoding/hex"
@@ -28,12 +26,15 @@ type PocketSigner struct {
 
 func CreatePocketSigner() (common.Signer, error) {
 	config := Config.Pocket
-	if config.Mnemonic == "" && config.GcpKmsKeyName == "" {
-		return nil, fmt.Errorf("both Mnemonic and GcpKmsKeyName are empty")
+	if config.Mnemonic == "" && config.RemoteSignerSocket == "" && config.GcpKmsKeyName == "" {
+		return nil, fmt.Errorf("none of Mnemonic, RemoteSignerSocket or GcpKmsKeyName is set")
 	}
 	if config.Mnemonic != "" {
 		return common.NewMnemonicSigner(config.Mnemonic)
 	}
+	if config.RemoteSignerSocket != "" {
+		return common.NewRemoteSigner(config.RemoteSignerSocket, config.RemoteSignerToken)
+	}
 
 	return common.NewGcpKmsSigner(config.GcpKmsKeyName)
 
@@ -102,21 +103,46 @@ func GetPocketSignerAndMultisig() (*PocketSigner, error) {
 	}, nil
 }
 
+// ValidatorId identifies the validator by its position in the multisig
+func (s *PocketSigner) ValidatorId() string {
+	return fmt.Sprintf("wpokt-validator-%02d", s.SignerIndex+1)
+}
+
 type EthereumSigner struct {
-	PrivateKey *ecdsa.PrivateKey
-	Address    string
+	Signer  common.Signer
+	Address string
+}
+
+func CreateEthereumSigner() (common.Signer, error) {
+	config := Config.Ethereum
+	if config.PrivateKey == "" && config.Mnemonic == "" && config.RemoteSignerSocket == "" && config.GcpKmsKeyName == "" {
+		return nil, fmt.Errorf("none of PrivateKey, Mnemonic, RemoteSignerSocket or GcpKmsKeyName is set")
+	}
+	if config.PrivateKey != "" {
+		return common.NewPrivateKeySigner(config.PrivateKey)
+	}
+	if config.Mnemonic != "" {
+		return common.NewMnemonicSigner(config.Mnemonic)
+	}
+	if config.RemoteSignerSocket != "" {
+		return common.NewRemoteSigner(config.RemoteSignerSocket, config.RemoteSignerToken)
+	}
+
+	return common.NewGcpKmsSigner(config.GcpKmsKeyName)
 }
 
 func GetEthereumSigner() (*EthereumSigner, error) {
-	ethPK, err := ethCrypto.HexToECDSA(Config.Ethereum.PrivateKey)
+	signer, err := CreateEthereumSigner()
 	if err != nil {
 		return nil, fmt.Errorf("error initializing ethereum signer: %w", err)
 	}
 
-	ethAddress := ethCrypto.PubkeyToAddress(ethPK.PublicKey).Hex()
+	ethAddress := signer.EthAddress().Hex()
+
+	log.Debugf("[SIGNER] Ethereum address: %s", ethAddress)
 
 	return &EthereumSigner{
-		PrivateKey: ethPK,
-		Address:    ethAddress,
+		Signer:  signer,
+		Address: ethAddress,
 	}, nil
 }
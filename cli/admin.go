@@ -0,0 +1,323 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/common"
+	"github.com/dan13ram/wpokt-validator/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const adminUsage = `usage: admin <command>
+
+commands:
+  list <collection> [-status <status>] [-tx <hash>] [-address <address>] [-limit <n>]
+                                        list documents, newest first
+  show <collection> <id or tx hash>     show documents, the validators that signed them and the actions applied to them
+  fail <collection> <id> -reason <reason>
+                                        force a document to failed
+  requeue <collection> <id> -reason <reason>
+                                        move a document back to pending and clear its failed attempts
+  reset <collection> <id> -reason <reason>
+                                        clear the signatures and sequence of a document and move it back to pending
+
+collections: mints, invalid-mints, burns`
+
+const defaultAdminListLimit = 50
+
+// adminRecord is the part of a mint, invalid mint or burn shown by the admin commands
+type adminRecord struct {
+	doc       interface{}
+	id        primitive.ObjectID
+	txHash    string
+	status    string
+	sender    string
+	recipient string
+	amount    string
+	attempts  int64
+	updatedAt time.Time
+	signers   []string
+}
+
+type adminCollection struct {
+	name       string
+	newResult  func() interface{}
+	records    func(result interface{}) []adminRecord
+	validators func() ([]string, error)
+}
+
+// maxAttempts is the most failed attempts of any validator at a document
+func maxAttempts(retries map[string]models.Retry) int64 {
+	var attempts int64
+	for _, retry := range retries {
+		attempts = max(attempts, retry.Attempts)
+	}
+	return attempts
+}
+
+func burnSigners(signatures []models.Signature) []string {
+	signers := []string{}
+	for _, sig := range signatures {
+		signers = append(signers, sig.Signer)
+	}
+	return signers
+}
+
+// ethereumValidators are the addresses mints are signed by
+func ethereumValidators() ([]string, error) {
+	validators := []string{}
+	for _, address := range app.Config.Ethereum.ValidatorAddresses {
+		validators = append(validators, strings.ToLower(address))
+	}
+	return validators, nil
+}
+
+// pocketValidators are the addresses of the multisig keys return transactions are signed by
+func pocketValidators() ([]string, error) {
+	validators := []string{}
+	for index, pk := range app.Config.Pocket.MultisigPublicKeys {
+		pKey, err := common.CosmosPublicKeyFromHex(pk)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing multisig public key [%d]: %w", index, err)
+		}
+		address, err := common.AddressHexFromBytes(pKey.Address().Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("error getting address of multisig public key [%d]: %w", index, err)
+		}
+		validators = append(validators, address)
+	}
+	return validators, nil
+}
+
+var adminCollections = map[string]adminCollection{
+	"mints": {
+		name:      models.CollectionMints,
+		newResult: func() interface{} { return &[]models.Mint{} },
+		records: func(result interface{}) []adminRecord {
+			records := []adminRecord{}
+			for _, doc := range *result.(*[]models.Mint) {
+				records = append(records, adminRecord{
+					doc: doc, id: *doc.Id, txHash: doc.TransactionHash, status: doc.Status,
+					sender: doc.SenderAddress, recipient: doc.RecipientAddress, amount: doc.Amount,
+					attempts: maxAttempts(doc.Retries), updatedAt: doc.UpdatedAt, signers: doc.Signers,
+				})
+			}
+			return records
+		},
+		validators: ethereumValidators,
+	},
+	"invalid-mints": {
+		name:      models.CollectionInvalidMints,
+		newResult: func() interface{} { return &[]models.InvalidMint{} },
+		records: func(result interface{}) []adminRecord {
+			records := []adminRecord{}
+			for _, doc := range *result.(*[]models.InvalidMint) {
+				// an invalid mint is returned to its sender
+				records = append(records, adminRecord{
+					doc: doc, id: *doc.Id, txHash: doc.TransactionHash, status: doc.Status,
+					sender: doc.SenderAddress, recipient: doc.SenderAddress, amount: doc.Amount,
+					attempts: maxAttempts(doc.Retries), updatedAt: doc.UpdatedAt, signers: burnSigners(doc.Signatures),
+				})
+			}
+			return records
+		},
+		validators: pocketValidators,
+	},
+	"burns": {
+		name:      models.CollectionBurns,
+		newResult: func() interface{} { return &[]models.Burn{} },
+		records: func(result interface{}) []adminRecord {
+			records := []adminRecord{}
+			for _, doc := range *result.(*[]models.Burn) {
+				records = append(records, adminRecord{
+					doc: doc, id: *doc.Id, txHash: doc.TransactionHash, status: doc.Status,
+					sender: doc.SenderAddress, recipient: doc.RecipientAddress, amount: doc.Amount,
+					attempts: maxAttempts(doc.Retries), updatedAt: doc.UpdatedAt, signers: burnSigners(doc.Signatures),
+				})
+			}
+			return records
+		},
+		validators: pocketValidators,
+	},
+}
+
+// Admin inspects mints, invalid mints and burns and manually transitions them during incidents
+func Admin(ctx context.Context, args []string) error {
+	if len(args) < 2 {
+		return errors.New(adminUsage)
+	}
+
+	collection, ok := adminCollections[args[1]]
+	if !ok {
+		return fmt.Errorf("unknown collection: %s\n%s", args[1], adminUsage)
+	}
+
+	switch args[0] {
+	case "list":
+		return adminList(ctx, os.Stdout, collection, args[2:])
+	case "show":
+		return adminShow(ctx, os.Stdout, collection, args[2:])
+	case models.AdminActionFail, models.AdminActionRequeue, models.AdminActionReset:
+		return adminTransition(ctx, os.Stdout, collection, args[0], args[2:])
+	default:
+		return errors.New(adminUsage)
+	}
+}
+
+// parseInterspersed parses flags given before or after the positional arguments
+func parseInterspersed(flags *flag.FlagSet, args []string) ([]string, error) {
+	positional := []string{}
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, fmt.Errorf("%w\n%s", err, adminUsage)
+		}
+		if flags.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+}
+
+func adminList(ctx context.Context, out io.Writer, collection adminCollection, args []string) error {
+	flags := flag.NewFlagSet("admin list", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	status := flags.String("status", "", "status of the documents, e.g. \"needs_attention\"")
+	tx := flags.String("tx", "", "source transaction hash")
+	address := flags.String("address", "", "sender or recipient address")
+	limit := flags.Int64("limit", defaultAdminListLimit, "maximum number of documents")
+	if _, err := parseInterspersed(flags, args); err != nil {
+		return err
+	}
+	if *limit <= 0 {
+		return fmt.Errorf("limit must be a positive integer")
+	}
+
+	filter := bson.M{}
+	if *status != "" {
+		filter["status"] = *status
+	}
+	if *tx != "" {
+		filter["transaction_hash"] = common.Ensure0xPrefix(*tx)
+	}
+	if *address != "" {
+		a := strings.ToLower(*address)
+		filter["$or"] = []bson.M{{"sender_address": a}, {"recipient_address": a}}
+	}
+
+	result := collection.newResult()
+	sort := bson.D{{Key: "created_at", Value: -1}}
+	if err := app.DB.FindManyPaged(ctx, collection.name, filter, sort, 0, *limit, result); err != nil {
+		return fmt.Errorf("error listing %s: %w", collection.name, err)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTX HASH\tSTATUS\tSENDER\tRECIPIENT\tAMOUNT\tSIGNERS\tATTEMPTS\tUPDATED AT")
+	for _, r := range collection.records(result) {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%d\t%s\n", r.id.Hex(), r.txHash, r.status, r.sender, r.recipient, r.amount, len(r.signers), r.attempts, r.updatedAt.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+func adminShow(ctx context.Context, out io.Writer, collection adminCollection, args []string) error {
+	if len(args) != 1 {
+		return errors.New(adminUsage)
+	}
+
+	filter := bson.M{"transaction_hash": common.Ensure0xPrefix(args[0])}
+	if id, err := primitive.ObjectIDFromHex(args[0]); err == nil {
+		filter = bson.M{"_id": id}
+	}
+
+	result := collection.newResult()
+	if err := app.DB.FindMany(ctx, collection.name, filter, result); err != nil {
+		return fmt.Errorf("error finding %s: %w", collection.name, err)
+	}
+	records := collection.records(result)
+	if len(records) == 0 {
+		return fmt.Errorf("no %s found for %s", collection.name, args[0])
+	}
+
+	validators, err := collection.validators()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		doc, err := json.MarshalIndent(r.doc, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error encoding document: %w", err)
+		}
+		fmt.Fprintf(out, "%s\n\n", doc)
+
+		signed := make(map[string]bool)
+		for _, signer := range r.signers {
+			signed[strings.ToLower(signer)] = true
+		}
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "Signed by %d of %d validators\n", len(r.signers), len(validators))
+		fmt.Fprintln(w, "VALIDATOR\tSIGNED")
+		for _, validator := range validators {
+			fmt.Fprintf(w, "%s\t%t\n", validator, signed[validator])
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		actions, err := app.FindAdminActions(ctx, collection.name, r.id)
+		if err != nil {
+			return fmt.Errorf("error finding admin actions: %w", err)
+		}
+		if len(actions) == 0 {
+			fmt.Fprintln(out, "\nNo admin actions")
+			continue
+		}
+		w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "\nAT\tACTION\tFROM\tTO\tOPERATOR\tREASON")
+		for _, a := range actions {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", a.CreatedAt.Format(time.RFC3339), a.Action, a.FromStatus, a.ToStatus, a.Operator, a.Reason)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func adminTransition(ctx context.Context, out io.Writer, collection adminCollection, action string, args []string) error {
+	flags := flag.NewFlagSet("admin "+action, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	reason := flags.String("reason", "", "why the action is needed, recorded with the action")
+	operator := flags.String("operator", os.Getenv("USER"), "who applies the action, recorded with the action")
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New(adminUsage)
+	}
+
+	id, err := primitive.ObjectIDFromHex(positional[0])
+	if err != nil {
+		return fmt.Errorf("invalid id %q: %w", positional[0], err)
+	}
+
+	record, err := app.ApplyAdminAction(ctx, collection.name, id, action, *reason, *operator)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Applied %s to %s %s: %s -> %s\n", action, collection.name, record.DocumentId, record.FromStatus, record.ToStatus)
+	return nil
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	appMocks "github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestAdminList(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	t.Run("With Filters", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+
+		filter := bson.M{
+			"status":           models.StatusNeedsAttention,
+			"transaction_hash": "0xabc",
+			"$or":              []bson.M{{"sender_address": "0xsender"}, {"recipient_address": "0xsender"}},
+		}
+		mockDB.EXPECT().FindManyPaged(mock.Anything, models.CollectionBurns, filter, mock.Anything, int64(0), int64(10), mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, _ interface{}, _ int64, _ int64, result interface{}) {
+				*result.(*[]models.Burn) = []models.Burn{{
+					Id:              &id,
+					TransactionHash: "0xabc",
+					Status:          models.StatusNeedsAttention,
+					SenderAddress:   "0xsender",
+					Amount:          "100",
+					Retries:         map[string]models.Retry{"0xsigner": {Attempts: 10}, "0xother": {Attempts: 2}},
+					Signatures:      []models.Signature{{Signer: "0xsigner"}},
+				}}
+			}).Return(nil)
+
+		var out bytes.Buffer
+		err := adminList(context.Background(), &out, adminCollections["burns"], []string{"-status", "needs_attention", "-tx", "ABC", "-address", "0xSENDER", "-limit", "10"})
+
+		assert.Nil(t, err)
+		assert.Contains(t, out.String(), id.Hex())
+		assert.Contains(t, out.String(), "0xabc")
+		assert.Contains(t, out.String(), "needs_attention")
+		assert.Regexp(t, `100\s+1\s+10\s`, out.String())
+	})
+
+	t.Run("Invalid Limit", func(t *testing.T) {
+		var out bytes.Buffer
+
+		err := adminList(context.Background(), &out, adminCollections["mints"], []string{"-limit", "0"})
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestAdminShow(t *testing.T) {
+	app.Config.Ethereum.ValidatorAddresses = []string{"0xAAA", "0xBBB"}
+	id := primitive.NewObjectID()
+
+	mockDB := appMocks.NewMockDatabase(t)
+	app.DB = mockDB
+
+	mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, bson.M{"_id": id}, mock.Anything).
+		Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+			*result.(*[]models.Mint) = []models.Mint{{Id: &id, Status: models.StatusConfirmed, Signers: []string{"0xaaa"}}}
+		}).Return(nil)
+	mockDB.EXPECT().FindManySorted(mock.Anything, models.CollectionAdminActions, bson.M{"collection": models.CollectionMints, "document_id": id.Hex()}, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ string, _ interface{}, _ interface{}, result interface{}) {
+			*result.(*[]models.AdminAction) = []models.AdminAction{{
+				Action:     models.AdminActionRequeue,
+				FromStatus: models.StatusNeedsAttention,
+				ToStatus:   models.StatusPending,
+				Operator:   "alice",
+				Reason:     "rpc outage",
+				CreatedAt:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			}}
+		}).Return(nil)
+
+	var out bytes.Buffer
+	err := adminShow(context.Background(), &out, adminCollections["mints"], []string{id.Hex()})
+
+	assert.Nil(t, err)
+	assert.Contains(t, out.String(), "Signed by 1 of 2 validators")
+	assert.Contains(t, out.String(), "0xaaa      true")
+	assert.Contains(t, out.String(), "0xbbb      false")
+	assert.Contains(t, out.String(), "2024-01-02T03:04:05Z  requeue  needs_attention  pending  alice     rpc outage")
+}
+
+func TestAdminTransition(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	t.Run("No Error", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionInvalidMints, bson.M{"_id": id}, mock.Anything).Return(nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+		mockDB.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(id, nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionAdminActions, mock.Anything).
+			Run(func(_ context.Context, _ string, data interface{}) {
+				assert.Equal(t, "stuck sequence", data.(models.AdminAction).Reason)
+				assert.Equal(t, "alice", data.(models.AdminAction).Operator)
+			}).Return(primitive.NewObjectID(), nil)
+
+		var out bytes.Buffer
+		err := adminTransition(context.Background(), &out, adminCollections["invalid-mints"], models.AdminActionFail, []string{id.Hex(), "-reason", "stuck sequence", "-operator", "alice"})
+
+		assert.Nil(t, err)
+		assert.Contains(t, out.String(), "Applied fail to invalidMints "+id.Hex())
+	})
+
+	t.Run("Invalid Arguments", func(t *testing.T) {
+		var out bytes.Buffer
+
+		err := adminTransition(context.Background(), &out, adminCollections["burns"], models.AdminActionFail, []string{"-reason", "reason"})
+		assert.NotNil(t, err)
+
+		err = adminTransition(context.Background(), &out, adminCollections["burns"], models.AdminActionFail, []string{"notanid", "-reason", "reason"})
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dan13ram/wpokt-validator/app"
+)
+
+const auditUsage = `usage: audit <command>
+
+commands:
+  verify [-file <path>]    verify the hash chain of the audit log`
+
+// Audit inspects the signature audit log of this validator
+func Audit(args []string) error {
+	if len(args) == 0 {
+		return errors.New(auditUsage)
+	}
+
+	switch args[0] {
+	case "verify":
+		return verifyAuditLog(os.Stdout, args[1:])
+	default:
+		return errors.New(auditUsage)
+	}
+}
+
+func verifyAuditLog(out io.Writer, args []string) error {
+	flags := flag.NewFlagSet("audit verify", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	file := flags.String("file", app.Config.AuditLog.File, "path of the audit log file")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w\n%s", err, auditUsage)
+	}
+
+	if *file == "" {
+		return fmt.Errorf("no audit log file configured")
+	}
+
+	last, err := app.VerifyAuditLog(*file)
+	if err != nil {
+		return fmt.Errorf("audit log is invalid: %w", err)
+	}
+
+	if last == nil {
+		fmt.Fprintf(out, "Audit log %s is empty\n", *file)
+		return nil
+	}
+	fmt.Fprintf(out, "Audit log %s is valid with %d entries, last hash %s\n", *file, last.Index+1, last.Hash)
+	return nil
+}
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyAuditLog(t *testing.T) {
+	defer func() { app.Config.AuditLog = models.AuditLogConfig{} }()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	app.Config.AuditLog = models.AuditLogConfig{File: path}
+	app.InitAuditLog()
+	assert.NoError(t, app.RecordSignature(context.Background(), models.AuditEntry{Kind: app.SigningKindMint}))
+
+	t.Run("Valid", func(t *testing.T) {
+		var out bytes.Buffer
+		err := verifyAuditLog(&out, []string{})
+
+		assert.Nil(t, err)
+		assert.Contains(t, out.String(), "is valid with 1 entries")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		invalid := filepath.Join(t.TempDir(), "invalid.log")
+		assert.NoError(t, os.WriteFile(invalid, []byte(`{"index":1}`+"\n"), 0600))
+
+		var out bytes.Buffer
+		err := verifyAuditLog(&out, []string{"-file", invalid})
+
+		assert.ErrorContains(t, err, "audit log is invalid: line 1: expected index 0, found 1")
+	})
+
+	t.Run("No file", func(t *testing.T) {
+		app.Config.AuditLog.File = ""
+
+		var out bytes.Buffer
+		err := verifyAuditLog(&out, []string{})
+
+		assert.ErrorContains(t, err, "no audit log file configured")
+	})
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/cosmos"
+	"github.com/dan13ram/wpokt-validator/eth"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const checkpointUsage = `usage: checkpoint <command>
+
+commands:
+  list                                  list the sync checkpoints of this validator
+  rewind -service <name> -height <n>    rewind the sync checkpoint of a service`
+
+// Checkpoint inspects or rewinds the sync checkpoints of this validator
+func Checkpoint(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New(checkpointUsage)
+	}
+
+	signer, err := app.GetPocketSignerAndMultisig()
+	if err != nil {
+		return fmt.Errorf("error getting signer and multisig: %w", err)
+	}
+
+	switch args[0] {
+	case "list":
+		return listCheckpoints(ctx, os.Stdout, signer.ValidatorId())
+	case "rewind":
+		return rewindCheckpoint(ctx, os.Stdout, signer.ValidatorId(), signer.MultisigAddress, args[1:])
+	default:
+		return errors.New(checkpointUsage)
+	}
+}
+
+func listCheckpoints(ctx context.Context, out io.Writer, validatorId string) error {
+	checkpoints, err := app.FindCheckpoints(ctx, validatorId)
+	if err != nil {
+		return fmt.Errorf("error finding checkpoints: %w", err)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SERVICE\tADDRESS\tHEIGHT\tUPDATED AT")
+	for _, checkpoint := range checkpoints {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", checkpoint.Service, checkpoint.Address, checkpoint.Height, checkpoint.UpdatedAt.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+// checkpointAddress returns the address the checkpoints of a service are keyed by
+func checkpointAddress(service string, vaultAddress string) (string, error) {
+	switch service {
+	case cosmos.MintMonitorName:
+		return vaultAddress, nil
+	case eth.BurnMonitorName, eth.MintExecutorName:
+		return strings.ToLower(app.Config.Ethereum.WrappedPocketAddress), nil
+	default:
+		return "", fmt.Errorf("service %q does not keep a checkpoint", service)
+	}
+}
+
+func rewindCheckpoint(ctx context.Context, out io.Writer, validatorId string, vaultAddress string, args []string) error {
+	flags := flag.NewFlagSet("checkpoint rewind", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	service := flags.String("service", "", "service name, e.g. \"mint-monitor\"")
+	height := flags.Int64("height", 0, "height or block number to resume syncing from")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w\n%s", err, checkpointUsage)
+	}
+
+	name := strings.ToUpper(strings.ReplaceAll(*service, "-", " "))
+	address, err := checkpointAddress(name, vaultAddress)
+	if err != nil {
+		return err
+	}
+
+	if *height <= 0 {
+		return fmt.Errorf("height must be a positive integer")
+	}
+
+	// a running service holds the lock while it syncs, and reloads the rewound checkpoint on its next sync
+	lock, err := app.LockCheckpoint(ctx, validatorId, name, address)
+	if err != nil {
+		return fmt.Errorf("error locking checkpoint, the service may be syncing, try again: %w", err)
+	}
+	defer func() {
+		//nolint:errcheck
+		lock.Release(ctx)
+	}()
+
+	checkpoint, err := app.FindCheckpoint(ctx, validatorId, name, address)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return fmt.Errorf("error finding checkpoint: %w", err)
+	}
+	if err == nil && *height > checkpoint.Height {
+		return fmt.Errorf("height %d is ahead of the checkpoint at %d, only rewinding is allowed", *height, checkpoint.Height)
+	}
+
+	if err := app.RewindCheckpoint(ctx, validatorId, name, address, *height); err != nil {
+		return fmt.Errorf("error rewinding checkpoint: %w", err)
+	}
+
+	fmt.Fprintf(out, "Rewound %s checkpoint for %s to %d\n", name, address, *height)
+	return nil
+}
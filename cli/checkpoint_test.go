@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	appMocks "github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/cosmos"
+	"github.com/dan13ram/wpokt-validator/eth"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func init() {
+	log.SetOutput(io.Discard)
+}
+
+func TestListCheckpoints(t *testing.T) {
+	mockDB := appMocks.NewMockDatabase(t)
+	app.DB = mockDB
+
+	mockDB.EXPECT().FindManySorted(mock.Anything, models.CollectionCheckpoints, bson.M{"validator_id": "validatorId"}, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ string, _ interface{}, _ interface{}, result interface{}) {
+			checkpoints := result.(*[]models.Checkpoint)
+			*checkpoints = append(*checkpoints, models.Checkpoint{Service: cosmos.MintMonitorName, Address: "vaultaddress", Height: 10})
+		}).Return(nil)
+
+	var out bytes.Buffer
+	err := listCheckpoints(context.Background(), &out, "validatorId")
+
+	assert.Nil(t, err)
+	assert.Contains(t, out.String(), "MINT MONITOR  vaultaddress  10")
+}
+
+func TestRewindCheckpoint(t *testing.T) {
+
+	t.Run("No Error", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.Ethereum.WrappedPocketAddress = "0xABCD"
+
+		filter := bson.M{"validator_id": "validatorId", "service": eth.BurnMonitorName, "address": "0xabcd"}
+		mockDB.EXPECT().XLock(mock.Anything, "checkpoints/validatorId/BURN MONITOR/0xabcd").Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				result.(*models.Checkpoint).Height = 100
+			}).Return(nil)
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).Return(primitive.NewObjectID(), nil)
+
+		var out bytes.Buffer
+		err := rewindCheckpoint(context.Background(), &out, "validatorId", "vaultaddress", []string{"-service", "burn-monitor", "-height", "50"})
+
+		assert.Nil(t, err)
+		assert.Contains(t, out.String(), "to 50")
+	})
+
+	t.Run("No Checkpoint", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+
+		filter := bson.M{"validator_id": "validatorId", "service": cosmos.MintMonitorName, "address": "vaultaddress"}
+		mockDB.EXPECT().XLock(mock.Anything, "checkpoints/validatorId/MINT MONITOR/vaultaddress").Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).Return(mongo.ErrNoDocuments)
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).Return(primitive.NewObjectID(), nil)
+
+		var out bytes.Buffer
+		err := rewindCheckpoint(context.Background(), &out, "validatorId", "vaultaddress", []string{"-service", "MINT MONITOR", "-height", "50"})
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Ahead of Checkpoint", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+
+		mockDB.EXPECT().XLock(mock.Anything, "checkpoints/validatorId/MINT MONITOR/vaultaddress").Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				result.(*models.Checkpoint).Height = 100
+			}).Return(nil)
+
+		var out bytes.Buffer
+		err := rewindCheckpoint(context.Background(), &out, "validatorId", "vaultaddress", []string{"-service", "mint-monitor", "-height", "150"})
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Service Syncing", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+
+		mockDB.EXPECT().XLock(mock.Anything, "checkpoints/validatorId/MINT MONITOR/vaultaddress").Return("", errors.New("locked"))
+
+		var out bytes.Buffer
+		err := rewindCheckpoint(context.Background(), &out, "validatorId", "vaultaddress", []string{"-service", "mint-monitor", "-height", "50"})
+
+		assert.ErrorContains(t, err, "the service may be syncing")
+	})
+
+	t.Run("Invalid Arguments", func(t *testing.T) {
+		var out bytes.Buffer
+
+		err := rewindCheckpoint(context.Background(), &out, "validatorId", "vaultaddress", []string{"-service", "burn-signer", "-height", "50"})
+		assert.NotNil(t, err)
+
+		err = rewindCheckpoint(context.Background(), &out, "validatorId", "vaultaddress", []string{"-service", "mint-monitor"})
+		assert.NotNil(t, err)
+
+		err = rewindCheckpoint(context.Background(), &out, "validatorId", "vaultaddress", []string{"-unknown"})
+		assert.NotNil(t, err)
+	})
+
+}
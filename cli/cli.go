@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+)
+
+// Run executes an operator command given after the flags instead of starting the validator
+func Run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no command given")
+	}
+
+	switch args[0] {
+	case "checkpoint":
+		return Checkpoint(ctx, args[1:])
+	case "audit":
+		return Audit(args[1:])
+	case "migrate":
+		return Migrate(ctx, args[1:])
+	case "admin":
+		return Admin(ctx, args[1:])
+	default:
+		return fmt.Errorf("unknown command: %s", args[0])
+	}
+}
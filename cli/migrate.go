@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+)
+
+const migrateUsage = `usage: migrate <command>
+
+commands:
+  status    list the migrations of this binary and when they were applied
+  up        apply the pending migrations`
+
+// Migrate inspects or applies the database migrations
+func Migrate(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	switch args[0] {
+	case "status":
+		return migrationStatus(ctx, os.Stdout)
+	case "up":
+		return migrateUp(ctx, os.Stdout)
+	default:
+		return errors.New(migrateUsage)
+	}
+}
+
+func migrationStatus(ctx context.Context, out io.Writer) error {
+	applied, err := app.DB.AppliedMigrations(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading applied migrations: %w", err)
+	}
+	appliedAt := make(map[int64]time.Time)
+	for _, m := range applied {
+		appliedAt[m.Version] = m.AppliedAt
+	}
+
+	known := make(map[int64]bool)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tDESCRIPTION\tAPPLIED AT")
+	for _, m := range app.DB.Migrations() {
+		known[m.Version] = true
+		status := "pending"
+		if at, ok := appliedAt[m.Version]; ok {
+			status = at.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", m.Version, m.Description, status)
+	}
+	for _, m := range applied {
+		if !known[m.Version] {
+			fmt.Fprintf(w, "%d\t%s\t%s (unknown to this binary)\n", m.Version, m.Description, m.AppliedAt.Format(time.RFC3339))
+		}
+	}
+	return w.Flush()
+}
+
+func migrateUp(ctx context.Context, out io.Writer) error {
+	applied, err := app.Migrate(ctx, app.DB)
+	for _, m := range applied {
+		fmt.Fprintf(out, "Applied migration %d: %s\n", m.Version, m.Description)
+	}
+	if err != nil {
+		return err
+	}
+	if len(applied) == 0 {
+		fmt.Fprintln(out, "No pending migrations")
+	}
+	return nil
+}
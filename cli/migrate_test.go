@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	appMocks "github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMigrationStatus(t *testing.T) {
+	mockDB := appMocks.NewMockDatabase(t)
+	app.DB = mockDB
+
+	appliedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockDB.EXPECT().Migrations().Return([]models.Migration{{Version: 1, Description: "first"}, {Version: 2, Description: "second"}})
+	mockDB.EXPECT().AppliedMigrations(mock.Anything).Return([]models.SchemaMigration{
+		{Version: 1, Description: "first", AppliedAt: appliedAt},
+		{Version: 3, Description: "third", AppliedAt: appliedAt},
+	}, nil)
+
+	var out bytes.Buffer
+	err := migrationStatus(context.Background(), &out)
+
+	assert.Nil(t, err)
+	assert.Contains(t, out.String(), "1        first        2024-01-02T03:04:05Z")
+	assert.Contains(t, out.String(), "2        second       pending")
+	assert.Contains(t, out.String(), "3        third        2024-01-02T03:04:05Z (unknown to this binary)")
+}
+
+func TestMigrateUp(t *testing.T) {
+
+	t.Run("Pending", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+
+		mockDB.EXPECT().Migrations().Return([]models.Migration{{Version: 1, Description: "first"}})
+		mockDB.EXPECT().AppliedMigrations(mock.Anything).Return([]models.SchemaMigration{}, nil)
+		mockDB.EXPECT().ApplyMigration(mock.Anything, models.Migration{Version: 1, Description: "first"}).Return(nil)
+
+		var out bytes.Buffer
+		err := migrateUp(context.Background(), &out)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Applied migration 1: first\n", out.String())
+	})
+
+	t.Run("Up To Date", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+
+		mockDB.EXPECT().Migrations().Return([]models.Migration{{Version: 1, Description: "first"}})
+		mockDB.EXPECT().AppliedMigrations(mock.Anything).Return([]models.SchemaMigration{{Version: 1}}, nil)
+
+		var out bytes.Buffer
+		err := migrateUp(context.Background(), &out)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "No pending migrations\n", out.String())
+	})
+}
@@ -0,0 +1,229 @@
+package common
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/models"
+)
+
+// EndpointCooldown is how long a failing endpoint is tried only after the healthy ones
+const EndpointCooldown = 30 * time.Second
+
+// endpointScoreWeight is how much the latest request moves the score of an endpoint
+const endpointScoreWeight = 0.2
+
+var ErrNoQuorum = errors.New("endpoints did not reach quorum")
+
+var urlPattern = regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.-]*://[^\s"'<>]+`)
+
+// RedactURLs replaces the urls in a message with their host, so api keys in their path, query or credentials are not exposed
+func RedactURLs(message string) string {
+	return urlPattern.ReplaceAllStringFunc(message, func(match string) string {
+		if u, err := url.Parse(match); err == nil && u.Host != "" {
+			return u.Host
+		}
+		return "<redacted>"
+	})
+}
+
+// redactedError is an endpoint error whose message has its urls redacted, it still matches the errors it wraps
+type redactedError struct {
+	err error
+}
+
+func (e redactedError) Error() string {
+	return RedactURLs(e.err.Error())
+}
+
+func (e redactedError) Unwrap() error {
+	return e.err
+}
+
+// endpointError names the endpoint an error came from, without exposing the urls in the error
+func endpointError(name string, err error) error {
+	return fmt.Errorf("%s: %w", name, redactedError{err})
+}
+
+// Endpoint is one node of an EndpointPool with its health
+type Endpoint[T any] struct {
+	Name   string
+	Client T
+
+	failures    int
+	lastFailure time.Time
+	lastError   error
+	score       float64
+}
+
+// EndpointPool orders the endpoints of a chain by their health, failing endpoints are tried last until they recover
+type EndpointPool[T any] struct {
+	mu        sync.Mutex
+	chain     string
+	endpoints []*Endpoint[T]
+	now       func() time.Time
+}
+
+func NewEndpointPool[T any](chain string, names []string, clients []T) *EndpointPool[T] {
+	endpoints := make([]*Endpoint[T], len(clients))
+	for i := range clients {
+		endpoints[i] = &Endpoint[T]{Name: names[i], Client: clients[i], score: 1}
+	}
+	return &EndpointPool[T]{chain: chain, endpoints: endpoints, now: time.Now}
+}
+
+func (p *EndpointPool[T]) Len() int {
+	return len(p.endpoints)
+}
+
+func (p *EndpointPool[T]) healthy(e *Endpoint[T]) bool {
+	return e.failures == 0 || p.now().Sub(e.lastFailure) >= EndpointCooldown
+}
+
+// Ordered returns the healthy endpoints in their configured order followed by the failing ones, least failures first
+func (p *EndpointPool[T]) Ordered() []*Endpoint[T] {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ordered := slices.Clone(p.endpoints)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		healthyI, healthyJ := p.healthy(ordered[i]), p.healthy(ordered[j])
+		if healthyI != healthyJ {
+			return healthyI
+		}
+		// an endpoint past its cooldown is tried again in its configured place
+		return !healthyI && ordered[i].failures < ordered[j].failures
+	})
+	return ordered
+}
+
+// Report updates the health and score of the endpoint with the result of a request
+func (p *EndpointPool[T]) Report(e *Endpoint[T], err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err == nil {
+		e.score += endpointScoreWeight * (1 - e.score)
+		e.failures = 0
+		e.lastError = nil
+		return
+	}
+	e.score -= endpointScoreWeight * e.score
+	e.failures++
+	e.lastFailure = p.now()
+	e.lastError = redactedError{err}
+}
+
+func (p *EndpointPool[T]) Health() []models.EndpointHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	health := make([]models.EndpointHealth, len(p.endpoints))
+	for i, e := range p.endpoints {
+		health[i] = models.EndpointHealth{Chain: p.chain, Name: e.Name, Healthy: p.healthy(e), Score: e.score, Failures: e.failures}
+		if e.lastError != nil {
+			health[i].LastError = e.lastError.Error()
+		}
+	}
+	return health
+}
+
+// Failover calls the endpoints in order of health until one succeeds
+func Failover[T any, R any](ctx context.Context, p *EndpointPool[T], call func(T) (R, error)) (R, error) {
+	var result R
+	var errs error
+	for _, e := range p.Ordered() {
+		res, err := call(e.Client)
+		p.Report(e, err)
+		if err == nil {
+			return res, nil
+		}
+		errs = errors.Join(errs, endpointError(e.Name, err))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return result, errs
+}
+
+type endpointResult[R any] struct {
+	value R
+	err   error
+}
+
+// callAll calls every endpoint concurrently and returns the successful results
+func callAll[T any, R any](p *EndpointPool[T], call func(T) (R, error)) ([]R, error) {
+	endpoints := p.Ordered()
+	results := make([]endpointResult[R], len(endpoints))
+
+	var wg sync.WaitGroup
+	for i, e := range endpoints {
+		wg.Add(1)
+		go func(i int, e *Endpoint[T]) {
+			defer wg.Done()
+			value, err := call(e.Client)
+			p.Report(e, err)
+			results[i] = endpointResult[R]{value: value, err: err}
+		}(i, e)
+	}
+	wg.Wait()
+
+	var values []R
+	var errs error
+	for i, res := range results {
+		if res.err != nil {
+			errs = errors.Join(errs, endpointError(endpoints[i].Name, res.err))
+			continue
+		}
+		values = append(values, res.value)
+	}
+	return values, errs
+}
+
+// Quorum calls every endpoint and returns a result that at least quorum endpoints agree on, compared by key
+func Quorum[T any, R any](p *EndpointPool[T], quorum int, call func(T) (R, error), key func(R) (string, error)) (R, error) {
+	var result R
+
+	values, errs := callAll(p, call)
+
+	votes := map[string]int{}
+	best, bestVotes := -1, 0
+	for i, value := range values {
+		k, err := key(value)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		votes[k]++
+		if votes[k] > bestVotes {
+			best, bestVotes = i, votes[k]
+		}
+	}
+
+	if bestVotes < quorum {
+		return result, errors.Join(fmt.Errorf("%w: %d of %d endpoints agree, %d required", ErrNoQuorum, bestVotes, p.Len(), quorum), errs)
+	}
+	return values[best], nil
+}
+
+// QuorumReached calls every endpoint and returns the highest value that at least quorum endpoints reached,
+// for values like heights that differ between endpoints that are in sync
+func QuorumReached[T any, R cmp.Ordered](p *EndpointPool[T], quorum int, call func(T) (R, error)) (R, error) {
+	var result R
+
+	values, errs := callAll(p, call)
+	if len(values) < quorum {
+		return result, errors.Join(fmt.Errorf("%w: %d of %d endpoints responded, %d required", ErrNoQuorum, len(values), p.Len(), quorum), errs)
+	}
+
+	slices.SortFunc(values, func(a, b R) int { return cmp.Compare(b, a) })
+	return values[quorum-1], nil
+}
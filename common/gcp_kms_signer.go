@@ -25,8 +25,8 @@ import (
 
 // Interface Definition
 type Signer interface {
-	EthSign(data []byte) ([]byte, error)
-	CosmosSign(data []byte) ([]byte, error)
+	EthSign(ctx context.Context, data []byte) ([]byte, error)
+	CosmosSign(ctx context.Context, data []byte) ([]byte, error)
 	EthAddress() common.Address
 	CosmosPublicKey() types.PubKey
 	Destroy()
@@ -111,22 +111,22 @@ func (s *GcpKmsSigner) Destroy() {
 }
 
 // Method Implementations
-func (s *GcpKmsSigner) EthSign(data []byte) ([]byte, error) {
+func (s *GcpKmsSigner) EthSign(ctx context.Context, data []byte) ([]byte, error) {
 	digest := data
 	if len(digest) != 32 {
 		digest = crypto.Keccak256(data)
 	}
 	hash := common.BytesToHash(digest)
-	return ethSignHash(hash, s.client, s.keyName, s.ethAddress)
+	return ethSignHash(ctx, hash, s.client, s.keyName, s.ethAddress)
 }
 
-func (s *GcpKmsSigner) CosmosSign(data []byte) ([]byte, error) {
+func (s *GcpKmsSigner) CosmosSign(ctx context.Context, data []byte) ([]byte, error) {
 	digest := data
 	if len(digest) != 32 {
 		digest = cryptotypes.Sha256(data)
 	}
 	hash := common.BytesToHash(digest)
-	return cosmosSignHash(s.client, s.keyName, hash, s.secp256k1PubKey)
+	return cosmosSignHash(ctx, s.client, s.keyName, hash, s.secp256k1PubKey)
 }
 
 func (s *GcpKmsSigner) EthAddress() common.Address {
@@ -174,7 +174,7 @@ func getEthAddr(bytes []byte) common.Address {
 	return addr
 }
 
-func ethSignHash(hash common.Hash, client GCPKeyManagementClient, keyName string, ethAddress common.Address) ([]byte, error) {
+func ethSignHash(ctx context.Context, hash common.Hash, client GCPKeyManagementClient, keyName string, ethAddress common.Address) ([]byte, error) {
 	// Resolve a signature
 	req := kmspb.AsymmetricSignRequest{
 		Name: keyName,
@@ -184,7 +184,7 @@ func ethSignHash(hash common.Hash, client GCPKeyManagementClient, keyName string
 			},
 		},
 	}
-	resp, err := client.AsymmetricSign(context.Background(), &req)
+	resp, err := client.AsymmetricSign(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("asymmetric sign operation: %w", err)
 	}
@@ -266,7 +266,7 @@ func ethSignHash(hash common.Hash, client GCPKeyManagementClient, keyName string
 	return finalSig, nil
 }
 
-func cosmosSignHash(client GCPKeyManagementClient, keyName string, hash [32]byte, pubKey *dcrecSecp256k1.PublicKey) ([]byte, error) {
+func cosmosSignHash(ctx context.Context, client GCPKeyManagementClient, keyName string, hash [32]byte, pubKey *dcrecSecp256k1.PublicKey) ([]byte, error) {
 	// Sign the hash using KMS
 	req := &kmspb.AsymmetricSignRequest{
 		Name: keyName,
@@ -277,7 +277,7 @@ func cosmosSignHash(client GCPKeyManagementClient, keyName string, hash [32]byte
 		},
 	}
 
-	resp, err := client.AsymmetricSign(context.Background(), req)
+	resp, err := client.AsymmetricSign(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign: %w", err)
 	}
@@ -109,13 +109,31 @@ func TestGcpKmsSigner_EthSign(t *testing.T) {
 	}
 	mockClient.On("AsymmetricSign", mock.Anything, mock.Anything, mock.Anything).Return(expectedSignature, nil)
 
-	sig, err := signer.EthSign(data)
+	sig, err := signer.EthSign(context.Background(), data)
 	assert.NoError(t, err)
 	assert.NotNil(t, sig)
 
 	mockClient.AssertExpectations(t)
 }
 
+func TestGcpKmsSigner_EthSignPassesContext(t *testing.T) {
+	mockClient := new(MockGCPKeyManagementClient)
+	signer := &GcpKmsSigner{
+		client:     mockClient,
+		keyName:    "test-key",
+		ethAddress: common.HexToAddress("0x14BFf3BDb55E171Dc5af4B0F6F779752bC146C6E"),
+	}
+
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "caller")
+	mockClient.On("AsymmetricSign", ctx, mock.Anything, mock.Anything).Return(&kmspb.AsymmetricSignResponse{}, context.Canceled)
+
+	_, err := signer.EthSign(ctx, []byte("example transaction data"))
+	assert.ErrorIs(t, err, context.Canceled)
+
+	mockClient.AssertExpectations(t)
+}
+
 func TestGcpKmsSigner_CosmosSign(t *testing.T) {
 	mockClient := new(MockGCPKeyManagementClient)
 	keyName := "test-key"
@@ -138,7 +156,7 @@ func TestGcpKmsSigner_CosmosSign(t *testing.T) {
 	}
 	mockClient.On("AsymmetricSign", mock.Anything, mock.Anything, mock.Anything).Return(expectedSignature, nil)
 
-	sig, err := signer.CosmosSign(data)
+	sig, err := signer.CosmosSign(context.Background(), data)
 	assert.NoError(t, err)
 	assert.NotNil(t, sig)
 
@@ -177,12 +195,12 @@ func TestGcpKmsSigner_WithGCPKMS(t *testing.T) {
 	data := []byte("example transaction data")
 
 	// Test EthSign
-	sig, err := signer.EthSign(data)
+	sig, err := signer.EthSign(context.Background(), data)
 	assert.NoError(t, err)
 	assert.NotNil(t, sig)
 
 	// Test CosmosSign
-	sig, err = signer.CosmosSign(data)
+	sig, err = signer.CosmosSign(context.Background(), data)
 	assert.NoError(t, err)
 	assert.NotNil(t, sig)
 
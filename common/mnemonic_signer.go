@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"fmt"
 
@@ -53,7 +54,7 @@ func (s *MnemonicSigner) Destroy() {
 }
 
 // Method Implementations
-func (s *MnemonicSigner) EthSign(data []byte) ([]byte, error) {
+func (s *MnemonicSigner) EthSign(_ context.Context, data []byte) ([]byte, error) {
 	digest := data
 	if len(digest) != 32 {
 		digest = crypto.Keccak256(data)
@@ -71,7 +72,7 @@ func (s *MnemonicSigner) EthSign(data []byte) ([]byte, error) {
 	return signature, nil
 }
 
-func (s *MnemonicSigner) CosmosSign(data []byte) ([]byte, error) {
+func (s *MnemonicSigner) CosmosSign(_ context.Context, data []byte) ([]byte, error) {
 	return s.cosmosPrivKey.Sign(data[:])
 }
 
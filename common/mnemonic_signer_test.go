@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -28,7 +29,7 @@ func TestMnemonicSigner_EthSign(t *testing.T) {
 	assert.NoError(t, err)
 
 	data := []byte("test data")
-	sig, err := signer.EthSign(data)
+	sig, err := signer.EthSign(context.Background(), data)
 	assert.NoError(t, err)
 	assert.NotNil(t, sig)
 
@@ -53,7 +54,7 @@ func TestMnemonicSigner_CosmosSign(t *testing.T) {
 	assert.NoError(t, err)
 
 	data := []byte("test data")
-	sig, err := signer.CosmosSign(data)
+	sig, err := signer.CosmosSign(context.Background(), data)
 	assert.NoError(t, err)
 	assert.NotNil(t, sig)
 
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Struct Definition
+type PrivateKeySigner struct {
+	ethAddress    common.Address
+	cosmosPubKey  types.PubKey
+	ethPrivKey    *ecdsa.PrivateKey
+	cosmosPrivKey types.PrivKey
+}
+
+var _ Signer = &PrivateKeySigner{}
+
+// Constructor Function
+func NewPrivateKeySigner(privateKey string) (*PrivateKeySigner, error) {
+
+	ethPrivKey, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ethereum private key: %w", err)
+	}
+
+	ethAddress := crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+
+	cosmosPrivKey := &secp256k1.PrivKey{Key: crypto.FromECDSA(ethPrivKey)}
+
+	return &PrivateKeySigner{
+		ethPrivKey:    ethPrivKey,
+		ethAddress:    ethAddress,
+		cosmosPrivKey: cosmosPrivKey,
+		cosmosPubKey:  cosmosPrivKey.PubKey(),
+	}, nil
+}
+
+// Destructor Function
+func (s *PrivateKeySigner) Destroy() {
+	// nothing to do
+}
+
+// Method Implementations
+func (s *PrivateKeySigner) EthSign(_ context.Context, data []byte) ([]byte, error) {
+	digest := data
+	if len(digest) != 32 {
+		digest = crypto.Keccak256(data)
+	}
+	hash := common.BytesToHash(digest)
+	signature, err := crypto.Sign(hash[:], s.ethPrivKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if signature[64] == 0 || signature[64] == 1 {
+		signature[64] += 27
+	}
+
+	return signature, nil
+}
+
+func (s *PrivateKeySigner) CosmosSign(_ context.Context, data []byte) ([]byte, error) {
+	return s.cosmosPrivKey.Sign(data[:])
+}
+
+func (s *PrivateKeySigner) EthAddress() common.Address {
+	return s.ethAddress
+}
+
+func (s *PrivateKeySigner) CosmosPublicKey() types.PubKey {
+	return s.cosmosPubKey
+}
@@ -0,0 +1,62 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPrivateKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+func TestNewPrivateKeySigner(t *testing.T) {
+
+	signer, err := NewPrivateKeySigner(testPrivateKey)
+	assert.NoError(t, err)
+	assert.NotNil(t, signer)
+	assert.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", signer.EthAddress().Hex())
+	assert.NotNil(t, signer.CosmosPublicKey())
+
+	prefixed, err := NewPrivateKeySigner("0x" + testPrivateKey)
+	assert.NoError(t, err)
+	assert.Equal(t, signer.EthAddress(), prefixed.EthAddress())
+
+	_, err = NewPrivateKeySigner("invalid")
+	assert.Error(t, err)
+}
+
+func TestPrivateKeySigner_EthSign(t *testing.T) {
+
+	signer, err := NewPrivateKeySigner(testPrivateKey)
+	assert.NoError(t, err)
+
+	data := []byte("test data")
+	sig, err := signer.EthSign(context.Background(), data)
+	assert.NoError(t, err)
+
+	if sig[64] != 27 && sig[64] != 28 {
+		t.Fatalf("invalid Ethereum signature")
+	}
+
+	sig[64] -= 27
+
+	hash := crypto.Keccak256(data)
+	pubKey, err := crypto.SigToPub(hash, sig)
+	assert.NoError(t, err)
+
+	recoveredAddr := crypto.PubkeyToAddress(*pubKey)
+	assert.Equal(t, signer.EthAddress(), recoveredAddr)
+}
+
+func TestPrivateKeySigner_CosmosSign(t *testing.T) {
+
+	signer, err := NewPrivateKeySigner(testPrivateKey)
+	assert.NoError(t, err)
+
+	data := []byte("test data")
+	sig, err := signer.CosmosSign(context.Background(), data)
+	assert.NoError(t, err)
+
+	assert.True(t, signer.CosmosPublicKey().VerifySignature(data, sig))
+}
@@ -0,0 +1,165 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	RemoteSignerPathKeys          = "/keys"
+	RemoteSignerPathEthSign       = "/eth/sign"
+	RemoteSignerPathEthSignTyped  = "/eth/sign-typed-data"
+	RemoteSignerPathCosmosSign    = "/cosmos/sign"
+	RemoteSignerAuthorizationType = "Bearer "
+
+	remoteSignerTimeout = 10 * time.Second
+	remoteSignerBaseURL = "http://remote-signer"
+)
+
+// TypedDataSigner is implemented by signers that hash EIP-712 typed data themselves
+type TypedDataSigner interface {
+	EthSignTypedData(ctx context.Context, typedData apitypes.TypedData) ([]byte, error)
+}
+
+type RemoteSignerKeys struct {
+	EthAddress      string `json:"eth_address"`
+	CosmosPublicKey string `json:"cosmos_public_key"`
+}
+
+type RemoteSignRequest struct {
+	Data      []byte              `json:"data,omitempty"`
+	TypedData *apitypes.TypedData `json:"typed_data,omitempty"`
+}
+
+type RemoteSignResponse struct {
+	Signature []byte `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Struct Definition
+type RemoteSigner struct {
+	client       *http.Client
+	token        string
+	ethAddress   common.Address
+	cosmosPubKey types.PubKey
+}
+
+var _ Signer = &RemoteSigner{}
+var _ TypedDataSigner = &RemoteSigner{}
+
+// Constructor Function
+func NewRemoteSigner(socketPath string, token string) (*RemoteSigner, error) {
+	client := &http.Client{
+		Timeout: remoteSignerTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+
+	s := &RemoteSigner{
+		client: client,
+		token:  token,
+	}
+
+	var keys RemoteSignerKeys
+	if err := s.do(context.Background(), http.MethodGet, RemoteSignerPathKeys, nil, &keys); err != nil {
+		return nil, fmt.Errorf("failed to fetch remote signer keys: %w", err)
+	}
+
+	if !IsValidEthereumAddress(keys.EthAddress) {
+		return nil, fmt.Errorf("remote signer returned invalid ethereum address: %s", keys.EthAddress)
+	}
+
+	cosmosPubKey, err := CosmosPublicKeyFromHex(keys.CosmosPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer returned invalid cosmos public key: %w", err)
+	}
+
+	s.ethAddress = common.HexToAddress(keys.EthAddress)
+	s.cosmosPubKey = cosmosPubKey
+
+	return s, nil
+}
+
+// Destructor Function
+func (s *RemoteSigner) Destroy() {
+	s.client.CloseIdleConnections()
+}
+
+// Method Implementations
+func (s *RemoteSigner) EthSign(ctx context.Context, data []byte) ([]byte, error) {
+	return s.sign(ctx, RemoteSignerPathEthSign, RemoteSignRequest{Data: data})
+}
+
+func (s *RemoteSigner) EthSignTypedData(ctx context.Context, typedData apitypes.TypedData) ([]byte, error) {
+	return s.sign(ctx, RemoteSignerPathEthSignTyped, RemoteSignRequest{TypedData: &typedData})
+}
+
+func (s *RemoteSigner) CosmosSign(ctx context.Context, data []byte) ([]byte, error) {
+	return s.sign(ctx, RemoteSignerPathCosmosSign, RemoteSignRequest{Data: data})
+}
+
+func (s *RemoteSigner) EthAddress() common.Address {
+	return s.ethAddress
+}
+
+func (s *RemoteSigner) CosmosPublicKey() types.PubKey {
+	return s.cosmosPubKey
+}
+
+func (s *RemoteSigner) sign(ctx context.Context, path string, req RemoteSignRequest) ([]byte, error) {
+	var res RemoteSignResponse
+	if err := s.do(ctx, http.MethodPost, path, req, &res); err != nil {
+		return nil, err
+	}
+	if len(res.Signature) == 0 {
+		return nil, fmt.Errorf("remote signer returned an empty signature")
+	}
+	return res.Signature, nil
+}
+
+func (s *RemoteSigner) do(ctx context.Context, method string, path string, body interface{}, result interface{}) error {
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, remoteSignerBaseURL+path, &reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", RemoteSignerAuthorizationType+s.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach remote signer: %w", err)
+	}
+	//nolint:errcheck
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var res RemoteSignResponse
+		_ = json.NewDecoder(resp.Body).Decode(&res)
+		return fmt.Errorf("remote signer returned status %d: %s", resp.StatusCode, res.Error)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/types/tx"
 
+	"github.com/dan13ram/wpokt-validator/app"
 	"github.com/dan13ram/wpokt-validator/common"
 	"github.com/dan13ram/wpokt-validator/cosmos/util"
 	"github.com/dan13ram/wpokt-validator/models"
@@ -32,23 +33,28 @@ import (
 
 const (
 	maxPageDepth = 500
+
+	MAX_QUERY_BLOCKS int64 = 499
 )
 
 type CosmosClient interface {
 	Confirmations() uint64
-	GetLatestBlockHeight() (int64, error)
-	GetChainID() (string, error)
-	GetTxsSentFromAddressAfterHeight(address string, height uint64) ([]*sdk.TxResponse, error)
-	GetTxsSentToAddressAfterHeight(address string, height uint64) ([]*sdk.TxResponse, error)
-	GetAccount(address string) (*auth.BaseAccount, error)
-	Simulate(txBytes []byte) (*sdk.GasInfo, error)
-	BroadcastTx(txBytes []byte) (string, error)
-	GetTx(hash string) (*sdk.TxResponse, error)
-	ValidateNetwork() error
+	GetLatestBlockHeight(ctx context.Context) (int64, error)
+	GetChainID(ctx context.Context) (string, error)
+	GetTxsSentFromAddressAfterHeight(ctx context.Context, address string, height uint64) ([]*sdk.TxResponse, error)
+	GetTxsSentToAddressAfterHeight(ctx context.Context, address string, height uint64) ([]*sdk.TxResponse, error)
+	GetTxsSentToAddressInRange(ctx context.Context, address string, fromHeight uint64, toHeight uint64) ([]*sdk.TxResponse, error)
+	GetTxsSentToAddressInBlock(ctx context.Context, address string, height int64) ([]*sdk.TxResponse, error)
+	GetAccount(ctx context.Context, address string) (*auth.BaseAccount, error)
+	Simulate(ctx context.Context, txBytes []byte) (*sdk.GasInfo, error)
+	BroadcastTx(ctx context.Context, txBytes []byte) (string, error)
+	GetTx(ctx context.Context, hash string) (*sdk.TxResponse, error)
+	ValidateNetwork(ctx context.Context) error
 }
 
 type CosmosHTTPClient interface {
 	Block(ctx context.Context, height *int64) (*rpctypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*rpctypes.ResultBlockResults, error)
 	Status(ctx context.Context) (*rpctypes.ResultStatus, error)
 	Tx(ctx context.Context, hash []byte, prove bool) (*rpctypes.ResultTx, error)
 	TxSearch(ctx context.Context, query string, prove bool, page *int, limit *int, orderBy string) (*rpctypes.ResultTxSearch, error)
@@ -80,10 +86,10 @@ func (c *cosmosClient) Confirmations() uint64 {
 	return c.confirmations
 }
 
-func (c *cosmosClient) getLatestBlockGRPC() (*cmtservice.Block, error) {
+func (c *cosmosClient) getLatestBlockGRPC(ctx context.Context) (*cmtservice.Block, error) {
 	client := cmtserviceNewServiceClient(c.grpcConn)
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	req := &cmtservice.GetLatestBlockRequest{}
@@ -96,8 +102,8 @@ func (c *cosmosClient) getLatestBlockGRPC() (*cmtservice.Block, error) {
 	return resp.SdkBlock, nil
 }
 
-func (c *cosmosClient) getStatusRPC() (*rpctypes.ResultStatus, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+func (c *cosmosClient) getStatusRPC(ctx context.Context) (*rpctypes.ResultStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	res, err := c.rpcClient.Status(ctx)
@@ -107,16 +113,18 @@ func (c *cosmosClient) getStatusRPC() (*rpctypes.ResultStatus, error) {
 	return res, nil
 }
 
-func (c *cosmosClient) GetLatestBlockHeight() (int64, error) {
+func (c *cosmosClient) GetLatestBlockHeight(ctx context.Context) (height int64, err error) {
+	defer app.ObserveRPC(app.ChainPocket, "GetLatestBlockHeight", time.Now(), &err)
+
 	if c.grpcEnabled {
-		block, err := c.getLatestBlockGRPC()
+		block, err := c.getLatestBlockGRPC(ctx)
 		if err != nil {
 			return 0, err
 		}
 		return block.Header.Height, nil
 	}
 
-	status, err := c.getStatusRPC()
+	status, err := c.getStatusRPC(ctx)
 
 	if err != nil {
 		return 0, err
@@ -126,30 +134,77 @@ func (c *cosmosClient) GetLatestBlockHeight() (int64, error) {
 
 }
 
-func (c *cosmosClient) GetTxsSentToAddressAfterHeight(address string, height uint64) ([]*sdk.TxResponse, error) {
+func (c *cosmosClient) GetTxsSentToAddressAfterHeight(ctx context.Context, address string, height uint64) (txs []*sdk.TxResponse, err error) {
+	defer app.ObserveRPC(app.ChainPocket, "GetTxsSentToAddressAfterHeight", time.Now(), &err)
+
 	if !common.IsValidBech32Address(c.bech32Prefix, address) {
 		return nil, fmt.Errorf("invalid bech32 address")
 	}
 
 	query := fmt.Sprintf("transfer.recipient='%s' AND tx.height>=%d", address, height)
 
-	return c.getTxsByEvents(query)
+	return c.getTxsByEvents(ctx, query)
+}
+
+// GetTxsSentToAddressInRange returns the txs sent to the address between both heights, inclusive
+func (c *cosmosClient) GetTxsSentToAddressInRange(ctx context.Context, address string, fromHeight uint64, toHeight uint64) (txs []*sdk.TxResponse, err error) {
+	defer app.ObserveRPC(app.ChainPocket, "GetTxsSentToAddressInRange", time.Now(), &err)
+
+	if !common.IsValidBech32Address(c.bech32Prefix, address) {
+		return nil, fmt.Errorf("invalid bech32 address")
+	}
+	if fromHeight > toHeight {
+		return nil, fmt.Errorf("invalid height range %d-%d", fromHeight, toHeight)
+	}
+
+	query := fmt.Sprintf("transfer.recipient='%s' AND tx.height>=%d AND tx.height<=%d", address, fromHeight, toHeight)
+
+	return c.getTxsByEvents(ctx, query)
+}
+
+// GetTxsSentToAddressInBlock reads the block and its results instead of querying the tx indexer of the node
+func (c *cosmosClient) GetTxsSentToAddressInBlock(ctx context.Context, address string, height int64) (txs []*sdk.TxResponse, err error) {
+	defer app.ObserveRPC(app.ChainPocket, "GetTxsSentToAddressInBlock", time.Now(), &err)
+
+	if !common.IsValidBech32Address(c.bech32Prefix, address) {
+		return nil, fmt.Errorf("invalid bech32 address")
+	}
+	if c.rpcClient == nil {
+		return nil, fmt.Errorf("block scanning requires an rpc client")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resBlock, err := c.rpcClient.Block(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block: %s", err)
+	}
+
+	resResults, err := c.rpcClient.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block results: %s", err)
+	}
+
+	return formatBlockTxResults(c.bech32Prefix, address, resBlock, resResults)
 }
 
-func (c *cosmosClient) GetTxsSentFromAddressAfterHeight(address string, height uint64) ([]*sdk.TxResponse, error) {
+func (c *cosmosClient) GetTxsSentFromAddressAfterHeight(ctx context.Context, address string, height uint64) (txs []*sdk.TxResponse, err error) {
+	defer app.ObserveRPC(app.ChainPocket, "GetTxsSentFromAddressAfterHeight", time.Now(), &err)
+
 	if !common.IsValidBech32Address(c.bech32Prefix, address) {
 		return nil, fmt.Errorf("invalid bech32 address")
 	}
 
 	query := fmt.Sprintf("transfer.sender='%s' AND tx.height>=%d", address, height)
 
-	return c.getTxsByEvents(query)
+	return c.getTxsByEvents(ctx, query)
 }
 
-func (c *cosmosClient) getTxsByEventsPerPageGRPC(query string, page uint64) ([]*sdk.TxResponse, uint64, error) {
+func (c *cosmosClient) getTxsByEventsPerPageGRPC(ctx context.Context, query string, page uint64) ([]*sdk.TxResponse, uint64, error) {
 	client := txNewServiceClient(c.grpcConn)
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	req := &tx.GetTxsEventRequest{
@@ -166,8 +221,8 @@ func (c *cosmosClient) getTxsByEventsPerPageGRPC(query string, page uint64) ([]*
 	return resp.TxResponses, resp.Total, nil
 }
 
-func (c *cosmosClient) getTxsByEventsPerPageRPC(query string, page uint64) ([]*sdk.TxResponse, uint64, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+func (c *cosmosClient) getTxsByEventsPerPageRPC(ctx context.Context, query string, page uint64) ([]*sdk.TxResponse, uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	limit := 100
@@ -178,7 +233,7 @@ func (c *cosmosClient) getTxsByEventsPerPageRPC(query string, page uint64) ([]*s
 		return nil, 0, fmt.Errorf("failed to get txs: %s", err)
 	}
 
-	resBlocks, err := getBlocksForTxResults(c.rpcClient, resTxs.Txs)
+	resBlocks, err := getBlocksForTxResults(ctx, c.rpcClient, resTxs.Txs)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get blocks for txs: %s", err)
 	}
@@ -191,7 +246,7 @@ func (c *cosmosClient) getTxsByEventsPerPageRPC(query string, page uint64) ([]*s
 	return txs, uint64(resTxs.TotalCount), err
 }
 
-func (c *cosmosClient) getTxsByEvents(query string) ([]*sdk.TxResponse, error) {
+func (c *cosmosClient) getTxsByEvents(ctx context.Context, query string) ([]*sdk.TxResponse, error) {
 	var page uint64 = 1
 	var txs = make([]*sdk.TxResponse, 0)
 	for {
@@ -201,9 +256,9 @@ func (c *cosmosClient) getTxsByEvents(query string) ([]*sdk.TxResponse, error) {
 		var total uint64
 
 		if c.grpcEnabled {
-			respTxs, total, err = c.getTxsByEventsPerPageGRPC(query, page)
+			respTxs, total, err = c.getTxsByEventsPerPageGRPC(ctx, query, page)
 		} else {
-			respTxs, total, err = c.getTxsByEventsPerPageRPC(query, page)
+			respTxs, total, err = c.getTxsByEventsPerPageRPC(ctx, query, page)
 		}
 
 		if err != nil {
@@ -221,10 +276,10 @@ func (c *cosmosClient) getTxsByEvents(query string) ([]*sdk.TxResponse, error) {
 	return txs, nil
 }
 
-func (c *cosmosClient) getTxGRPC(hash string) (*sdk.TxResponse, error) {
+func (c *cosmosClient) getTxGRPC(ctx context.Context, hash string) (*sdk.TxResponse, error) {
 	client := txNewServiceClient(c.grpcConn)
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	req := &tx.GetTxRequest{
@@ -239,8 +294,8 @@ func (c *cosmosClient) getTxGRPC(hash string) (*sdk.TxResponse, error) {
 	return resp.TxResponse, nil
 }
 
-func (c *cosmosClient) getTxRPC(hash string) (*sdk.TxResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+func (c *cosmosClient) getTxRPC(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	hashBytes, err := hex.DecodeString(hash)
@@ -253,7 +308,7 @@ func (c *cosmosClient) getTxRPC(hash string) (*sdk.TxResponse, error) {
 		return nil, fmt.Errorf("failed to get tx: %s", err)
 	}
 
-	resBlocks, err := getBlocksForTxResults(c.rpcClient, []*rpctypes.ResultTx{resTx})
+	resBlocks, err := getBlocksForTxResults(ctx, c.rpcClient, []*rpctypes.ResultTx{resTx})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get blocks for tx: %s", err)
 	}
@@ -266,18 +321,20 @@ func (c *cosmosClient) getTxRPC(hash string) (*sdk.TxResponse, error) {
 	return out, nil
 }
 
-func (c *cosmosClient) GetTx(hash string) (*sdk.TxResponse, error) {
+func (c *cosmosClient) GetTx(ctx context.Context, hash string) (tx *sdk.TxResponse, err error) {
+	defer app.ObserveRPC(app.ChainPocket, "GetTx", time.Now(), &err)
+
 	hash = strings.TrimPrefix(hash, "0x")
 	if c.grpcEnabled {
-		return c.getTxGRPC(hash)
+		return c.getTxGRPC(ctx, hash)
 	}
-	return c.getTxRPC(hash)
+	return c.getTxRPC(ctx, hash)
 }
 
-func (c *cosmosClient) getAccountGRPC(address string) (*auth.BaseAccount, error) {
+func (c *cosmosClient) getAccountGRPC(ctx context.Context, address string) (*auth.BaseAccount, error) {
 	client := authNewQueryClient(c.grpcConn)
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	req := auth.QueryAccountRequest{
@@ -297,8 +354,8 @@ func (c *cosmosClient) getAccountGRPC(address string) (*auth.BaseAccount, error)
 	return &account, nil
 }
 
-func (c *cosmosClient) getAccountRPC(address string) (*auth.BaseAccount, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+func (c *cosmosClient) getAccountRPC(ctx context.Context, address string) (*auth.BaseAccount, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	reqBz, _ := util.NewProtoCodec(c.bech32Prefix).Marshal(&auth.QueryAccountRequest{Address: address}) // no reason to fail since account address is validated
@@ -326,20 +383,22 @@ func (c *cosmosClient) getAccountRPC(address string) (*auth.BaseAccount, error)
 	return &baseAccount, nil
 }
 
-func (c *cosmosClient) GetAccount(address string) (*auth.BaseAccount, error) {
+func (c *cosmosClient) GetAccount(ctx context.Context, address string) (account *auth.BaseAccount, err error) {
+	defer app.ObserveRPC(app.ChainPocket, "GetAccount", time.Now(), &err)
+
 	if !common.IsValidBech32Address(c.bech32Prefix, address) {
 		return nil, fmt.Errorf("invalid bech32 address")
 	}
 	if c.grpcEnabled {
-		return c.getAccountGRPC(address)
+		return c.getAccountGRPC(ctx, address)
 	}
-	return c.getAccountRPC(address)
+	return c.getAccountRPC(ctx, address)
 }
 
-func (c *cosmosClient) broadcastTxGRPC(txBytes []byte) (string, error) {
+func (c *cosmosClient) broadcastTxGRPC(ctx context.Context, txBytes []byte) (string, error) {
 	client := txNewServiceClient(c.grpcConn)
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	req := &tx.BroadcastTxRequest{
@@ -359,8 +418,8 @@ func (c *cosmosClient) broadcastTxGRPC(txBytes []byte) (string, error) {
 	return resp.TxResponse.TxHash, nil
 }
 
-func (c *cosmosClient) broadcastTxRPC(txBytes []byte) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+func (c *cosmosClient) broadcastTxRPC(ctx context.Context, txBytes []byte) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	res, err := c.rpcClient.BroadcastTxSync(ctx, txBytes)
@@ -375,17 +434,19 @@ func (c *cosmosClient) broadcastTxRPC(txBytes []byte) (string, error) {
 	return res.Hash.String(), nil
 }
 
-func (c *cosmosClient) BroadcastTx(txBytes []byte) (string, error) {
+func (c *cosmosClient) BroadcastTx(ctx context.Context, txBytes []byte) (hash string, err error) {
+	defer app.ObserveRPC(app.ChainPocket, "BroadcastTx", time.Now(), &err)
+
 	if c.grpcEnabled {
-		return c.broadcastTxGRPC(txBytes)
+		return c.broadcastTxGRPC(ctx, txBytes)
 	}
-	return c.broadcastTxRPC(txBytes)
+	return c.broadcastTxRPC(ctx, txBytes)
 }
 
-func (c *cosmosClient) simulateGRPC(txBytes []byte) (*sdk.GasInfo, error) {
+func (c *cosmosClient) simulateGRPC(ctx context.Context, txBytes []byte) (*sdk.GasInfo, error) {
 	client := txNewServiceClient(c.grpcConn)
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	req := &tx.SimulateRequest{
@@ -400,8 +461,8 @@ func (c *cosmosClient) simulateGRPC(txBytes []byte) (*sdk.GasInfo, error) {
 	return resp.GasInfo, nil
 }
 
-func (c *cosmosClient) simulateRPC(txBytes []byte) (*sdk.GasInfo, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+func (c *cosmosClient) simulateRPC(ctx context.Context, txBytes []byte) (*sdk.GasInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	res, err := c.rpcClient.CheckTx(ctx, txBytes)
@@ -419,23 +480,26 @@ func (c *cosmosClient) simulateRPC(txBytes []byte) (*sdk.GasInfo, error) {
 	}, nil
 }
 
-func (c *cosmosClient) Simulate(txBytes []byte) (*sdk.GasInfo, error) {
+func (c *cosmosClient) Simulate(ctx context.Context, txBytes []byte) (gasInfo *sdk.GasInfo, err error) {
+	defer app.ObserveRPC(app.ChainPocket, "Simulate", time.Now(), &err)
+
 	if c.grpcEnabled {
-		return c.simulateGRPC(txBytes)
+		return c.simulateGRPC(ctx, txBytes)
 	}
-	return c.simulateRPC(txBytes)
+	return c.simulateRPC(ctx, txBytes)
 }
 
-func (c *cosmosClient) GetChainID() (string, error) {
-	var chainID string
+func (c *cosmosClient) GetChainID(ctx context.Context) (chainID string, err error) {
+	defer app.ObserveRPC(app.ChainPocket, "GetChainID", time.Now(), &err)
+
 	if c.grpcEnabled {
-		res, err := c.getLatestBlockGRPC()
+		res, err := c.getLatestBlockGRPC(ctx)
 		if err != nil {
 			return "", fmt.Errorf("failed to get latest block: %s", err)
 		}
 		chainID = res.Header.ChainID
 	} else {
-		status, err := c.getStatusRPC()
+		status, err := c.getStatusRPC(ctx)
 		if err != nil {
 			return "", fmt.Errorf("failed to get status: %s", err)
 		}
@@ -445,9 +509,9 @@ func (c *cosmosClient) GetChainID() (string, error) {
 	return chainID, nil
 }
 
-func (c *cosmosClient) ValidateNetwork() error {
+func (c *cosmosClient) ValidateNetwork(ctx context.Context) error {
 	c.logger.Debugf("[POKT] Validating network")
-	chainID, err := c.GetChainID()
+	chainID, err := c.GetChainID(ctx)
 	if err != nil {
 		return err
 	}
@@ -464,14 +528,37 @@ var rpchttpNew = func(url, endpoint string) (CosmosHTTPClient, error) {
 }
 
 func NewClient(config models.CosmosConfig) (CosmosClient, error) {
-	var connection *grpc.ClientConn
-	var client CosmosHTTPClient
-
 	logger := log.
 		WithField("module", "cosmos").
 		WithField("package", "client").
 		WithField("chain_id", strings.ToLower(config.ChainID))
 
+	endpoints, err := endpointConfigs(config)
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) > 1 {
+		return newMultiClient(config, endpoints, logger)
+	}
+
+	c, err := newCosmosClient(config, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.ValidateNetwork(context.Background())
+	if err != nil {
+		logger.WithError(err).Error("[POKT] failed to validate network")
+		return nil, fmt.Errorf("failed to validate network")
+	}
+
+	return c, nil
+}
+
+func newCosmosClient(config models.CosmosConfig, logger *log.Entry) (*cosmosClient, error) {
+	var connection *grpc.ClientConn
+	var client CosmosHTTPClient
+
 	if config.GRPCEnabled {
 		grpcURL := fmt.Sprintf("%s:%d", config.GRPCHost, config.GRPCPort)
 		conn, err := grpcNewClient(grpcURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -481,6 +568,15 @@ func NewClient(config models.CosmosConfig) (CosmosClient, error) {
 		}
 		connection = conn
 		client = nil
+		if config.ScanMode == models.ScanModeBlocks {
+			// block results are only served over rpc
+			c, err := rpchttpNew(config.RPCURL, "/websocket")
+			if err != nil {
+				logger.WithError(err).Error("[POKT] failed to connect to rpc")
+				return nil, fmt.Errorf("failed to connect to rpc")
+			}
+			client = c
+		}
 	} else {
 		c, err := rpchttpNew(config.RPCURL, "/websocket")
 		if err != nil {
@@ -491,7 +587,7 @@ func NewClient(config models.CosmosConfig) (CosmosClient, error) {
 		connection = nil
 	}
 
-	c := &cosmosClient{
+	return &cosmosClient{
 		grpcEnabled: config.GRPCEnabled,
 		config:      config,
 
@@ -505,13 +601,5 @@ func NewClient(config models.CosmosConfig) (CosmosClient, error) {
 		rpcClient: client,
 
 		logger: logger,
-	}
-
-	err := c.ValidateNetwork()
-	if err != nil {
-		logger.WithError(err).Error("[POKT] failed to validate network")
-		return nil, fmt.Errorf("failed to validate network")
-	}
-
-	return c, nil
+	}, nil
 }
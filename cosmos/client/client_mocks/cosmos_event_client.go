@@ -0,0 +1,366 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCosmosEventClient is an autogenerated mock type for the CosmosEventClient type
+type MockCosmosEventClient struct {
+	mock.Mock
+}
+
+type MockCosmosEventClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCosmosEventClient) EXPECT() *MockCosmosEventClient_Expecter {
+	return &MockCosmosEventClient_Expecter{mock: &_m.Mock}
+}
+
+// Block provides a mock function with given fields: ctx, height
+func (_m *MockCosmosEventClient) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	ret := _m.Called(ctx, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 *coretypes.ResultBlock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) (*coretypes.ResultBlock, error)); ok {
+		return rf(ctx, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultBlock); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultBlock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCosmosEventClient_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type MockCosmosEventClient_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - ctx context.Context
+//   - height *int64
+func (_e *MockCosmosEventClient_Expecter) Block(ctx interface{}, height interface{}) *MockCosmosEventClient_Block_Call {
+	return &MockCosmosEventClient_Block_Call{Call: _e.mock.On("Block", ctx, height)}
+}
+
+func (_c *MockCosmosEventClient_Block_Call) Run(run func(ctx context.Context, height *int64)) *MockCosmosEventClient_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*int64))
+	})
+	return _c
+}
+
+func (_c *MockCosmosEventClient_Block_Call) Return(_a0 *coretypes.ResultBlock, _a1 error) *MockCosmosEventClient_Block_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCosmosEventClient_Block_Call) RunAndReturn(run func(context.Context, *int64) (*coretypes.ResultBlock, error)) *MockCosmosEventClient_Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *MockCosmosEventClient) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCosmosEventClient_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockCosmosEventClient_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *MockCosmosEventClient_Expecter) Start() *MockCosmosEventClient_Start_Call {
+	return &MockCosmosEventClient_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *MockCosmosEventClient_Start_Call) Run(run func()) *MockCosmosEventClient_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCosmosEventClient_Start_Call) Return(_a0 error) *MockCosmosEventClient_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCosmosEventClient_Start_Call) RunAndReturn(run func() error) *MockCosmosEventClient_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Status provides a mock function with given fields: ctx
+func (_m *MockCosmosEventClient) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 *coretypes.ResultStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*coretypes.ResultStatus, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *coretypes.ResultStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCosmosEventClient_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type MockCosmosEventClient_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCosmosEventClient_Expecter) Status(ctx interface{}) *MockCosmosEventClient_Status_Call {
+	return &MockCosmosEventClient_Status_Call{Call: _e.mock.On("Status", ctx)}
+}
+
+func (_c *MockCosmosEventClient_Status_Call) Run(run func(ctx context.Context)) *MockCosmosEventClient_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockCosmosEventClient_Status_Call) Return(_a0 *coretypes.ResultStatus, _a1 error) *MockCosmosEventClient_Status_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCosmosEventClient_Status_Call) RunAndReturn(run func(context.Context) (*coretypes.ResultStatus, error)) *MockCosmosEventClient_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *MockCosmosEventClient) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCosmosEventClient_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MockCosmosEventClient_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *MockCosmosEventClient_Expecter) Stop() *MockCosmosEventClient_Stop_Call {
+	return &MockCosmosEventClient_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *MockCosmosEventClient_Stop_Call) Run(run func()) *MockCosmosEventClient_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCosmosEventClient_Stop_Call) Return(_a0 error) *MockCosmosEventClient_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCosmosEventClient_Stop_Call) RunAndReturn(run func() error) *MockCosmosEventClient_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: ctx, subscriber, query, outCapacity
+func (_m *MockCosmosEventClient) Subscribe(ctx context.Context, subscriber string, query string, outCapacity ...int) (<-chan coretypes.ResultEvent, error) {
+	_va := make([]interface{}, len(outCapacity))
+	for _i := range outCapacity {
+		_va[_i] = outCapacity[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, subscriber, query)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan coretypes.ResultEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...int) (<-chan coretypes.ResultEvent, error)); ok {
+		return rf(ctx, subscriber, query, outCapacity...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...int) <-chan coretypes.ResultEvent); ok {
+		r0 = rf(ctx, subscriber, query, outCapacity...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan coretypes.ResultEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, ...int) error); ok {
+		r1 = rf(ctx, subscriber, query, outCapacity...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCosmosEventClient_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockCosmosEventClient_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriber string
+//   - query string
+//   - outCapacity ...int
+func (_e *MockCosmosEventClient_Expecter) Subscribe(ctx interface{}, subscriber interface{}, query interface{}, outCapacity ...interface{}) *MockCosmosEventClient_Subscribe_Call {
+	return &MockCosmosEventClient_Subscribe_Call{Call: _e.mock.On("Subscribe",
+		append([]interface{}{ctx, subscriber, query}, outCapacity...)...)}
+}
+
+func (_c *MockCosmosEventClient_Subscribe_Call) Run(run func(ctx context.Context, subscriber string, query string, outCapacity ...int)) *MockCosmosEventClient_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]int, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(int)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCosmosEventClient_Subscribe_Call) Return(_a0 <-chan coretypes.ResultEvent, _a1 error) *MockCosmosEventClient_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCosmosEventClient_Subscribe_Call) RunAndReturn(run func(context.Context, string, string, ...int) (<-chan coretypes.ResultEvent, error)) *MockCosmosEventClient_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsubscribeAll provides a mock function with given fields: ctx, subscriber
+func (_m *MockCosmosEventClient) UnsubscribeAll(ctx context.Context, subscriber string) error {
+	ret := _m.Called(ctx, subscriber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribeAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, subscriber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCosmosEventClient_UnsubscribeAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeAll'
+type MockCosmosEventClient_UnsubscribeAll_Call struct {
+	*mock.Call
+}
+
+// UnsubscribeAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriber string
+func (_e *MockCosmosEventClient_Expecter) UnsubscribeAll(ctx interface{}, subscriber interface{}) *MockCosmosEventClient_UnsubscribeAll_Call {
+	return &MockCosmosEventClient_UnsubscribeAll_Call{Call: _e.mock.On("UnsubscribeAll", ctx, subscriber)}
+}
+
+func (_c *MockCosmosEventClient_UnsubscribeAll_Call) Run(run func(ctx context.Context, subscriber string)) *MockCosmosEventClient_UnsubscribeAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCosmosEventClient_UnsubscribeAll_Call) Return(_a0 error) *MockCosmosEventClient_UnsubscribeAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCosmosEventClient_UnsubscribeAll_Call) RunAndReturn(run func(context.Context, string) error) *MockCosmosEventClient_UnsubscribeAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCosmosEventClient creates a new instance of MockCosmosEventClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCosmosEventClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCosmosEventClient {
+	mock := &MockCosmosEventClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
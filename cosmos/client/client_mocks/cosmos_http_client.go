@@ -146,6 +146,65 @@ func (_c *MockCosmosHTTPClient_Block_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// BlockResults provides a mock function with given fields: ctx, height
+func (_m *MockCosmosHTTPClient) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	ret := _m.Called(ctx, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockResults")
+	}
+
+	var r0 *coretypes.ResultBlockResults
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) (*coretypes.ResultBlockResults, error)); ok {
+		return rf(ctx, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultBlockResults); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultBlockResults)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCosmosHTTPClient_BlockResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockResults'
+type MockCosmosHTTPClient_BlockResults_Call struct {
+	*mock.Call
+}
+
+// BlockResults is a helper method to define mock.On call
+//   - ctx context.Context
+//   - height *int64
+func (_e *MockCosmosHTTPClient_Expecter) BlockResults(ctx interface{}, height interface{}) *MockCosmosHTTPClient_BlockResults_Call {
+	return &MockCosmosHTTPClient_BlockResults_Call{Call: _e.mock.On("BlockResults", ctx, height)}
+}
+
+func (_c *MockCosmosHTTPClient_BlockResults_Call) Run(run func(ctx context.Context, height *int64)) *MockCosmosHTTPClient_BlockResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*int64))
+	})
+	return _c
+}
+
+func (_c *MockCosmosHTTPClient_BlockResults_Call) Return(_a0 *coretypes.ResultBlockResults, _a1 error) *MockCosmosHTTPClient_BlockResults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCosmosHTTPClient_BlockResults_Call) RunAndReturn(run func(context.Context, *int64) (*coretypes.ResultBlockResults, error)) *MockCosmosHTTPClient_BlockResults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BroadcastTxSync provides a mock function with given fields: ctx, tx
 func (_m *MockCosmosHTTPClient) BroadcastTxSync(ctx context.Context, tx types.Tx) (*coretypes.ResultBroadcastTx, error) {
 	ret := _m.Called(ctx, tx)
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -66,7 +67,7 @@ func TestGetLatestBlockHeight_GRPC(t *testing.T) {
 	block := &cmtservice.Block{Header: cmtservice.Header{Height: 100}}
 	mockGRPCClient.On("GetLatestBlock", mock.Anything, mock.Anything).Return(&cmtservice.GetLatestBlockResponse{SdkBlock: block}, nil)
 
-	height, err := client.GetLatestBlockHeight()
+	height, err := client.GetLatestBlockHeight(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, int64(100), height)
 
@@ -103,7 +104,7 @@ func TestGetLatestBlockHeight_GRPC_Error(t *testing.T) {
 	block := &cmtservice.Block{Header: cmtservice.Header{Height: 100}}
 	mockGRPCClient.On("GetLatestBlock", mock.Anything, mock.Anything).Return(&cmtservice.GetLatestBlockResponse{SdkBlock: block}, errors.New("error"))
 
-	height, err := client.GetLatestBlockHeight()
+	height, err := client.GetLatestBlockHeight(context.Background())
 	assert.Error(t, err)
 	assert.Equal(t, int64(0), height)
 
@@ -131,7 +132,7 @@ func TestGetLatestBlockHeight_RPC(t *testing.T) {
 	status := &rpctypes.ResultStatus{SyncInfo: rpctypes.SyncInfo{LatestBlockHeight: 100}}
 	mockHTTPClient.On("Status", mock.Anything).Return(status, nil)
 
-	height, err := client.GetLatestBlockHeight()
+	height, err := client.GetLatestBlockHeight(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, int64(100), height)
 
@@ -158,7 +159,7 @@ func TestGetLatestBlockHeight_RPC_Error(t *testing.T) {
 
 	mockHTTPClient.On("Status", mock.Anything).Return(nil, errors.New("error"))
 
-	height, err := client.GetLatestBlockHeight()
+	height, err := client.GetLatestBlockHeight(context.Background())
 	assert.Error(t, err)
 	assert.Equal(t, int64(0), height)
 
@@ -187,7 +188,7 @@ func TestGetTxsSentToAddressAfterHeight_AddressError(t *testing.T) {
 
 	recipientBech32 := "cosmos1test"
 
-	txs, err := client.GetTxsSentToAddressAfterHeight(recipientBech32, 100)
+	txs, err := client.GetTxsSentToAddressAfterHeight(context.Background(), recipientBech32, 100)
 	assert.Error(t, err)
 	assert.Nil(t, txs)
 
@@ -232,7 +233,7 @@ func TestGetTxsSentToAddressAfterHeight_GRPC(t *testing.T) {
 	}
 	mockGRPCClient.On("GetTxsEvent", mock.Anything, req).Return(&tx.GetTxsEventResponse{Txs: []*tx.Tx{}}, nil)
 
-	txs, err := client.GetTxsSentToAddressAfterHeight(recipientBech32, 100)
+	txs, err := client.GetTxsSentToAddressAfterHeight(context.Background(), recipientBech32, 100)
 	assert.NoError(t, err)
 	assert.NotNil(t, txs)
 }
@@ -291,7 +292,7 @@ func TestGetTxsSentToAddressAfterHeight_GRPC_MultiPages(t *testing.T) {
 	mockGRPCClient.On("GetTxsEvent", mock.Anything, req1).Return(&tx.GetTxsEventResponse{TxResponses: resTxs1, Total: 4}, nil).Once()
 	mockGRPCClient.On("GetTxsEvent", mock.Anything, req2).Return(&tx.GetTxsEventResponse{TxResponses: resTxs2, Total: 4}, nil).Once()
 
-	txs, err := client.GetTxsSentToAddressAfterHeight(recipientBech32, 100)
+	txs, err := client.GetTxsSentToAddressAfterHeight(context.Background(), recipientBech32, 100)
 	assert.NoError(t, err)
 	assert.NotNil(t, txs)
 	assert.Len(t, txs, 4)
@@ -339,7 +340,7 @@ func TestGetTxsSentToAddressAfterHeight_GRPC_Error(t *testing.T) {
 	}
 	mockGRPCClient.On("GetTxsEvent", mock.Anything, req).Return(nil, errors.New("error"))
 
-	txs, err := client.GetTxsSentToAddressAfterHeight(recipientBech32, 100)
+	txs, err := client.GetTxsSentToAddressAfterHeight(context.Background(), recipientBech32, 100)
 	assert.Error(t, err)
 	assert.Nil(t, txs)
 }
@@ -370,13 +371,142 @@ func TestGetTxsSentToAddressAfterHeight(t *testing.T) {
 	query := fmt.Sprintf("transfer.recipient='%s' AND tx.height>=100", recipientBech32)
 	mockHTTPClient.On("TxSearch", mock.Anything, query, false, mock.Anything, mock.Anything, "asc").Return(&rpctypes.ResultTxSearch{Txs: []*rpctypes.ResultTx{}}, nil)
 
-	txs, err := client.GetTxsSentToAddressAfterHeight(recipientBech32, 100)
+	txs, err := client.GetTxsSentToAddressAfterHeight(context.Background(), recipientBech32, 100)
 	assert.NoError(t, err)
 	assert.NotNil(t, txs)
 
 	mockHTTPClient.AssertExpectations(t)
 }
 
+func TestGetTxsSentToAddressInRange(t *testing.T) {
+	mockHTTPClient := mocks.NewMockCosmosHTTPClient(t)
+
+	config := models.CosmosConfig{
+		GRPCEnabled:      false,
+		RPCTimeoutMillis: 5000,
+		Bech32Prefix:     "cosmos",
+
+		ChainID: "TestChainID",
+	}
+
+	client := &cosmosClient{
+		grpcEnabled:   config.GRPCEnabled,
+		confirmations: uint64(config.Confirmations),
+		timeout:       time.Duration(config.RPCTimeoutMillis) * time.Millisecond,
+		bech32Prefix:  config.Bech32Prefix,
+		rpcClient:     mockHTTPClient,
+		logger:        log.NewEntry(log.New()),
+	}
+
+	recipientAddress := ethcommon.BytesToAddress([]byte("cosmos1test"))
+	recipientBech32, _ := common.Bech32FromBytes(config.Bech32Prefix, recipientAddress.Bytes())
+
+	query := fmt.Sprintf("transfer.recipient='%s' AND tx.height>=100 AND tx.height<=200", recipientBech32)
+	mockHTTPClient.On("TxSearch", mock.Anything, query, false, mock.Anything, mock.Anything, "asc").Return(&rpctypes.ResultTxSearch{Txs: []*rpctypes.ResultTx{}}, nil)
+
+	txs, err := client.GetTxsSentToAddressInRange(context.Background(), recipientBech32, 100, 200)
+	assert.NoError(t, err)
+	assert.NotNil(t, txs)
+
+	txs, err = client.GetTxsSentToAddressInRange(context.Background(), recipientBech32, 200, 100)
+	assert.ErrorContains(t, err, "invalid height range 200-100")
+	assert.Nil(t, txs)
+
+	txs, err = client.GetTxsSentToAddressInRange(context.Background(), "cosmos1test", 100, 200)
+	assert.ErrorContains(t, err, "invalid bech32 address")
+	assert.Nil(t, txs)
+
+	mockHTTPClient.AssertExpectations(t)
+}
+
+func TestGetTxsSentToAddressInBlock(t *testing.T) {
+	mockHTTPClient := mocks.NewMockCosmosHTTPClient(t)
+
+	config := models.CosmosConfig{
+		GRPCEnabled:      false,
+		RPCTimeoutMillis: 5000,
+		Bech32Prefix:     "cosmos",
+
+		ChainID: "TestChainID",
+	}
+
+	client := &cosmosClient{
+		grpcEnabled:   config.GRPCEnabled,
+		confirmations: uint64(config.Confirmations),
+		timeout:       time.Duration(config.RPCTimeoutMillis) * time.Millisecond,
+		bech32Prefix:  config.Bech32Prefix,
+		rpcClient:     mockHTTPClient,
+		logger:        log.NewEntry(log.New()),
+	}
+
+	recipientAddress := ethcommon.BytesToAddress([]byte("cosmos1test"))
+	recipientBech32, _ := common.Bech32FromBytes(config.Bech32Prefix, recipientAddress.Bytes())
+
+	height := int64(100)
+	mockHTTPClient.EXPECT().Block(mock.Anything, &height).Return(&rpctypes.ResultBlock{Block: &ctypes.Block{Header: ctypes.Header{Height: height, Time: time.Now()}}}, nil)
+	mockHTTPClient.EXPECT().BlockResults(mock.Anything, &height).Return(&rpctypes.ResultBlockResults{Height: height}, nil)
+
+	txs, err := client.GetTxsSentToAddressInBlock(context.Background(), recipientBech32, height)
+	assert.NoError(t, err)
+	assert.NotNil(t, txs)
+	assert.Empty(t, txs)
+}
+
+func TestGetTxsSentToAddressInBlock_Errors(t *testing.T) {
+	config := models.CosmosConfig{
+		GRPCEnabled:      false,
+		RPCTimeoutMillis: 5000,
+		Bech32Prefix:     "cosmos",
+
+		ChainID: "TestChainID",
+	}
+
+	recipientAddress := ethcommon.BytesToAddress([]byte("cosmos1test"))
+	recipientBech32, _ := common.Bech32FromBytes(config.Bech32Prefix, recipientAddress.Bytes())
+	height := int64(100)
+
+	newClient := func(rpcClient CosmosHTTPClient) *cosmosClient {
+		return &cosmosClient{
+			grpcEnabled:  config.GRPCEnabled,
+			timeout:      time.Duration(config.RPCTimeoutMillis) * time.Millisecond,
+			bech32Prefix: config.Bech32Prefix,
+			rpcClient:    rpcClient,
+			logger:       log.NewEntry(log.New()),
+		}
+	}
+
+	t.Run("Invalid address", func(t *testing.T) {
+		txs, err := newClient(mocks.NewMockCosmosHTTPClient(t)).GetTxsSentToAddressInBlock(context.Background(), "cosmos1test", height)
+		assert.Error(t, err)
+		assert.Nil(t, txs)
+	})
+
+	t.Run("No rpc client", func(t *testing.T) {
+		txs, err := newClient(nil).GetTxsSentToAddressInBlock(context.Background(), recipientBech32, height)
+		assert.ErrorContains(t, err, "block scanning requires an rpc client")
+		assert.Nil(t, txs)
+	})
+
+	t.Run("Block error", func(t *testing.T) {
+		mockHTTPClient := mocks.NewMockCosmosHTTPClient(t)
+		mockHTTPClient.EXPECT().Block(mock.Anything, &height).Return(nil, errors.New("error"))
+
+		txs, err := newClient(mockHTTPClient).GetTxsSentToAddressInBlock(context.Background(), recipientBech32, height)
+		assert.ErrorContains(t, err, "failed to get block")
+		assert.Nil(t, txs)
+	})
+
+	t.Run("Block results error", func(t *testing.T) {
+		mockHTTPClient := mocks.NewMockCosmosHTTPClient(t)
+		mockHTTPClient.EXPECT().Block(mock.Anything, &height).Return(&rpctypes.ResultBlock{Block: &ctypes.Block{}}, nil)
+		mockHTTPClient.EXPECT().BlockResults(mock.Anything, &height).Return(nil, errors.New("error"))
+
+		txs, err := newClient(mockHTTPClient).GetTxsSentToAddressInBlock(context.Background(), recipientBech32, height)
+		assert.ErrorContains(t, err, "failed to get block results")
+		assert.Nil(t, txs)
+	})
+}
+
 func TestGetTxsSentFromAddressAfterHeight_AddressError(t *testing.T) {
 	mockHTTPClient := mocks.NewMockCosmosHTTPClient(t)
 
@@ -399,7 +529,7 @@ func TestGetTxsSentFromAddressAfterHeight_AddressError(t *testing.T) {
 
 	senderBech32 := "cosmos1test"
 
-	txs, err := client.GetTxsSentFromAddressAfterHeight(senderBech32, 100)
+	txs, err := client.GetTxsSentFromAddressAfterHeight(context.Background(), senderBech32, 100)
 	assert.Error(t, err)
 	assert.Nil(t, txs)
 
@@ -432,7 +562,7 @@ func TestGetTxsSentFromAddressAfterHeight(t *testing.T) {
 	query := fmt.Sprintf("transfer.sender='%s' AND tx.height>=100", senderBech32)
 	mockHTTPClient.On("TxSearch", mock.Anything, query, false, mock.Anything, mock.Anything, "asc").Return(&rpctypes.ResultTxSearch{Txs: []*rpctypes.ResultTx{}}, nil)
 
-	txs, err := client.GetTxsSentFromAddressAfterHeight(senderBech32, 100)
+	txs, err := client.GetTxsSentFromAddressAfterHeight(context.Background(), senderBech32, 100)
 	assert.NoError(t, err)
 	assert.NotNil(t, txs)
 
@@ -472,7 +602,7 @@ func TestGetTxsSentFromAddressAfterHeight_GetBlocksError(t *testing.T) {
 	mockHTTPClient.On("TxSearch", mock.Anything, query, false, mock.Anything, mock.Anything, "asc").Return(&rpctypes.ResultTxSearch{Txs: resTxs}, nil)
 	mockHTTPClient.EXPECT().Block(mock.Anything, &resTxs[0].Height).Return(nil, errors.New("error")).Once()
 
-	txs, err := client.GetTxsSentFromAddressAfterHeight(senderBech32, 100)
+	txs, err := client.GetTxsSentFromAddressAfterHeight(context.Background(), senderBech32, 100)
 	assert.Error(t, err)
 	assert.Nil(t, txs)
 
@@ -528,7 +658,7 @@ func TestGetTxsSentFromAddressAfterHeight_FormatError(t *testing.T) {
 		utilNewTxDecoder = util.NewTxDecoder
 	}()
 
-	txs, err := client.GetTxsSentFromAddressAfterHeight(senderBech32, 100)
+	txs, err := client.GetTxsSentFromAddressAfterHeight(context.Background(), senderBech32, 100)
 	assert.Error(t, err)
 	assert.Nil(t, txs)
 
@@ -561,7 +691,7 @@ func TestGetTxsSentFromAddressAfterHeight_SearchError(t *testing.T) {
 	query := fmt.Sprintf("transfer.sender='%s' AND tx.height>=100", senderBech32)
 	mockHTTPClient.On("TxSearch", mock.Anything, query, false, mock.Anything, mock.Anything, "asc").Return(nil, errors.New("error"))
 
-	txs, err := client.GetTxsSentFromAddressAfterHeight(senderBech32, 100)
+	txs, err := client.GetTxsSentFromAddressAfterHeight(context.Background(), senderBech32, 100)
 	assert.Error(t, err)
 	assert.Nil(t, txs)
 
@@ -595,7 +725,7 @@ func TestGetAccount_AddressError(t *testing.T) {
 
 	accountBech32 := "cosmos1account"
 
-	result, err := client.GetAccount(accountBech32)
+	result, err := client.GetAccount(context.Background(), accountBech32)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Equal(t, "invalid bech32 address", err.Error())
@@ -636,7 +766,7 @@ func TestGetAccount_GRPC(t *testing.T) {
 
 	mockGRPCClient.On("Account", mock.Anything, &auth.QueryAccountRequest{Address: accountBech32}).Return(&auth.QueryAccountResponse{Account: &codectypes.Any{Value: accountBytes}}, nil)
 
-	result, err := client.GetAccount(accountBech32)
+	result, err := client.GetAccount(context.Background(), accountBech32)
 	assert.NoError(t, err)
 	assert.Equal(t, account, result)
 
@@ -673,7 +803,7 @@ func TestGetAccount_GRPC_ClientError(t *testing.T) {
 
 	mockGRPCClient.On("Account", mock.Anything, &auth.QueryAccountRequest{Address: accountBech32}).Return(nil, errors.New("error"))
 
-	result, err := client.GetAccount(accountBech32)
+	result, err := client.GetAccount(context.Background(), accountBech32)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 
@@ -710,7 +840,7 @@ func TestGetAccount_GRPC_UnmarshalError(t *testing.T) {
 
 	mockGRPCClient.On("Account", mock.Anything, &auth.QueryAccountRequest{Address: accountBech32}).Return(&auth.QueryAccountResponse{Account: &codectypes.Any{Value: []byte("invalid")}}, nil)
 
-	result, err := client.GetAccount(accountBech32)
+	result, err := client.GetAccount(context.Background(), accountBech32)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 
@@ -754,7 +884,7 @@ func TestGetAccount_RPC(t *testing.T) {
 
 	mockHTTPClient.On("ABCIQuery", mock.Anything, queryPath, queryDataHex).Return(&rpctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: responseBytes}}, nil)
 
-	result, err := client.GetAccount(accountBech32)
+	result, err := client.GetAccount(context.Background(), accountBech32)
 	assert.NoError(t, err)
 	assert.Equal(t, accountBech32, result.Address)
 
@@ -798,7 +928,7 @@ func TestGetAccount_RPC_RequestFailed(t *testing.T) {
 
 	mockHTTPClient.On("ABCIQuery", mock.Anything, queryPath, queryDataHex).Return(&rpctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: responseBytes, Code: 1}}, nil)
 
-	result, err := client.GetAccount(accountBech32)
+	result, err := client.GetAccount(context.Background(), accountBech32)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 
@@ -835,7 +965,7 @@ func TestGetAccount_RPC_ClientError(t *testing.T) {
 
 	mockHTTPClient.On("ABCIQuery", mock.Anything, queryPath, queryDataHex).Return(nil, errors.New("error"))
 
-	result, err := client.GetAccount(accountBech32)
+	result, err := client.GetAccount(context.Background(), accountBech32)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 
@@ -872,7 +1002,7 @@ func TestGetAccount_RPC_ResponseUnmarshalError(t *testing.T) {
 
 	mockHTTPClient.On("ABCIQuery", mock.Anything, queryPath, queryDataHex).Return(&rpctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: []byte("invalid")}}, nil)
 
-	result, err := client.GetAccount(accountBech32)
+	result, err := client.GetAccount(context.Background(), accountBech32)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 
@@ -913,7 +1043,7 @@ func TestGetAccount_RPC_AccountUnmarshalError(t *testing.T) {
 
 	mockHTTPClient.On("ABCIQuery", mock.Anything, queryPath, queryDataHex).Return(&rpctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: responseBytes}}, nil)
 
-	result, err := client.GetAccount(accountBech32)
+	result, err := client.GetAccount(context.Background(), accountBech32)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 
@@ -946,7 +1076,7 @@ func TestBroadcastTx_GRPC(t *testing.T) {
 	txBytes := []byte("txBytes")
 	mockGRPCClient.On("BroadcastTx", mock.Anything, &tx.BroadcastTxRequest{TxBytes: txBytes, Mode: tx.BroadcastMode_BROADCAST_MODE_SYNC}).Return(&tx.BroadcastTxResponse{TxResponse: &sdk.TxResponse{TxHash: "txHash", Code: 0}}, nil)
 
-	txHash, err := client.BroadcastTx(txBytes)
+	txHash, err := client.BroadcastTx(context.Background(), txBytes)
 	assert.NoError(t, err)
 	assert.Equal(t, "txHash", txHash)
 
@@ -979,7 +1109,7 @@ func TestBroadcastTx_GRPC_ClientError(t *testing.T) {
 	txBytes := []byte("txBytes")
 	mockGRPCClient.On("BroadcastTx", mock.Anything, &tx.BroadcastTxRequest{TxBytes: txBytes, Mode: tx.BroadcastMode_BROADCAST_MODE_SYNC}).Return(nil, errors.New("error"))
 
-	txHash, err := client.BroadcastTx(txBytes)
+	txHash, err := client.BroadcastTx(context.Background(), txBytes)
 	assert.Error(t, err)
 	assert.Empty(t, txHash)
 
@@ -1012,7 +1142,7 @@ func TestBroadcastTx_GRPC_TxFailed(t *testing.T) {
 	txBytes := []byte("txBytes")
 	mockGRPCClient.On("BroadcastTx", mock.Anything, &tx.BroadcastTxRequest{TxBytes: txBytes, Mode: tx.BroadcastMode_BROADCAST_MODE_SYNC}).Return(&tx.BroadcastTxResponse{TxResponse: &sdk.TxResponse{TxHash: "txHash", Code: 1}}, nil)
 
-	txHash, err := client.BroadcastTx(txBytes)
+	txHash, err := client.BroadcastTx(context.Background(), txBytes)
 	assert.Error(t, err)
 	assert.Empty(t, txHash)
 
@@ -1040,7 +1170,7 @@ func TestBroadcastTx_RPC(t *testing.T) {
 	var txBytes ctypes.Tx = []byte("txBytes")
 	mockHTTPClient.On("BroadcastTxSync", mock.Anything, txBytes).Return(&rpctypes.ResultBroadcastTx{Hash: []byte("txHash"), Code: 0}, nil)
 
-	txHash, err := client.BroadcastTx(txBytes)
+	txHash, err := client.BroadcastTx(context.Background(), txBytes)
 	assert.NoError(t, err)
 	assert.Equal(t, hex.EncodeToString([]byte("txHash")), txHash)
 
@@ -1068,7 +1198,7 @@ func TestBroadcastTx_RPC_ClientError(t *testing.T) {
 	var txBytes ctypes.Tx = []byte("txBytes")
 	mockHTTPClient.On("BroadcastTxSync", mock.Anything, txBytes).Return(nil, errors.New("error"))
 
-	txHash, err := client.BroadcastTx(txBytes)
+	txHash, err := client.BroadcastTx(context.Background(), txBytes)
 	assert.Error(t, err)
 	assert.Empty(t, txHash)
 
@@ -1096,7 +1226,7 @@ func TestBroadcastTx_RPC_TxFailed(t *testing.T) {
 	var txBytes ctypes.Tx = []byte("txBytes")
 	mockHTTPClient.On("BroadcastTxSync", mock.Anything, txBytes).Return(&rpctypes.ResultBroadcastTx{Hash: []byte("txHash"), Code: 1}, nil)
 
-	txHash, err := client.BroadcastTx(txBytes)
+	txHash, err := client.BroadcastTx(context.Background(), txBytes)
 	assert.Error(t, err)
 	assert.Empty(t, txHash)
 
@@ -1130,7 +1260,7 @@ func TestGetTx_GRPC(t *testing.T) {
 	txResponse := &sdk.TxResponse{TxHash: txHash, Code: 0}
 	mockGRPCClient.On("GetTx", mock.Anything, &tx.GetTxRequest{Hash: txHash}).Return(&tx.GetTxResponse{TxResponse: txResponse}, nil)
 
-	result, err := client.GetTx(txHash)
+	result, err := client.GetTx(context.Background(), txHash)
 	assert.NoError(t, err)
 	assert.Equal(t, txResponse, result)
 
@@ -1163,7 +1293,7 @@ func TestGetTx_GRPC_Error(t *testing.T) {
 	txHash := "txHash"
 	mockGRPCClient.On("GetTx", mock.Anything, &tx.GetTxRequest{Hash: txHash}).Return(nil, errors.New("error"))
 
-	result, err := client.GetTx(txHash)
+	result, err := client.GetTx(context.Background(), txHash)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 
@@ -1196,7 +1326,7 @@ func TestGetTx_RPC(t *testing.T) {
 	mockHTTPClient.On("Tx", mock.Anything, hashBytes, true).Return(txResponse, nil)
 	mockHTTPClient.On("Block", mock.Anything, &txResponse.Height).Return(&rpctypes.ResultBlock{Block: &ctypes.Block{Header: ctypes.Header{Time: time.Now()}}}, nil)
 
-	result, err := client.GetTx(txHash)
+	result, err := client.GetTx(context.Background(), txHash)
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 
@@ -1222,7 +1352,7 @@ func TestGetTx_RPC_DecodeError(t *testing.T) {
 	}
 
 	txHash := "hash"
-	result, err := client.GetTx(txHash)
+	result, err := client.GetTx(context.Background(), txHash)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "failed to decode hash")
@@ -1254,7 +1384,7 @@ func TestGetTx_RPC_ClientError(t *testing.T) {
 
 	mockHTTPClient.On("Tx", mock.Anything, hashBytes, true).Return(nil, errors.New("error"))
 
-	result, err := client.GetTx(txHash)
+	result, err := client.GetTx(context.Background(), txHash)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "failed to get tx")
@@ -1288,7 +1418,7 @@ func TestGetTx_RPC_BlockError(t *testing.T) {
 	mockHTTPClient.On("Tx", mock.Anything, hashBytes, true).Return(txResponse, nil)
 	mockHTTPClient.On("Block", mock.Anything, &txResponse.Height).Return(nil, errors.New("error"))
 
-	result, err := client.GetTx(txHash)
+	result, err := client.GetTx(context.Background(), txHash)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "failed to get blocks for tx")
@@ -1333,7 +1463,7 @@ func TestGetTx_RPC_FormatError(t *testing.T) {
 		utilNewTxDecoder = util.NewTxDecoder
 	}()
 
-	result, err := client.GetTx(txHash)
+	result, err := client.GetTx(context.Background(), txHash)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "failed to format tx result")
@@ -1371,7 +1501,7 @@ func TestGetChainID_GRPC(t *testing.T) {
 	block := &cmtservice.Block{Header: cmtservice.Header{Height: 100, ChainID: config.ChainID}}
 	mockGRPCClient.On("GetLatestBlock", mock.Anything, mock.Anything).Return(&cmtservice.GetLatestBlockResponse{SdkBlock: block}, nil)
 
-	chainID, err := client.GetChainID()
+	chainID, err := client.GetChainID(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, config.ChainID, chainID)
 
@@ -1407,7 +1537,7 @@ func TestGetChainID_GRPC_Error(t *testing.T) {
 
 	mockGRPCClient.On("GetLatestBlock", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
 
-	chainID, err := client.GetChainID()
+	chainID, err := client.GetChainID(context.Background())
 	assert.Error(t, err)
 	assert.Equal(t, "", chainID)
 
@@ -1436,7 +1566,7 @@ func TestGetChainID_RPC(t *testing.T) {
 	status := &rpctypes.ResultStatus{SyncInfo: rpctypes.SyncInfo{LatestBlockHeight: 100}, NodeInfo: p2p.DefaultNodeInfo{Network: config.ChainID}}
 	mockHTTPClient.On("Status", mock.Anything).Return(status, nil)
 
-	chainID, err := client.GetChainID()
+	chainID, err := client.GetChainID(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, config.ChainID, chainID)
 
@@ -1464,7 +1594,7 @@ func TestGetChainID_RPC_Error(t *testing.T) {
 
 	mockHTTPClient.On("Status", mock.Anything).Return(nil, errors.New("error"))
 
-	chainID, err := client.GetChainID()
+	chainID, err := client.GetChainID(context.Background())
 	assert.Error(t, err)
 	assert.Equal(t, "", chainID)
 
@@ -1499,7 +1629,7 @@ func TestValidateNetwork(t *testing.T) {
 	block := &cmtservice.Block{Header: cmtservice.Header{Height: 100, ChainID: config.ChainID}}
 	mockGRPCClient.On("GetLatestBlock", mock.Anything, mock.Anything).Return(&cmtservice.GetLatestBlockResponse{SdkBlock: block}, nil)
 
-	err := client.ValidateNetwork()
+	err := client.ValidateNetwork(context.Background())
 	assert.NoError(t, err)
 
 	mockGRPCClient.AssertExpectations(t)
@@ -1535,7 +1665,7 @@ func TestValidateNetwork_ErrorGetChainID(t *testing.T) {
 
 	mockGRPCClient.On("GetLatestBlock", mock.Anything, mock.Anything).Return(nil, errors.New("error getting chain id"))
 
-	err := client.ValidateNetwork()
+	err := client.ValidateNetwork(context.Background())
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get latest block: error getting chain id")
 
@@ -1573,7 +1703,7 @@ func TestValidateNetwork_InvalidChainID(t *testing.T) {
 	block := &cmtservice.Block{Header: cmtservice.Header{Height: 100, ChainID: "InvalidChainID"}}
 	mockGRPCClient.On("GetLatestBlock", mock.Anything, mock.Anything).Return(&cmtservice.GetLatestBlockResponse{SdkBlock: block}, nil)
 
-	err := client.ValidateNetwork()
+	err := client.ValidateNetwork(context.Background())
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "expected chain id TestChainID, got InvalidChainID")
 
@@ -10,20 +10,21 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"context"
 )
 
 // @dev internal methods copied from cosmos-sdk
 
-func getBlocksForTxResults(node CosmosHTTPClient, resTxs []*rpctypes.ResultTx) (map[int64]*rpctypes.ResultBlock, error) {
+func getBlocksForTxResults(ctx context.Context, node CosmosHTTPClient, resTxs []*rpctypes.ResultTx) (map[int64]*rpctypes.ResultBlock, error) {
 	resBlocks := make(map[int64]*rpctypes.ResultBlock)
 
 	for _, resTx := range resTxs {
 		resTx := resTx
 
 		if _, ok := resBlocks[resTx.Height]; !ok {
-			resBlock, err := node.Block(context.Background(), &resTx.Height)
+			resBlock, err := node.Block(ctx, &resTx.Height)
 			if err != nil {
 				return nil, err
 			}
@@ -50,9 +51,9 @@ func formatTxResults(bech32Prefix string, resTxs []*rpctypes.ResultTx, resBlocks
 
 var utilNewTxDecoder = util.NewTxDecoder
 
-func mkTxResult(bech32Prefix string, resTx *rpctypes.ResultTx, resBlock *rpctypes.ResultBlock) (*sdk.TxResponse, error) {
+func decodeAnyTx(bech32Prefix string, txBytes []byte) (AnyTx, error) {
 	txDecoder := utilNewTxDecoder(bech32Prefix)
-	txb, err := txDecoder(resTx.Tx)
+	txb, err := txDecoder(txBytes)
 	if err != nil {
 		return nil, fmt.Errorf("decoding tx: %w", err)
 	}
@@ -60,12 +61,74 @@ func mkTxResult(bech32Prefix string, resTx *rpctypes.ResultTx, resBlock *rpctype
 	if !ok {
 		return nil, fmt.Errorf("expecting a type implementing intoAny, got: %T", txb)
 	}
+	return p, nil
+}
+
+func mkTxResult(bech32Prefix string, resTx *rpctypes.ResultTx, resBlock *rpctypes.ResultBlock) (*sdk.TxResponse, error) {
+	p, err := decodeAnyTx(bech32Prefix, resTx.Tx)
+	if err != nil {
+		return nil, err
+	}
 
 	any := p.AsAny()
 
 	return sdk.NewResponseResultTx(resTx, any, resBlock.Block.Time.Format(time.RFC3339)), nil
 }
 
+// formatBlockTxResults returns the successful txs of the block that send coins to the address
+func formatBlockTxResults(bech32Prefix string, address string, resBlock *rpctypes.ResultBlock, resResults *rpctypes.ResultBlockResults) ([]*sdk.TxResponse, error) {
+	blockTxs := resBlock.Block.Data.Txs
+	if len(resResults.TxsResults) != len(blockTxs) {
+		return nil, fmt.Errorf("block %d has %d txs but %d results", resBlock.Block.Height, len(blockTxs), len(resResults.TxsResults))
+	}
+
+	out := make([]*sdk.TxResponse, 0)
+	for i, txBytes := range blockTxs {
+		result := resResults.TxsResults[i]
+		if result == nil || result.Code != 0 {
+			continue
+		}
+
+		p, err := decodeAnyTx(bech32Prefix, txBytes)
+		if err != nil {
+			// txs with messages unknown to the codec cannot be transfers to the vault
+			continue
+		}
+		if !isSentToAddress(p.GetMsgs(), address) {
+			continue
+		}
+
+		resTx := &rpctypes.ResultTx{
+			Hash:     txBytes.Hash(),
+			Height:   resBlock.Block.Height,
+			Index:    uint32(i),
+			TxResult: *result,
+			Tx:       txBytes,
+		}
+		out = append(out, sdk.NewResponseResultTx(resTx, p.AsAny(), resBlock.Block.Time.Format(time.RFC3339)))
+	}
+
+	return out, nil
+}
+
+func isSentToAddress(msgs []sdk.Msg, address string) bool {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			if msg.ToAddress == address {
+				return true
+			}
+		case *banktypes.MsgMultiSend:
+			for _, output := range msg.Outputs {
+				if output.Address == address {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // Deprecated: this interface is used only internally for scenario we are
 // deprecating (StdTxConfig support)
 type AnyTx interface {
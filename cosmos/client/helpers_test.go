@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -8,8 +9,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
@@ -34,7 +38,7 @@ func TestGetBlocksForTxResults(t *testing.T) {
 	mockClient.EXPECT().Block(mock.Anything, &resTxs[0].Height).Return(resBlock1, nil).Once()
 	mockClient.EXPECT().Block(mock.Anything, &resTxs[1].Height).Return(resBlock2, nil).Once()
 
-	resBlocks, err := getBlocksForTxResults(mockClient, resTxs)
+	resBlocks, err := getBlocksForTxResults(context.Background(), mockClient, resTxs)
 
 	assert.NoError(t, err)
 	assert.Equal(t, 2, len(resBlocks))
@@ -54,7 +58,7 @@ func TestGetBlocksForTxResults_Error(t *testing.T) {
 
 	mockClient.EXPECT().Block(mock.Anything, &resTxs[0].Height).Return(resBlock1, errors.New("error")).Once()
 
-	resBlocks, err := getBlocksForTxResults(mockClient, resTxs)
+	resBlocks, err := getBlocksForTxResults(context.Background(), mockClient, resTxs)
 
 	assert.Error(t, err)
 	assert.Nil(t, resBlocks)
@@ -216,3 +220,73 @@ func TestMkTxResult_InvalidTx(t *testing.T) {
 	assert.Nil(t, txResult)
 	assert.Contains(t, err.Error(), "expecting a type implementing")
 }
+
+func TestFormatBlockTxResults(t *testing.T) {
+	resBlock := &rpctypes.ResultBlock{
+		Block: &types.Block{
+			Header: types.Header{
+				Height: 10,
+				Time:   time.Now(),
+			},
+			Data: types.Data{Txs: types.Txs{{1}, {2}, {3}, {4}}},
+		},
+	}
+	resResults := &rpctypes.ResultBlockResults{
+		Height: 10,
+		TxsResults: []*abci.ExecTxResult{
+			{Code: 0},
+			{Code: 0},
+			{Code: 5},
+			{Code: 0},
+		},
+	}
+
+	toVault := clientMocks.NewMockAnyTx(t)
+	toVault.EXPECT().GetMsgs().Return([]sdk.Msg{&banktypes.MsgSend{ToAddress: "vault"}})
+	toVault.EXPECT().AsAny().Return(&codectypes.Any{})
+	toOther := clientMocks.NewMockAnyTx(t)
+	toOther.EXPECT().GetMsgs().Return([]sdk.Msg{&banktypes.MsgSend{ToAddress: "other"}})
+
+	utilNewTxDecoder = func(bech32Prefix string) sdk.TxDecoder {
+		return func(txBytes []byte) (sdk.Tx, error) {
+			switch txBytes[0] {
+			case 1:
+				return toVault, nil
+			case 2:
+				return toOther, nil
+			default:
+				return nil, errors.New("unknown message")
+			}
+		}
+	}
+	defer func() {
+		utilNewTxDecoder = util.NewTxDecoder
+	}()
+
+	txs, err := formatBlockTxResults("prefix", "vault", resBlock, resResults)
+	assert.NoError(t, err)
+	assert.Len(t, txs, 1)
+	assert.Equal(t, int64(10), txs[0].Height)
+	assert.Equal(t, types.Tx{1}.Hash(), ethcommon.FromHex(txs[0].TxHash))
+}
+
+func TestFormatBlockTxResults_ResultsMismatch(t *testing.T) {
+	resBlock := &rpctypes.ResultBlock{
+		Block: &types.Block{
+			Header: types.Header{Height: 10},
+			Data:   types.Data{Txs: types.Txs{{1}}},
+		},
+	}
+
+	txs, err := formatBlockTxResults("prefix", "vault", resBlock, &rpctypes.ResultBlockResults{})
+	assert.Error(t, err)
+	assert.Nil(t, txs)
+	assert.Contains(t, err.Error(), "block 10 has 1 txs but 0 results")
+}
+
+func TestIsSentToAddress(t *testing.T) {
+	assert.True(t, isSentToAddress([]sdk.Msg{&banktypes.MsgSend{ToAddress: "vault"}}, "vault"))
+	assert.True(t, isSentToAddress([]sdk.Msg{&banktypes.MsgMultiSend{Outputs: []banktypes.Output{{Address: "other"}, {Address: "vault"}}}}, "vault"))
+	assert.False(t, isSentToAddress([]sdk.Msg{&banktypes.MsgSend{FromAddress: "vault", ToAddress: "other"}}, "vault"))
+	assert.False(t, isSentToAddress(nil, "vault"))
+}
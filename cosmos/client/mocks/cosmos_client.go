@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	cosmos_sdktypes "github.com/cosmos/cosmos-sdk/types"
 	mock "github.com/stretchr/testify/mock"
 
@@ -22,9 +24,9 @@ func (_m *MockCosmosClient) EXPECT() *MockCosmosClient_Expecter {
 	return &MockCosmosClient_Expecter{mock: &_m.Mock}
 }
 
-// BroadcastTx provides a mock function with given fields: txBytes
-func (_m *MockCosmosClient) BroadcastTx(txBytes []byte) (string, error) {
-	ret := _m.Called(txBytes)
+// BroadcastTx provides a mock function with given fields: ctx, txBytes
+func (_m *MockCosmosClient) BroadcastTx(ctx context.Context, txBytes []byte) (string, error) {
+	ret := _m.Called(ctx, txBytes)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BroadcastTx")
@@ -32,17 +34,17 @@ func (_m *MockCosmosClient) BroadcastTx(txBytes []byte) (string, error) {
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]byte) (string, error)); ok {
-		return rf(txBytes)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) (string, error)); ok {
+		return rf(ctx, txBytes)
 	}
-	if rf, ok := ret.Get(0).(func([]byte) string); ok {
-		r0 = rf(txBytes)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) string); ok {
+		r0 = rf(ctx, txBytes)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func([]byte) error); ok {
-		r1 = rf(txBytes)
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, txBytes)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,14 +58,15 @@ type MockCosmosClient_BroadcastTx_Call struct {
 }
 
 // BroadcastTx is a helper method to define mock.On call
+//   - ctx context.Context
 //   - txBytes []byte
-func (_e *MockCosmosClient_Expecter) BroadcastTx(txBytes interface{}) *MockCosmosClient_BroadcastTx_Call {
-	return &MockCosmosClient_BroadcastTx_Call{Call: _e.mock.On("BroadcastTx", txBytes)}
+func (_e *MockCosmosClient_Expecter) BroadcastTx(ctx interface{}, txBytes interface{}) *MockCosmosClient_BroadcastTx_Call {
+	return &MockCosmosClient_BroadcastTx_Call{Call: _e.mock.On("BroadcastTx", ctx, txBytes)}
 }
 
-func (_c *MockCosmosClient_BroadcastTx_Call) Run(run func(txBytes []byte)) *MockCosmosClient_BroadcastTx_Call {
+func (_c *MockCosmosClient_BroadcastTx_Call) Run(run func(ctx context.Context, txBytes []byte)) *MockCosmosClient_BroadcastTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]byte))
+		run(args[0].(context.Context), args[1].([]byte))
 	})
 	return _c
 }
@@ -73,7 +76,7 @@ func (_c *MockCosmosClient_BroadcastTx_Call) Return(_a0 string, _a1 error) *Mock
 	return _c
 }
 
-func (_c *MockCosmosClient_BroadcastTx_Call) RunAndReturn(run func([]byte) (string, error)) *MockCosmosClient_BroadcastTx_Call {
+func (_c *MockCosmosClient_BroadcastTx_Call) RunAndReturn(run func(context.Context, []byte) (string, error)) *MockCosmosClient_BroadcastTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -123,9 +126,9 @@ func (_c *MockCosmosClient_Confirmations_Call) RunAndReturn(run func() uint64) *
 	return _c
 }
 
-// GetAccount provides a mock function with given fields: address
-func (_m *MockCosmosClient) GetAccount(address string) (*types.BaseAccount, error) {
-	ret := _m.Called(address)
+// GetAccount provides a mock function with given fields: ctx, address
+func (_m *MockCosmosClient) GetAccount(ctx context.Context, address string) (*types.BaseAccount, error) {
+	ret := _m.Called(ctx, address)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAccount")
@@ -133,19 +136,19 @@ func (_m *MockCosmosClient) GetAccount(address string) (*types.BaseAccount, erro
 
 	var r0 *types.BaseAccount
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*types.BaseAccount, error)); ok {
-		return rf(address)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.BaseAccount, error)); ok {
+		return rf(ctx, address)
 	}
-	if rf, ok := ret.Get(0).(func(string) *types.BaseAccount); ok {
-		r0 = rf(address)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *types.BaseAccount); ok {
+		r0 = rf(ctx, address)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.BaseAccount)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(address)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, address)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -159,14 +162,15 @@ type MockCosmosClient_GetAccount_Call struct {
 }
 
 // GetAccount is a helper method to define mock.On call
+//   - ctx context.Context
 //   - address string
-func (_e *MockCosmosClient_Expecter) GetAccount(address interface{}) *MockCosmosClient_GetAccount_Call {
-	return &MockCosmosClient_GetAccount_Call{Call: _e.mock.On("GetAccount", address)}
+func (_e *MockCosmosClient_Expecter) GetAccount(ctx interface{}, address interface{}) *MockCosmosClient_GetAccount_Call {
+	return &MockCosmosClient_GetAccount_Call{Call: _e.mock.On("GetAccount", ctx, address)}
 }
 
-func (_c *MockCosmosClient_GetAccount_Call) Run(run func(address string)) *MockCosmosClient_GetAccount_Call {
+func (_c *MockCosmosClient_GetAccount_Call) Run(run func(ctx context.Context, address string)) *MockCosmosClient_GetAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -176,14 +180,14 @@ func (_c *MockCosmosClient_GetAccount_Call) Return(_a0 *types.BaseAccount, _a1 e
 	return _c
 }
 
-func (_c *MockCosmosClient_GetAccount_Call) RunAndReturn(run func(string) (*types.BaseAccount, error)) *MockCosmosClient_GetAccount_Call {
+func (_c *MockCosmosClient_GetAccount_Call) RunAndReturn(run func(context.Context, string) (*types.BaseAccount, error)) *MockCosmosClient_GetAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetChainID provides a mock function with no fields
-func (_m *MockCosmosClient) GetChainID() (string, error) {
-	ret := _m.Called()
+// GetChainID provides a mock function with given fields: ctx
+func (_m *MockCosmosClient) GetChainID(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetChainID")
@@ -191,17 +195,17 @@ func (_m *MockCosmosClient) GetChainID() (string, error) {
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (string, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -215,13 +219,14 @@ type MockCosmosClient_GetChainID_Call struct {
 }
 
 // GetChainID is a helper method to define mock.On call
-func (_e *MockCosmosClient_Expecter) GetChainID() *MockCosmosClient_GetChainID_Call {
-	return &MockCosmosClient_GetChainID_Call{Call: _e.mock.On("GetChainID")}
+//   - ctx context.Context
+func (_e *MockCosmosClient_Expecter) GetChainID(ctx interface{}) *MockCosmosClient_GetChainID_Call {
+	return &MockCosmosClient_GetChainID_Call{Call: _e.mock.On("GetChainID", ctx)}
 }
 
-func (_c *MockCosmosClient_GetChainID_Call) Run(run func()) *MockCosmosClient_GetChainID_Call {
+func (_c *MockCosmosClient_GetChainID_Call) Run(run func(ctx context.Context)) *MockCosmosClient_GetChainID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
@@ -231,14 +236,14 @@ func (_c *MockCosmosClient_GetChainID_Call) Return(_a0 string, _a1 error) *MockC
 	return _c
 }
 
-func (_c *MockCosmosClient_GetChainID_Call) RunAndReturn(run func() (string, error)) *MockCosmosClient_GetChainID_Call {
+func (_c *MockCosmosClient_GetChainID_Call) RunAndReturn(run func(context.Context) (string, error)) *MockCosmosClient_GetChainID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLatestBlockHeight provides a mock function with no fields
-func (_m *MockCosmosClient) GetLatestBlockHeight() (int64, error) {
-	ret := _m.Called()
+// GetLatestBlockHeight provides a mock function with given fields: ctx
+func (_m *MockCosmosClient) GetLatestBlockHeight(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetLatestBlockHeight")
@@ -246,17 +251,17 @@ func (_m *MockCosmosClient) GetLatestBlockHeight() (int64, error) {
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() int64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -270,13 +275,14 @@ type MockCosmosClient_GetLatestBlockHeight_Call struct {
 }
 
 // GetLatestBlockHeight is a helper method to define mock.On call
-func (_e *MockCosmosClient_Expecter) GetLatestBlockHeight() *MockCosmosClient_GetLatestBlockHeight_Call {
-	return &MockCosmosClient_GetLatestBlockHeight_Call{Call: _e.mock.On("GetLatestBlockHeight")}
+//   - ctx context.Context
+func (_e *MockCosmosClient_Expecter) GetLatestBlockHeight(ctx interface{}) *MockCosmosClient_GetLatestBlockHeight_Call {
+	return &MockCosmosClient_GetLatestBlockHeight_Call{Call: _e.mock.On("GetLatestBlockHeight", ctx)}
 }
 
-func (_c *MockCosmosClient_GetLatestBlockHeight_Call) Run(run func()) *MockCosmosClient_GetLatestBlockHeight_Call {
+func (_c *MockCosmosClient_GetLatestBlockHeight_Call) Run(run func(ctx context.Context)) *MockCosmosClient_GetLatestBlockHeight_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
@@ -286,14 +292,14 @@ func (_c *MockCosmosClient_GetLatestBlockHeight_Call) Return(_a0 int64, _a1 erro
 	return _c
 }
 
-func (_c *MockCosmosClient_GetLatestBlockHeight_Call) RunAndReturn(run func() (int64, error)) *MockCosmosClient_GetLatestBlockHeight_Call {
+func (_c *MockCosmosClient_GetLatestBlockHeight_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockCosmosClient_GetLatestBlockHeight_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTx provides a mock function with given fields: hash
-func (_m *MockCosmosClient) GetTx(hash string) (*cosmos_sdktypes.TxResponse, error) {
-	ret := _m.Called(hash)
+// GetTx provides a mock function with given fields: ctx, hash
+func (_m *MockCosmosClient) GetTx(ctx context.Context, hash string) (*cosmos_sdktypes.TxResponse, error) {
+	ret := _m.Called(ctx, hash)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTx")
@@ -301,19 +307,19 @@ func (_m *MockCosmosClient) GetTx(hash string) (*cosmos_sdktypes.TxResponse, err
 
 	var r0 *cosmos_sdktypes.TxResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*cosmos_sdktypes.TxResponse, error)); ok {
-		return rf(hash)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*cosmos_sdktypes.TxResponse, error)); ok {
+		return rf(ctx, hash)
 	}
-	if rf, ok := ret.Get(0).(func(string) *cosmos_sdktypes.TxResponse); ok {
-		r0 = rf(hash)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *cosmos_sdktypes.TxResponse); ok {
+		r0 = rf(ctx, hash)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*cosmos_sdktypes.TxResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(hash)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, hash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -327,14 +333,15 @@ type MockCosmosClient_GetTx_Call struct {
 }
 
 // GetTx is a helper method to define mock.On call
+//   - ctx context.Context
 //   - hash string
-func (_e *MockCosmosClient_Expecter) GetTx(hash interface{}) *MockCosmosClient_GetTx_Call {
-	return &MockCosmosClient_GetTx_Call{Call: _e.mock.On("GetTx", hash)}
+func (_e *MockCosmosClient_Expecter) GetTx(ctx interface{}, hash interface{}) *MockCosmosClient_GetTx_Call {
+	return &MockCosmosClient_GetTx_Call{Call: _e.mock.On("GetTx", ctx, hash)}
 }
 
-func (_c *MockCosmosClient_GetTx_Call) Run(run func(hash string)) *MockCosmosClient_GetTx_Call {
+func (_c *MockCosmosClient_GetTx_Call) Run(run func(ctx context.Context, hash string)) *MockCosmosClient_GetTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -344,14 +351,14 @@ func (_c *MockCosmosClient_GetTx_Call) Return(_a0 *cosmos_sdktypes.TxResponse, _
 	return _c
 }
 
-func (_c *MockCosmosClient_GetTx_Call) RunAndReturn(run func(string) (*cosmos_sdktypes.TxResponse, error)) *MockCosmosClient_GetTx_Call {
+func (_c *MockCosmosClient_GetTx_Call) RunAndReturn(run func(context.Context, string) (*cosmos_sdktypes.TxResponse, error)) *MockCosmosClient_GetTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTxsSentFromAddressAfterHeight provides a mock function with given fields: address, height
-func (_m *MockCosmosClient) GetTxsSentFromAddressAfterHeight(address string, height uint64) ([]*cosmos_sdktypes.TxResponse, error) {
-	ret := _m.Called(address, height)
+// GetTxsSentFromAddressAfterHeight provides a mock function with given fields: ctx, address, height
+func (_m *MockCosmosClient) GetTxsSentFromAddressAfterHeight(ctx context.Context, address string, height uint64) ([]*cosmos_sdktypes.TxResponse, error) {
+	ret := _m.Called(ctx, address, height)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTxsSentFromAddressAfterHeight")
@@ -359,19 +366,19 @@ func (_m *MockCosmosClient) GetTxsSentFromAddressAfterHeight(address string, hei
 
 	var r0 []*cosmos_sdktypes.TxResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, uint64) ([]*cosmos_sdktypes.TxResponse, error)); ok {
-		return rf(address, height)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) ([]*cosmos_sdktypes.TxResponse, error)); ok {
+		return rf(ctx, address, height)
 	}
-	if rf, ok := ret.Get(0).(func(string, uint64) []*cosmos_sdktypes.TxResponse); ok {
-		r0 = rf(address, height)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) []*cosmos_sdktypes.TxResponse); ok {
+		r0 = rf(ctx, address, height)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*cosmos_sdktypes.TxResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, uint64) error); ok {
-		r1 = rf(address, height)
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
+		r1 = rf(ctx, address, height)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -385,15 +392,16 @@ type MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call struct {
 }
 
 // GetTxsSentFromAddressAfterHeight is a helper method to define mock.On call
+//   - ctx context.Context
 //   - address string
 //   - height uint64
-func (_e *MockCosmosClient_Expecter) GetTxsSentFromAddressAfterHeight(address interface{}, height interface{}) *MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call {
-	return &MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call{Call: _e.mock.On("GetTxsSentFromAddressAfterHeight", address, height)}
+func (_e *MockCosmosClient_Expecter) GetTxsSentFromAddressAfterHeight(ctx interface{}, address interface{}, height interface{}) *MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call {
+	return &MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call{Call: _e.mock.On("GetTxsSentFromAddressAfterHeight", ctx, address, height)}
 }
 
-func (_c *MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call) Run(run func(address string, height uint64)) *MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call {
+func (_c *MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call) Run(run func(ctx context.Context, address string, height uint64)) *MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64))
 	})
 	return _c
 }
@@ -403,14 +411,14 @@ func (_c *MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call) Return(_a0 []*
 	return _c
 }
 
-func (_c *MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call) RunAndReturn(run func(string, uint64) ([]*cosmos_sdktypes.TxResponse, error)) *MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call {
+func (_c *MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call) RunAndReturn(run func(context.Context, string, uint64) ([]*cosmos_sdktypes.TxResponse, error)) *MockCosmosClient_GetTxsSentFromAddressAfterHeight_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTxsSentToAddressAfterHeight provides a mock function with given fields: address, height
-func (_m *MockCosmosClient) GetTxsSentToAddressAfterHeight(address string, height uint64) ([]*cosmos_sdktypes.TxResponse, error) {
-	ret := _m.Called(address, height)
+// GetTxsSentToAddressAfterHeight provides a mock function with given fields: ctx, address, height
+func (_m *MockCosmosClient) GetTxsSentToAddressAfterHeight(ctx context.Context, address string, height uint64) ([]*cosmos_sdktypes.TxResponse, error) {
+	ret := _m.Called(ctx, address, height)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTxsSentToAddressAfterHeight")
@@ -418,19 +426,19 @@ func (_m *MockCosmosClient) GetTxsSentToAddressAfterHeight(address string, heigh
 
 	var r0 []*cosmos_sdktypes.TxResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, uint64) ([]*cosmos_sdktypes.TxResponse, error)); ok {
-		return rf(address, height)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) ([]*cosmos_sdktypes.TxResponse, error)); ok {
+		return rf(ctx, address, height)
 	}
-	if rf, ok := ret.Get(0).(func(string, uint64) []*cosmos_sdktypes.TxResponse); ok {
-		r0 = rf(address, height)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) []*cosmos_sdktypes.TxResponse); ok {
+		r0 = rf(ctx, address, height)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*cosmos_sdktypes.TxResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, uint64) error); ok {
-		r1 = rf(address, height)
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
+		r1 = rf(ctx, address, height)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -444,15 +452,16 @@ type MockCosmosClient_GetTxsSentToAddressAfterHeight_Call struct {
 }
 
 // GetTxsSentToAddressAfterHeight is a helper method to define mock.On call
+//   - ctx context.Context
 //   - address string
 //   - height uint64
-func (_e *MockCosmosClient_Expecter) GetTxsSentToAddressAfterHeight(address interface{}, height interface{}) *MockCosmosClient_GetTxsSentToAddressAfterHeight_Call {
-	return &MockCosmosClient_GetTxsSentToAddressAfterHeight_Call{Call: _e.mock.On("GetTxsSentToAddressAfterHeight", address, height)}
+func (_e *MockCosmosClient_Expecter) GetTxsSentToAddressAfterHeight(ctx interface{}, address interface{}, height interface{}) *MockCosmosClient_GetTxsSentToAddressAfterHeight_Call {
+	return &MockCosmosClient_GetTxsSentToAddressAfterHeight_Call{Call: _e.mock.On("GetTxsSentToAddressAfterHeight", ctx, address, height)}
 }
 
-func (_c *MockCosmosClient_GetTxsSentToAddressAfterHeight_Call) Run(run func(address string, height uint64)) *MockCosmosClient_GetTxsSentToAddressAfterHeight_Call {
+func (_c *MockCosmosClient_GetTxsSentToAddressAfterHeight_Call) Run(run func(ctx context.Context, address string, height uint64)) *MockCosmosClient_GetTxsSentToAddressAfterHeight_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64))
 	})
 	return _c
 }
@@ -462,14 +471,135 @@ func (_c *MockCosmosClient_GetTxsSentToAddressAfterHeight_Call) Return(_a0 []*co
 	return _c
 }
 
-func (_c *MockCosmosClient_GetTxsSentToAddressAfterHeight_Call) RunAndReturn(run func(string, uint64) ([]*cosmos_sdktypes.TxResponse, error)) *MockCosmosClient_GetTxsSentToAddressAfterHeight_Call {
+func (_c *MockCosmosClient_GetTxsSentToAddressAfterHeight_Call) RunAndReturn(run func(context.Context, string, uint64) ([]*cosmos_sdktypes.TxResponse, error)) *MockCosmosClient_GetTxsSentToAddressAfterHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTxsSentToAddressInBlock provides a mock function with given fields: ctx, address, height
+func (_m *MockCosmosClient) GetTxsSentToAddressInBlock(ctx context.Context, address string, height int64) ([]*cosmos_sdktypes.TxResponse, error) {
+	ret := _m.Called(ctx, address, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTxsSentToAddressInBlock")
+	}
+
+	var r0 []*cosmos_sdktypes.TxResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]*cosmos_sdktypes.TxResponse, error)); ok {
+		return rf(ctx, address, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []*cosmos_sdktypes.TxResponse); ok {
+		r0 = rf(ctx, address, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*cosmos_sdktypes.TxResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, address, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCosmosClient_GetTxsSentToAddressInBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTxsSentToAddressInBlock'
+type MockCosmosClient_GetTxsSentToAddressInBlock_Call struct {
+	*mock.Call
+}
+
+// GetTxsSentToAddressInBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address string
+//   - height int64
+func (_e *MockCosmosClient_Expecter) GetTxsSentToAddressInBlock(ctx interface{}, address interface{}, height interface{}) *MockCosmosClient_GetTxsSentToAddressInBlock_Call {
+	return &MockCosmosClient_GetTxsSentToAddressInBlock_Call{Call: _e.mock.On("GetTxsSentToAddressInBlock", ctx, address, height)}
+}
+
+func (_c *MockCosmosClient_GetTxsSentToAddressInBlock_Call) Run(run func(ctx context.Context, address string, height int64)) *MockCosmosClient_GetTxsSentToAddressInBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCosmosClient_GetTxsSentToAddressInBlock_Call) Return(_a0 []*cosmos_sdktypes.TxResponse, _a1 error) *MockCosmosClient_GetTxsSentToAddressInBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCosmosClient_GetTxsSentToAddressInBlock_Call) RunAndReturn(run func(context.Context, string, int64) ([]*cosmos_sdktypes.TxResponse, error)) *MockCosmosClient_GetTxsSentToAddressInBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTxsSentToAddressInRange provides a mock function with given fields: ctx, address, fromHeight, toHeight
+func (_m *MockCosmosClient) GetTxsSentToAddressInRange(ctx context.Context, address string, fromHeight uint64, toHeight uint64) ([]*cosmos_sdktypes.TxResponse, error) {
+	ret := _m.Called(ctx, address, fromHeight, toHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTxsSentToAddressInRange")
+	}
+
+	var r0 []*cosmos_sdktypes.TxResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) ([]*cosmos_sdktypes.TxResponse, error)); ok {
+		return rf(ctx, address, fromHeight, toHeight)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) []*cosmos_sdktypes.TxResponse); ok {
+		r0 = rf(ctx, address, fromHeight, toHeight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*cosmos_sdktypes.TxResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, address, fromHeight, toHeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCosmosClient_GetTxsSentToAddressInRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTxsSentToAddressInRange'
+type MockCosmosClient_GetTxsSentToAddressInRange_Call struct {
+	*mock.Call
+}
+
+// GetTxsSentToAddressInRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address string
+//   - fromHeight uint64
+//   - toHeight uint64
+func (_e *MockCosmosClient_Expecter) GetTxsSentToAddressInRange(ctx interface{}, address interface{}, fromHeight interface{}, toHeight interface{}) *MockCosmosClient_GetTxsSentToAddressInRange_Call {
+	return &MockCosmosClient_GetTxsSentToAddressInRange_Call{Call: _e.mock.On("GetTxsSentToAddressInRange", ctx, address, fromHeight, toHeight)}
+}
+
+func (_c *MockCosmosClient_GetTxsSentToAddressInRange_Call) Run(run func(ctx context.Context, address string, fromHeight uint64, toHeight uint64)) *MockCosmosClient_GetTxsSentToAddressInRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockCosmosClient_GetTxsSentToAddressInRange_Call) Return(_a0 []*cosmos_sdktypes.TxResponse, _a1 error) *MockCosmosClient_GetTxsSentToAddressInRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCosmosClient_GetTxsSentToAddressInRange_Call) RunAndReturn(run func(context.Context, string, uint64, uint64) ([]*cosmos_sdktypes.TxResponse, error)) *MockCosmosClient_GetTxsSentToAddressInRange_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Simulate provides a mock function with given fields: txBytes
-func (_m *MockCosmosClient) Simulate(txBytes []byte) (*cosmos_sdktypes.GasInfo, error) {
-	ret := _m.Called(txBytes)
+// Simulate provides a mock function with given fields: ctx, txBytes
+func (_m *MockCosmosClient) Simulate(ctx context.Context, txBytes []byte) (*cosmos_sdktypes.GasInfo, error) {
+	ret := _m.Called(ctx, txBytes)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Simulate")
@@ -477,19 +607,19 @@ func (_m *MockCosmosClient) Simulate(txBytes []byte) (*cosmos_sdktypes.GasInfo,
 
 	var r0 *cosmos_sdktypes.GasInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]byte) (*cosmos_sdktypes.GasInfo, error)); ok {
-		return rf(txBytes)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) (*cosmos_sdktypes.GasInfo, error)); ok {
+		return rf(ctx, txBytes)
 	}
-	if rf, ok := ret.Get(0).(func([]byte) *cosmos_sdktypes.GasInfo); ok {
-		r0 = rf(txBytes)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *cosmos_sdktypes.GasInfo); ok {
+		r0 = rf(ctx, txBytes)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*cosmos_sdktypes.GasInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]byte) error); ok {
-		r1 = rf(txBytes)
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, txBytes)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -503,14 +633,15 @@ type MockCosmosClient_Simulate_Call struct {
 }
 
 // Simulate is a helper method to define mock.On call
+//   - ctx context.Context
 //   - txBytes []byte
-func (_e *MockCosmosClient_Expecter) Simulate(txBytes interface{}) *MockCosmosClient_Simulate_Call {
-	return &MockCosmosClient_Simulate_Call{Call: _e.mock.On("Simulate", txBytes)}
+func (_e *MockCosmosClient_Expecter) Simulate(ctx interface{}, txBytes interface{}) *MockCosmosClient_Simulate_Call {
+	return &MockCosmosClient_Simulate_Call{Call: _e.mock.On("Simulate", ctx, txBytes)}
 }
 
-func (_c *MockCosmosClient_Simulate_Call) Run(run func(txBytes []byte)) *MockCosmosClient_Simulate_Call {
+func (_c *MockCosmosClient_Simulate_Call) Run(run func(ctx context.Context, txBytes []byte)) *MockCosmosClient_Simulate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]byte))
+		run(args[0].(context.Context), args[1].([]byte))
 	})
 	return _c
 }
@@ -520,22 +651,22 @@ func (_c *MockCosmosClient_Simulate_Call) Return(_a0 *cosmos_sdktypes.GasInfo, _
 	return _c
 }
 
-func (_c *MockCosmosClient_Simulate_Call) RunAndReturn(run func([]byte) (*cosmos_sdktypes.GasInfo, error)) *MockCosmosClient_Simulate_Call {
+func (_c *MockCosmosClient_Simulate_Call) RunAndReturn(run func(context.Context, []byte) (*cosmos_sdktypes.GasInfo, error)) *MockCosmosClient_Simulate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ValidateNetwork provides a mock function with no fields
-func (_m *MockCosmosClient) ValidateNetwork() error {
-	ret := _m.Called()
+// ValidateNetwork provides a mock function with given fields: ctx
+func (_m *MockCosmosClient) ValidateNetwork(ctx context.Context) error {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ValidateNetwork")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -549,13 +680,14 @@ type MockCosmosClient_ValidateNetwork_Call struct {
 }
 
 // ValidateNetwork is a helper method to define mock.On call
-func (_e *MockCosmosClient_Expecter) ValidateNetwork() *MockCosmosClient_ValidateNetwork_Call {
-	return &MockCosmosClient_ValidateNetwork_Call{Call: _e.mock.On("ValidateNetwork")}
+//   - ctx context.Context
+func (_e *MockCosmosClient_Expecter) ValidateNetwork(ctx interface{}) *MockCosmosClient_ValidateNetwork_Call {
+	return &MockCosmosClient_ValidateNetwork_Call{Call: _e.mock.On("ValidateNetwork", ctx)}
 }
 
-func (_c *MockCosmosClient_ValidateNetwork_Call) Run(run func()) *MockCosmosClient_ValidateNetwork_Call {
+func (_c *MockCosmosClient_ValidateNetwork_Call) Run(run func(ctx context.Context)) *MockCosmosClient_ValidateNetwork_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
@@ -565,7 +697,7 @@ func (_c *MockCosmosClient_ValidateNetwork_Call) Return(_a0 error) *MockCosmosCl
 	return _c
 }
 
-func (_c *MockCosmosClient_ValidateNetwork_Call) RunAndReturn(run func() error) *MockCosmosClient_ValidateNetwork_Call {
+func (_c *MockCosmosClient_ValidateNetwork_Call) RunAndReturn(run func(context.Context) error) *MockCosmosClient_ValidateNetwork_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,229 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/common"
+	"github.com/dan13ram/wpokt-validator/models"
+)
+
+// multiClient spreads requests over several endpoints, failing over to the next endpoint on errors.
+// With a quorum, GetTx, GetAccount and GetLatestBlockHeight only return results that enough endpoints agree on.
+type multiClient struct {
+	config models.CosmosConfig
+	quorum int
+	pool   *common.EndpointPool[CosmosClient]
+	logger *log.Entry
+}
+
+// endpointConfigs returns a config for the primary endpoint followed by one for every additional endpoint
+func endpointConfigs(config models.CosmosConfig) ([]models.CosmosConfig, error) {
+	configs := []models.CosmosConfig{config}
+
+	if config.GRPCEnabled {
+		seen := []string{net.JoinHostPort(config.GRPCHost, strconv.FormatUint(config.GRPCPort, 10))}
+		for index, endpoint := range config.GRPCEndpoints {
+			host, port, err := net.SplitHostPort(endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("invalid grpc endpoint [%d] %q: %w", index, endpoint, err)
+			}
+			grpcPort, err := strconv.ParseUint(port, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid port of grpc endpoint [%d] %q: %w", index, endpoint, err)
+			}
+			if slices.Contains(seen, endpoint) {
+				continue
+			}
+			seen = append(seen, endpoint)
+			endpointConfig := config
+			endpointConfig.GRPCHost = host
+			endpointConfig.GRPCPort = grpcPort
+			configs = append(configs, endpointConfig)
+		}
+		return configs, nil
+	}
+
+	seen := []string{config.RPCURL}
+	for _, rpcURL := range config.RPCURLs {
+		if rpcURL == "" || slices.Contains(seen, rpcURL) {
+			continue
+		}
+		seen = append(seen, rpcURL)
+		endpointConfig := config
+		endpointConfig.RPCURL = rpcURL
+		configs = append(configs, endpointConfig)
+	}
+	return configs, nil
+}
+
+// endpointName identifies an endpoint in logs without the path or credentials of its url
+func endpointName(index int, config models.CosmosConfig) string {
+	host := net.JoinHostPort(config.GRPCHost, strconv.FormatUint(config.GRPCPort, 10))
+	if !config.GRPCEnabled {
+		host = config.RPCURL
+		if u, err := url.Parse(config.RPCURL); err == nil && u.Host != "" {
+			host = u.Host
+		}
+	}
+	return fmt.Sprintf("#%d %s", index, host)
+}
+
+func newMultiClient(config models.CosmosConfig, endpoints []models.CosmosConfig, logger *log.Entry) (CosmosClient, error) {
+	names := make([]string, len(endpoints))
+	clients := make([]CosmosClient, len(endpoints))
+	for i, endpointConfig := range endpoints {
+		names[i] = endpointName(i, endpointConfig)
+		c, err := newCosmosClient(endpointConfig, logger.WithField("endpoint", names[i]))
+		if err != nil {
+			return nil, err
+		}
+		clients[i] = c
+	}
+
+	c := &multiClient{
+		config: config,
+		quorum: int(config.Quorum),
+		pool:   common.NewEndpointPool(app.ChainPocket, names, clients),
+		logger: logger,
+	}
+
+	if err := c.ValidateNetwork(context.Background()); err != nil {
+		logger.WithError(err).Error("[POKT] failed to validate network")
+		return nil, fmt.Errorf("failed to validate network")
+	}
+
+	logger.Infof("[POKT] Using %d endpoints with quorum %d", len(endpoints), max(c.quorum, 1))
+	return c, nil
+}
+
+func (c *multiClient) quorumEnabled() bool {
+	return c.quorum > 1
+}
+
+// EndpointHealth reports the health and score of every endpoint
+func (c *multiClient) EndpointHealth() []models.EndpointHealth {
+	return c.pool.Health()
+}
+
+func (c *multiClient) Confirmations() uint64 {
+	return uint64(c.config.Confirmations)
+}
+
+func (c *multiClient) GetLatestBlockHeight(ctx context.Context) (int64, error) {
+	call := func(client CosmosClient) (int64, error) { return client.GetLatestBlockHeight(ctx) }
+	if c.quorumEnabled() {
+		return common.QuorumReached(c.pool, c.quorum, call)
+	}
+	return common.Failover(ctx, c.pool, call)
+}
+
+func (c *multiClient) GetChainID(ctx context.Context) (string, error) {
+	return common.Failover(ctx, c.pool, func(client CosmosClient) (string, error) { return client.GetChainID(ctx) })
+}
+
+func (c *multiClient) GetTxsSentFromAddressAfterHeight(ctx context.Context, address string, height uint64) ([]*sdk.TxResponse, error) {
+	return common.Failover(ctx, c.pool, func(client CosmosClient) ([]*sdk.TxResponse, error) {
+		return client.GetTxsSentFromAddressAfterHeight(ctx, address, height)
+	})
+}
+
+func (c *multiClient) GetTxsSentToAddressAfterHeight(ctx context.Context, address string, height uint64) ([]*sdk.TxResponse, error) {
+	return common.Failover(ctx, c.pool, func(client CosmosClient) ([]*sdk.TxResponse, error) {
+		return client.GetTxsSentToAddressAfterHeight(ctx, address, height)
+	})
+}
+
+func (c *multiClient) GetTxsSentToAddressInRange(ctx context.Context, address string, fromHeight uint64, toHeight uint64) ([]*sdk.TxResponse, error) {
+	return common.Failover(ctx, c.pool, func(client CosmosClient) ([]*sdk.TxResponse, error) {
+		return client.GetTxsSentToAddressInRange(ctx, address, fromHeight, toHeight)
+	})
+}
+
+func (c *multiClient) GetTxsSentToAddressInBlock(ctx context.Context, address string, height int64) ([]*sdk.TxResponse, error) {
+	return common.Failover(ctx, c.pool, func(client CosmosClient) ([]*sdk.TxResponse, error) {
+		return client.GetTxsSentToAddressInBlock(ctx, address, height)
+	})
+}
+
+func (c *multiClient) GetAccount(ctx context.Context, address string) (*auth.BaseAccount, error) {
+	call := func(client CosmosClient) (*auth.BaseAccount, error) { return client.GetAccount(ctx, address) }
+	if c.quorumEnabled() {
+		return common.Quorum(c.pool, c.quorum, call, accountKey)
+	}
+	return common.Failover(ctx, c.pool, call)
+}
+
+func (c *multiClient) Simulate(ctx context.Context, txBytes []byte) (*sdk.GasInfo, error) {
+	return common.Failover(ctx, c.pool, func(client CosmosClient) (*sdk.GasInfo, error) { return client.Simulate(ctx, txBytes) })
+}
+
+func (c *multiClient) BroadcastTx(ctx context.Context, txBytes []byte) (string, error) {
+	return common.Failover(ctx, c.pool, func(client CosmosClient) (string, error) { return client.BroadcastTx(ctx, txBytes) })
+}
+
+func (c *multiClient) GetTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	call := func(client CosmosClient) (*sdk.TxResponse, error) { return client.GetTx(ctx, hash) }
+	if c.quorumEnabled() {
+		return common.Quorum(c.pool, c.quorum, call, txKey)
+	}
+	return common.Failover(ctx, c.pool, call)
+}
+
+// ValidateNetwork fails if any endpoint serves another chain, unreachable endpoints are only marked unhealthy
+func (c *multiClient) ValidateNetwork(ctx context.Context) error {
+	c.logger.Debugf("[POKT] Validating network")
+	reachable := 0
+	for _, e := range c.pool.Ordered() {
+		chainID, err := e.Client.GetChainID(ctx)
+		c.pool.Report(e, err)
+		if err != nil {
+			c.logger.WithError(err).Warnf("[POKT] Endpoint %s is unreachable", e.Name)
+			continue
+		}
+		if chainID != c.config.ChainID {
+			return fmt.Errorf("endpoint %s: expected chain id %s, got %s", e.Name, c.config.ChainID, chainID)
+		}
+		reachable++
+	}
+	if reachable < max(c.quorum, 1) {
+		return fmt.Errorf("%d of %d endpoints are reachable, %d required", reachable, c.pool.Len(), max(c.quorum, 1))
+	}
+	c.logger.Debugf("[POKT] Validated network")
+	return nil
+}
+
+// txKey compares the parts of a tx response that validation depends on
+func txKey(tx *sdk.TxResponse) (string, error) {
+	if tx == nil {
+		return "", fmt.Errorf("empty tx response")
+	}
+	compared := &sdk.TxResponse{
+		Height: tx.Height,
+		TxHash: tx.TxHash,
+		Code:   tx.Code,
+		Tx:     tx.Tx,
+		Events: tx.Events,
+	}
+	bz, err := compared.Marshal()
+	if err != nil {
+		return "", fmt.Errorf("error encoding tx response: %w", err)
+	}
+	return string(bz), nil
+}
+
+func accountKey(account *auth.BaseAccount) (string, error) {
+	if account == nil {
+		return "", fmt.Errorf("empty account")
+	}
+	return fmt.Sprintf("%s/%d/%d", account.Address, account.AccountNumber, account.Sequence), nil
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	ctypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dan13ram/wpokt-validator/models"
+)
+
+const subscriberName = "wpokt-validator"
+
+// SubscriptionHealthInterval is how often an open subscription checks that the node is still reachable,
+// the websocket client reconnects on its own but never reports that it gave up
+var SubscriptionHealthInterval = 30 * time.Second
+
+// CosmosEventClient is the part of the CometBFT rpc client used for event subscriptions
+type CosmosEventClient interface {
+	Start() error
+	Stop() error
+	Status(ctx context.Context) (*rpctypes.ResultStatus, error)
+	Block(ctx context.Context, height *int64) (*rpctypes.ResultBlock, error)
+	Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (<-chan rpctypes.ResultEvent, error)
+	UnsubscribeAll(ctx context.Context, subscriber string) error
+}
+
+var rpchttpNewEventClient = func(url string) (CosmosEventClient, error) {
+	return rpchttp.New(url, "/websocket")
+}
+
+// SubscribeTxsSentToAddress hands every tx with a transfer to the address to handle as soon as it is committed.
+// It returns when ctx is done or the connection to the node is lost.
+func SubscribeTxsSentToAddress(ctx context.Context, config models.CosmosConfig, address string, handle func(tx *sdk.TxResponse)) error {
+	client, err := rpchttpNewEventClient(config.RPCURL)
+	if err != nil {
+		return fmt.Errorf("error creating websocket client: %w", err)
+	}
+	if err := client.Start(); err != nil {
+		return fmt.Errorf("error starting websocket client: %w", err)
+	}
+	//nolint:errcheck
+	defer client.Stop()
+
+	query := fmt.Sprintf("tm.event='Tx' AND transfer.recipient='%s'", address)
+	events, err := client.Subscribe(ctx, subscriberName, query, 100)
+	if err != nil {
+		return fmt.Errorf("error subscribing to txs: %w", err)
+	}
+	//nolint:errcheck
+	defer client.UnsubscribeAll(context.Background(), subscriberName)
+
+	ticker := time.NewTicker(SubscriptionHealthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := client.Status(ctx); err != nil {
+				return fmt.Errorf("connection lost: %w", err)
+			}
+		case event := <-events:
+			tx, err := eventTxResponse(ctx, client, config.Bech32Prefix, event)
+			if err != nil {
+				return err
+			}
+			if tx != nil {
+				handle(tx)
+			}
+		}
+	}
+}
+
+// eventTxResponse builds the tx response of a tx event the same way as the tx search results
+func eventTxResponse(ctx context.Context, client CosmosEventClient, bech32Prefix string, event rpctypes.ResultEvent) (*sdk.TxResponse, error) {
+	data, ok := event.Data.(ctypes.EventDataTx)
+	if !ok {
+		return nil, nil
+	}
+
+	p, err := decodeAnyTx(bech32Prefix, data.Tx)
+	if err != nil {
+		// txs with messages unknown to the codec cannot be transfers to the vault
+		return nil, nil
+	}
+
+	resBlock, err := client.Block(ctx, &data.Height)
+	if err != nil {
+		return nil, fmt.Errorf("error getting block %d: %w", data.Height, err)
+	}
+
+	resTx := &rpctypes.ResultTx{
+		Hash:     ctypes.Tx(data.Tx).Hash(),
+		Height:   data.Height,
+		Index:    data.Index,
+		TxResult: data.Result,
+		Tx:       data.Tx,
+	}
+	return sdk.NewResponseResultTx(resTx, p.AsAny(), resBlock.Block.Time.Format(time.RFC3339)), nil
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	clientMocks "github.com/dan13ram/wpokt-validator/cosmos/client/client_mocks"
+	"github.com/dan13ram/wpokt-validator/cosmos/util"
+	"github.com/dan13ram/wpokt-validator/models"
+)
+
+func withEventClient(t *testing.T, client CosmosEventClient) {
+	original := rpchttpNewEventClient
+	t.Cleanup(func() { rpchttpNewEventClient = original })
+	rpchttpNewEventClient = func(url string) (CosmosEventClient, error) {
+		assert.Equal(t, "http://localhost:26657", url)
+		return client, nil
+	}
+}
+
+func TestSubscribeTxsSentToAddress(t *testing.T) {
+	mockClient := clientMocks.NewMockCosmosEventClient(t)
+	withEventClient(t, mockClient)
+
+	anyTx := clientMocks.NewMockAnyTx(t)
+	anyTx.EXPECT().AsAny().Return(&codectypes.Any{})
+	utilNewTxDecoder = func(bech32Prefix string) sdk.TxDecoder {
+		return func(txBytes []byte) (sdk.Tx, error) {
+			if txBytes[0] == 1 {
+				return anyTx, nil
+			}
+			return nil, errors.New("unknown message")
+		}
+	}
+	defer func() {
+		utilNewTxDecoder = util.NewTxDecoder
+	}()
+
+	events := make(chan rpctypes.ResultEvent, 3)
+	events <- rpctypes.ResultEvent{Data: types.EventDataNewBlock{}}
+	events <- rpctypes.ResultEvent{Data: types.EventDataTx{TxResult: abci.TxResult{Height: 10, Tx: []byte{2}}}}
+	events <- rpctypes.ResultEvent{Data: types.EventDataTx{TxResult: abci.TxResult{Height: 10, Index: 1, Tx: []byte{1}}}}
+
+	height := int64(10)
+	mockClient.EXPECT().Start().Return(nil)
+	mockClient.EXPECT().Stop().Return(nil)
+	mockClient.EXPECT().Subscribe(mock.Anything, subscriberName, "tm.event='Tx' AND transfer.recipient='vault'", 100).Return(events, nil)
+	mockClient.EXPECT().UnsubscribeAll(mock.Anything, subscriberName).Return(nil)
+	mockClient.EXPECT().Block(mock.Anything, &height).Return(&rpctypes.ResultBlock{Block: &types.Block{Header: types.Header{Height: 10, Time: time.Now()}}}, nil).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var handled []*sdk.TxResponse
+	err := SubscribeTxsSentToAddress(ctx, models.CosmosConfig{RPCURL: "http://localhost:26657", Bech32Prefix: "pokt"}, "vault", func(tx *sdk.TxResponse) {
+		handled = append(handled, tx)
+		cancel()
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, handled, 1)
+	assert.Equal(t, int64(10), handled[0].Height)
+	assert.Equal(t, types.Tx{1}.Hash(), ethcommon.FromHex(handled[0].TxHash))
+}
+
+func TestSubscribeTxsSentToAddress_ConnectionLost(t *testing.T) {
+	mockClient := clientMocks.NewMockCosmosEventClient(t)
+	withEventClient(t, mockClient)
+
+	original := SubscriptionHealthInterval
+	SubscriptionHealthInterval = 10 * time.Millisecond
+	defer func() { SubscriptionHealthInterval = original }()
+
+	mockClient.EXPECT().Start().Return(nil)
+	mockClient.EXPECT().Stop().Return(nil)
+	mockClient.EXPECT().Subscribe(mock.Anything, subscriberName, mock.Anything, 100).Return(make(chan rpctypes.ResultEvent), nil)
+	mockClient.EXPECT().UnsubscribeAll(mock.Anything, subscriberName).Return(nil)
+	mockClient.EXPECT().Status(mock.Anything).Return(nil, errors.New("connection refused"))
+
+	err := SubscribeTxsSentToAddress(context.Background(), models.CosmosConfig{RPCURL: "http://localhost:26657"}, "vault", func(tx *sdk.TxResponse) {
+		t.Fatal("unexpected tx")
+	})
+
+	assert.ErrorContains(t, err, "connection lost")
+}
+
+func TestSubscribeTxsSentToAddress_SubscribeError(t *testing.T) {
+	mockClient := clientMocks.NewMockCosmosEventClient(t)
+	withEventClient(t, mockClient)
+
+	mockClient.EXPECT().Start().Return(nil)
+	mockClient.EXPECT().Stop().Return(nil)
+	mockClient.EXPECT().Subscribe(mock.Anything, subscriberName, mock.Anything, 100).Return(nil, errors.New("max subscriptions"))
+
+	err := SubscribeTxsSentToAddress(context.Background(), models.CosmosConfig{RPCURL: "http://localhost:26657"}, "vault", func(tx *sdk.TxResponse) {})
+
+	assert.ErrorContains(t, err, "error subscribing to txs")
+}
@@ -1,6 +1,7 @@
 package cosmos
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -33,12 +34,17 @@ type BurnExecutorRunner struct {
 	vaultAddress string
 }
 
-func (x *BurnExecutorRunner) Run() {
-	x.SyncTxs()
+func (x *BurnExecutorRunner) Run(ctx context.Context) error {
+	if !x.SyncTxs(ctx) {
+		return app.ErrSyncTxs
+	}
+	return nil
 }
 
 func (x *BurnExecutorRunner) Status() models.RunnerStatus {
-	return models.RunnerStatus{}
+	return models.RunnerStatus{
+		Endpoints: app.EndpointHealth(x.client),
+	}
 }
 
 func pubKeyExists(key crypto.PubKey, keys []crypto.PubKey) bool {
@@ -51,6 +57,7 @@ func pubKeyExists(key crypto.PubKey, keys []crypto.PubKey) bool {
 }
 
 func (x *BurnExecutorRunner) ValidateSignaturesAndAddMultiSignatureToTxConfig(
+	ctx context.Context,
 	originTxHash string,
 	sequence uint64,
 	txCfg client.TxConfig,
@@ -73,7 +80,7 @@ func (x *BurnExecutorRunner) ValidateSignaturesAndAddMultiSignatureToTxConfig(
 		return false
 	}
 
-	account, err := x.client.GetAccount(x.signer.MultisigAddress)
+	account, err := x.client.GetAccount(ctx, x.signer.MultisigAddress)
 
 	if err != nil {
 		logger.WithError(err).Error("Error getting account")
@@ -121,7 +128,7 @@ func (x *BurnExecutorRunner) ValidateSignaturesAndAddMultiSignatureToTxConfig(
 	return true
 }
 
-func (x *BurnExecutorRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
+func (x *BurnExecutorRunner) HandleInvalidMint(ctx context.Context, doc *models.InvalidMint) bool {
 
 	if doc == nil {
 		log.Error("[BURN EXECUTOR] Invalid mint is nil or has invalid status")
@@ -144,7 +151,7 @@ func (x *BurnExecutorRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 				return false
 			}
 
-			if !x.ValidateSignaturesAndAddMultiSignatureToTxConfig(doc.TransactionHash, *doc.Sequence, txCfg, txBuilder) {
+			if !x.ValidateSignaturesAndAddMultiSignatureToTxConfig(ctx, doc.TransactionHash, *doc.Sequence, txCfg, txBuilder) {
 				log.Error("[BURN EXECUTOR] Error validating signatures and adding multisig to tx config")
 				return false
 			}
@@ -161,7 +168,7 @@ func (x *BurnExecutorRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 				return false
 			}
 
-			txHash, err := x.client.BroadcastTx(txBytes)
+			txHash, err := x.client.BroadcastTx(ctx, txBytes)
 			if err != nil {
 				log.WithError(err).Errorf("Error broadcasting tx")
 				return false
@@ -184,7 +191,7 @@ func (x *BurnExecutorRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 	case models.StatusSubmitted:
 		{
 			log.Debug("[BURN EXECUTOR] Checking invalid mint")
-			tx, err := x.client.GetTx(doc.ReturnTransactionHash)
+			tx, err := x.client.GetTx(ctx, doc.ReturnTransactionHash)
 			if err != nil {
 				log.Error("[BURN EXECUTOR] Error fetching transaction: ", err)
 				return false
@@ -219,7 +226,13 @@ func (x *BurnExecutorRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 		}
 	}
 
-	if _, err := app.DB.UpdateOne(models.CollectionInvalidMints, filter, update); err != nil {
+	filter, update, err := app.Fence(ctx, filter, update, doc.FencingToken)
+	if err != nil {
+		log.Error("[BURN EXECUTOR] Lock lost before updating invalid mint: ", err)
+		return false
+	}
+
+	if _, err := app.DB.UpdateOne(ctx, models.CollectionInvalidMints, filter, update); err != nil {
 		log.Error("[BURN EXECUTOR] Error updating invalid mint: ", err)
 		return false
 	}
@@ -228,7 +241,7 @@ func (x *BurnExecutorRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 	return true
 }
 
-func (x *BurnExecutorRunner) HandleBurn(doc *models.Burn) bool {
+func (x *BurnExecutorRunner) HandleBurn(ctx context.Context, doc *models.Burn) bool {
 
 	if doc == nil {
 		log.Error("[BURN EXECUTOR] Burn is nil")
@@ -251,7 +264,7 @@ func (x *BurnExecutorRunner) HandleBurn(doc *models.Burn) bool {
 				return false
 			}
 
-			if !x.ValidateSignaturesAndAddMultiSignatureToTxConfig(doc.TransactionHash, *doc.Sequence, txCfg, txBuilder) {
+			if !x.ValidateSignaturesAndAddMultiSignatureToTxConfig(ctx, doc.TransactionHash, *doc.Sequence, txCfg, txBuilder) {
 				log.Error("[BURN EXECUTOR] Error validating signatures and adding multisig to tx config")
 				return false
 			}
@@ -268,7 +281,7 @@ func (x *BurnExecutorRunner) HandleBurn(doc *models.Burn) bool {
 				return false
 			}
 
-			txHash, err := x.client.BroadcastTx(txBytes)
+			txHash, err := x.client.BroadcastTx(ctx, txBytes)
 			if err != nil {
 				log.WithError(err).Errorf("Error broadcasting tx")
 				return false
@@ -291,7 +304,7 @@ func (x *BurnExecutorRunner) HandleBurn(doc *models.Burn) bool {
 	case models.StatusSubmitted:
 		{
 			log.Debug("[BURN EXECUTOR] Checking burn")
-			tx, err := x.client.GetTx(doc.ReturnTransactionHash)
+			tx, err := x.client.GetTx(ctx, doc.ReturnTransactionHash)
 			if err != nil {
 				log.Error("[BURN EXECUTOR] Error fetching transaction: ", err)
 				return false
@@ -326,7 +339,13 @@ func (x *BurnExecutorRunner) HandleBurn(doc *models.Burn) bool {
 		}
 	}
 
-	if _, err := app.DB.UpdateOne(models.CollectionBurns, filter, update); err != nil {
+	filter, update, err := app.Fence(ctx, filter, update, doc.FencingToken)
+	if err != nil {
+		log.Error("[BURN EXECUTOR] Lock lost before updating burn: ", err)
+		return false
+	}
+
+	if _, err := app.DB.UpdateOne(ctx, models.CollectionBurns, filter, update); err != nil {
 		log.Error("[BURN EXECUTOR] Error updating burn: ", err)
 		return false
 	}
@@ -335,7 +354,7 @@ func (x *BurnExecutorRunner) HandleBurn(doc *models.Burn) bool {
 	return true
 }
 
-func (x *BurnExecutorRunner) SyncInvalidMints() bool {
+func (x *BurnExecutorRunner) SyncInvalidMints(ctx context.Context) bool {
 	log.Debug("[BURN EXECUTOR] Syncing invalid mints")
 
 	filter := bson.M{
@@ -349,7 +368,7 @@ func (x *BurnExecutorRunner) SyncInvalidMints() bool {
 	}
 	invalidMints := []models.InvalidMint{}
 
-	err := app.DB.FindMany(models.CollectionInvalidMints, filter, &invalidMints)
+	err := app.DB.FindMany(ctx, models.CollectionInvalidMints, filter, &invalidMints)
 	if err != nil {
 		log.Error("[BURN EXECUTOR] Error fetching invalid mints: ", err)
 		return false
@@ -362,7 +381,7 @@ func (x *BurnExecutorRunner) SyncInvalidMints() bool {
 		doc := invalidMints[i]
 
 		resourceId := fmt.Sprintf("%s/%s", models.CollectionInvalidMints, doc.Id.Hex())
-		lockId, err := app.DB.XLock(resourceId)
+		lock, lockCtx, err := app.AcquireLock(ctx, resourceId)
 		if err != nil {
 			log.Error("[BURN EXECUTOR] Error locking invalid mint: ", err)
 			success = false
@@ -370,9 +389,9 @@ func (x *BurnExecutorRunner) SyncInvalidMints() bool {
 		}
 		log.Debug("[BURN EXECUTOR] Locked invalid mint: ", doc.TransactionHash)
 
-		success = x.HandleInvalidMint(&doc) && success
+		success = x.HandleInvalidMint(lockCtx, &doc) && success
 
-		if err := app.DB.Unlock(lockId); err != nil {
+		if err := lock.Release(ctx); err != nil {
 			log.Error("[BURN EXECUTOR] Error unlocking invalid mint: ", err)
 			success = false
 		} else {
@@ -385,7 +404,7 @@ func (x *BurnExecutorRunner) SyncInvalidMints() bool {
 	return success
 }
 
-func (x *BurnExecutorRunner) SyncBurns() bool {
+func (x *BurnExecutorRunner) SyncBurns(ctx context.Context) bool {
 	log.Debug("[BURN EXECUTOR] Syncing burns")
 
 	filter := bson.M{
@@ -399,7 +418,7 @@ func (x *BurnExecutorRunner) SyncBurns() bool {
 	}
 	burns := []models.Burn{}
 
-	err := app.DB.FindMany(models.CollectionBurns, filter, &burns)
+	err := app.DB.FindMany(ctx, models.CollectionBurns, filter, &burns)
 	if err != nil {
 		log.Error("[BURN EXECUTOR] Error fetching burns: ", err)
 		return false
@@ -413,7 +432,7 @@ func (x *BurnExecutorRunner) SyncBurns() bool {
 		doc := burns[i]
 
 		resourceId := fmt.Sprintf("%s/%s", models.CollectionBurns, doc.Id.Hex())
-		lockId, err := app.DB.XLock(resourceId)
+		lock, lockCtx, err := app.AcquireLock(ctx, resourceId)
 		if err != nil {
 			log.Error("[BURN EXECUTOR] Error locking burn: ", err)
 			success = false
@@ -421,9 +440,9 @@ func (x *BurnExecutorRunner) SyncBurns() bool {
 		}
 		log.Debugln("[BURN EXECUTOR] Locked burn:", doc.TransactionHash, doc.LogIndex)
 
-		success = x.HandleBurn(&doc) && success
+		success = x.HandleBurn(lockCtx, &doc) && success
 
-		if err := app.DB.Unlock(lockId); err != nil {
+		if err := lock.Release(ctx); err != nil {
 			log.Error("[BURN EXECUTOR] Error unlocking burn: ", err)
 			success = false
 		} else {
@@ -436,11 +455,11 @@ func (x *BurnExecutorRunner) SyncBurns() bool {
 	return success
 }
 
-func (x *BurnExecutorRunner) SyncTxs() bool {
+func (x *BurnExecutorRunner) SyncTxs(ctx context.Context) bool {
 	log.Debug("[BURN EXECUTOR] Syncing")
 
-	success := x.SyncInvalidMints()
-	success = x.SyncBurns() && success
+	success := x.SyncInvalidMints(ctx)
+	success = x.SyncBurns(ctx) && success
 
 	log.Info("[BURN EXECUTOR] Synced txs")
 	return success
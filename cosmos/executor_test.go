@@ -2,6 +2,7 @@ package cosmos
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -112,7 +113,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestBurnExecutor(t, mockClient)
 
-		success := x.HandleInvalidMint(nil)
+		success := x.HandleInvalidMint(context.Background(), nil)
 
 		assert.False(t, success)
 	})
@@ -138,7 +139,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			utilWrapTxBuilder = util.WrapTxBuilder
 		}()
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -170,7 +171,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 		txBuilder.EXPECT().GetTx().Return(tx)
 		tx.EXPECT().GetSignaturesV2().Return([]signingtypes.SignatureV2{{}}, assert.AnError)
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -202,7 +203,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 		txBuilder.EXPECT().GetTx().Return(tx)
 		tx.EXPECT().GetSignaturesV2().Return([]signingtypes.SignatureV2{{}}, nil)
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -234,9 +235,9 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 		txBuilder.EXPECT().GetTx().Return(tx)
 		tx.EXPECT().GetSignaturesV2().Return([]signingtypes.SignatureV2{{}, {}}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, assert.AnError)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, assert.AnError)
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -279,9 +280,9 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -324,9 +325,9 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -369,11 +370,11 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 		txBuilder.EXPECT().SetSignatures(mock.Anything).Return(assert.AnError)
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -416,7 +417,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 		txBuilder.EXPECT().SetSignatures(mock.Anything).Return(nil)
 
@@ -426,7 +427,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			return txJSON, assert.AnError
 		})
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -469,7 +470,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 		txBuilder.EXPECT().SetSignatures(mock.Anything).Return(nil)
 
@@ -484,7 +485,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			return txBytes, assert.AnError
 		})
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -527,7 +528,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 		txBuilder.EXPECT().SetSignatures(mock.Anything).Return(nil)
 
@@ -544,9 +545,9 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 
 		txHash := "0xHash"
 
-		mockClient.EXPECT().BroadcastTx(txBytes).Return(txHash, assert.AnError)
+		mockClient.EXPECT().BroadcastTx(mock.Anything, txBytes).Return(txHash, assert.AnError)
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -589,7 +590,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 		txBuilder.EXPECT().SetSignatures(mock.Anything).Return(nil)
 
@@ -606,11 +607,11 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 
 		txHash := "0xHash"
 
-		mockClient.EXPECT().BroadcastTx(txBytes).Return(txHash, nil)
+		mockClient.EXPECT().BroadcastTx(mock.Anything, txBytes).Return(txHash, nil)
 
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), assert.AnError)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), assert.AnError)
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -653,7 +654,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 		txBuilder.EXPECT().SetSignatures(mock.Anything).Return(nil)
 
@@ -670,7 +671,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 
 		txHash := "0xhash"
 
-		mockClient.EXPECT().BroadcastTx(txBytes).Return(txHash, nil)
+		mockClient.EXPECT().BroadcastTx(mock.Anything, txBytes).Return(txHash, nil)
 
 		filter := bson.M{
 			"_id":    doc.Id,
@@ -688,12 +689,12 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Run(func(_collection string, _filter interface{}, _update interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, filter, mock.Anything).Run(func(_ context.Context, _collection string, _filter interface{}, _update interface{}) {
 			_update.(bson.M)["$set"].(bson.M)["updated_at"] = date
 			assert.Equal(t, update, _update)
 		}).Return(primitive.NewObjectID(), nil)
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.True(t, success)
 	})
@@ -707,9 +708,9 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			Status: models.StatusSubmitted,
 		}
 
-		mockClient.EXPECT().GetTx("").Return(nil, assert.AnError)
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(nil, assert.AnError)
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -728,7 +729,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			Code: 10,
 		}
 
-		mockClient.EXPECT().GetTx("").Return(tx, nil)
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		filter := bson.M{
 			"_id":    doc.Id,
@@ -746,13 +747,13 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(collection string, filter, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, collection string, filter, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.True(t, success)
 	})
@@ -771,7 +772,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			Code: 0,
 		}
 
-		mockClient.EXPECT().GetTx("").Return(tx, nil)
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		filter := bson.M{
 			"_id":    doc.Id,
@@ -785,13 +786,13 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), assert.AnError).
-			Run(func(collection string, filter, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), assert.AnError).
+			Run(func(_ context.Context, collection string, filter, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -810,7 +811,7 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			Code: 0,
 		}
 
-		mockClient.EXPECT().GetTx("").Return(tx, nil)
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		filter := bson.M{
 			"_id":    doc.Id,
@@ -824,13 +825,13 @@ func TestBurnExecutorHandleInvalidMint(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(collection string, filter, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, collection string, filter, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(doc)
+		success := x.HandleInvalidMint(context.Background(), doc)
 
 		assert.True(t, success)
 
@@ -846,7 +847,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestBurnExecutor(t, mockClient)
 
-		success := x.HandleBurn(nil)
+		success := x.HandleBurn(context.Background(), nil)
 
 		assert.False(t, success)
 	})
@@ -872,7 +873,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			utilWrapTxBuilder = util.WrapTxBuilder
 		}()
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -904,7 +905,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 		txBuilder.EXPECT().GetTx().Return(tx)
 		tx.EXPECT().GetSignaturesV2().Return([]signingtypes.SignatureV2{{}}, assert.AnError)
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -936,7 +937,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 		txBuilder.EXPECT().GetTx().Return(tx)
 		tx.EXPECT().GetSignaturesV2().Return([]signingtypes.SignatureV2{{}}, nil)
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -968,9 +969,9 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 		txBuilder.EXPECT().GetTx().Return(tx)
 		tx.EXPECT().GetSignaturesV2().Return([]signingtypes.SignatureV2{{}, {}}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, assert.AnError)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, assert.AnError)
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -1013,9 +1014,9 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -1058,9 +1059,9 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -1103,11 +1104,11 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 		txBuilder.EXPECT().SetSignatures(mock.Anything).Return(assert.AnError)
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -1150,7 +1151,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 		txBuilder.EXPECT().SetSignatures(mock.Anything).Return(nil)
 
@@ -1160,7 +1161,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			return txJSON, assert.AnError
 		})
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -1203,7 +1204,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 		txBuilder.EXPECT().SetSignatures(mock.Anything).Return(nil)
 
@@ -1218,7 +1219,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			return txBytes, assert.AnError
 		})
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -1261,7 +1262,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 		txBuilder.EXPECT().SetSignatures(mock.Anything).Return(nil)
 
@@ -1278,9 +1279,9 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 
 		txHash := "0xHash"
 
-		mockClient.EXPECT().BroadcastTx(txBytes).Return(txHash, assert.AnError)
+		mockClient.EXPECT().BroadcastTx(mock.Anything, txBytes).Return(txHash, assert.AnError)
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -1323,7 +1324,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 		txBuilder.EXPECT().SetSignatures(mock.Anything).Return(nil)
 
@@ -1340,11 +1341,11 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 
 		txHash := "0xHash"
 
-		mockClient.EXPECT().BroadcastTx(txBytes).Return(txHash, nil)
+		mockClient.EXPECT().BroadcastTx(mock.Anything, txBytes).Return(txHash, nil)
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), assert.AnError)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), assert.AnError)
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -1387,7 +1388,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			{PubKey: pubKey2},
 		}, nil)
 
-		mockClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+		mockClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 		txBuilder.EXPECT().SetSignatures(mock.Anything).Return(nil)
 
@@ -1404,7 +1405,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 
 		txHash := "0xhash"
 
-		mockClient.EXPECT().BroadcastTx(txBytes).Return(txHash, nil)
+		mockClient.EXPECT().BroadcastTx(mock.Anything, txBytes).Return(txHash, nil)
 
 		filter := bson.M{
 			"_id":    doc.Id,
@@ -1422,12 +1423,12 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filter, mock.Anything).Run(func(_collection string, _filter interface{}, _update interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, mock.Anything).Run(func(_ context.Context, _collection string, _filter interface{}, _update interface{}) {
 			_update.(bson.M)["$set"].(bson.M)["updated_at"] = date
 			assert.Equal(t, update, _update)
 		}).Return(primitive.NewObjectID(), nil)
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.True(t, success)
 	})
@@ -1441,9 +1442,9 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			Status: models.StatusSubmitted,
 		}
 
-		mockClient.EXPECT().GetTx("").Return(nil, assert.AnError)
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(nil, assert.AnError)
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -1462,7 +1463,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			Code: 10,
 		}
 
-		mockClient.EXPECT().GetTx("").Return(tx, nil)
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		filter := bson.M{
 			"_id":    doc.Id,
@@ -1480,13 +1481,13 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(collection string, filter, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, collection string, filter, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.True(t, success)
 	})
@@ -1505,7 +1506,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			Code: 0,
 		}
 
-		mockClient.EXPECT().GetTx("").Return(tx, nil)
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		filter := bson.M{
 			"_id":    doc.Id,
@@ -1519,13 +1520,13 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), assert.AnError).
-			Run(func(collection string, filter, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), assert.AnError).
+			Run(func(_ context.Context, collection string, filter, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.False(t, success)
 	})
@@ -1544,7 +1545,7 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			Code: 0,
 		}
 
-		mockClient.EXPECT().GetTx("").Return(tx, nil)
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		filter := bson.M{
 			"_id":    doc.Id,
@@ -1558,13 +1559,13 @@ func TestBurnExecutorHandleBurn(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(collection string, filter, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, collection string, filter, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(doc)
+		success := x.HandleBurn(context.Background(), doc)
 
 		assert.True(t, success)
 
@@ -1580,9 +1581,9 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestBurnExecutor(t, mockClient)
 
-		mockDB.EXPECT().FindMany(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"))
+		mockDB.EXPECT().FindMany(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"))
 
-		success := x.SyncInvalidMints()
+		success := x.SyncInvalidMints(context.Background())
 
 		assert.False(t, success)
 
@@ -1604,9 +1605,9 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 			"vault_address": x.vaultAddress,
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filter, mock.Anything).Return(nil)
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionInvalidMints, filter, mock.Anything).Return(nil)
 
-		success := x.SyncInvalidMints()
+		success := x.SyncInvalidMints(context.Background())
 
 		assert.True(t, success)
 	})
@@ -1627,8 +1628,8 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 			"vault_address": x.vaultAddress,
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.InvalidMint)
 				*v = []models.InvalidMint{
 					{
@@ -1637,8 +1638,8 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", errors.New("error"))
-		success := x.SyncInvalidMints()
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", errors.New("error"))
+		success := x.SyncInvalidMints(context.Background())
 
 		assert.False(t, success)
 
@@ -1665,19 +1666,20 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 			Status: models.StatusSubmitted,
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.InvalidMint)
 				*v = []models.InvalidMint{
 					*doc,
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
 
 		tx := &sdk.TxResponse{}
 
-		mockClient.EXPECT().GetTx("").Return(tx, nil)
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		filterUpdate := bson.M{
 			"_id":    doc.Id,
@@ -1686,20 +1688,21 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 
 		update := bson.M{
 			"$set": bson.M{
-				"status":     models.StatusSuccess,
-				"updated_at": time.Now(),
+				"status":        models.StatusSuccess,
+				"updated_at":    time.Now(),
+				"fencing_token": int64(1),
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filterUpdate, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(collection string, filter, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, fenced(filterUpdate), mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, collection string, filter, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		mockDB.EXPECT().Unlock("lockId").Return(errors.New("error"))
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(errors.New("error"))
 
-		success := x.SyncInvalidMints()
+		success := x.SyncInvalidMints(context.Background())
 
 		assert.False(t, success)
 	})
@@ -1725,19 +1728,20 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 			Status: models.StatusSubmitted,
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.InvalidMint)
 				*v = []models.InvalidMint{
 					*doc,
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
 
 		tx := &sdk.TxResponse{}
 
-		mockClient.EXPECT().GetTx("").Return(tx, nil)
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		filterUpdate := bson.M{
 			"_id":    doc.Id,
@@ -1746,20 +1750,21 @@ func TestBurnExecutorSyncInvalidMints(t *testing.T) {
 
 		update := bson.M{
 			"$set": bson.M{
-				"status":     models.StatusSuccess,
-				"updated_at": time.Now(),
+				"status":        models.StatusSuccess,
+				"updated_at":    time.Now(),
+				"fencing_token": int64(1),
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filterUpdate, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(collection string, filter, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, fenced(filterUpdate), mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, collection string, filter, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		mockDB.EXPECT().Unlock("lockId").Return(nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
 
-		success := x.SyncInvalidMints()
+		success := x.SyncInvalidMints(context.Background())
 
 		assert.True(t, success)
 	})
@@ -1774,9 +1779,9 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestBurnExecutor(t, mockClient)
 
-		mockDB.EXPECT().FindMany(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"))
+		mockDB.EXPECT().FindMany(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"))
 
-		success := x.SyncBurns()
+		success := x.SyncBurns(context.Background())
 
 		assert.False(t, success)
 
@@ -1798,9 +1803,9 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 			"wpokt_address": x.wpoktAddress,
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionBurns, filter, mock.Anything).Return(nil)
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, filter, mock.Anything).Return(nil)
 
-		success := x.SyncBurns()
+		success := x.SyncBurns(context.Background())
 
 		assert.True(t, success)
 	})
@@ -1821,8 +1826,8 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 			"wpokt_address": x.wpoktAddress,
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionBurns, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.Burn)
 				*v = []models.Burn{
 					{
@@ -1831,8 +1836,8 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", errors.New("error"))
-		success := x.SyncBurns()
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", errors.New("error"))
+		success := x.SyncBurns(context.Background())
 
 		assert.False(t, success)
 
@@ -1859,19 +1864,20 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 			Status: models.StatusSubmitted,
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionBurns, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.Burn)
 				*v = []models.Burn{
 					*doc,
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
 
 		tx := &sdk.TxResponse{}
 
-		mockClient.EXPECT().GetTx("").Return(tx, nil)
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		filterUpdate := bson.M{
 			"_id":    doc.Id,
@@ -1880,20 +1886,21 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 
 		update := bson.M{
 			"$set": bson.M{
-				"status":     models.StatusSuccess,
-				"updated_at": time.Now(),
+				"status":        models.StatusSuccess,
+				"updated_at":    time.Now(),
+				"fencing_token": int64(1),
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filterUpdate, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(collection string, filter, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, fenced(filterUpdate), mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, collection string, filter, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		mockDB.EXPECT().Unlock("lockId").Return(errors.New("error"))
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(errors.New("error"))
 
-		success := x.SyncBurns()
+		success := x.SyncBurns(context.Background())
 
 		assert.False(t, success)
 	})
@@ -1919,19 +1926,20 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 			Status: models.StatusSubmitted,
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionBurns, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.Burn)
 				*v = []models.Burn{
 					*doc,
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
 
 		tx := &sdk.TxResponse{}
 
-		mockClient.EXPECT().GetTx("").Return(tx, nil)
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		filterUpdate := bson.M{
 			"_id":    doc.Id,
@@ -1940,20 +1948,21 @@ func TestBurnExecutorSyncBurns(t *testing.T) {
 
 		update := bson.M{
 			"$set": bson.M{
-				"status":     models.StatusSuccess,
-				"updated_at": time.Now(),
+				"status":        models.StatusSuccess,
+				"updated_at":    time.Now(),
+				"fencing_token": int64(1),
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filterUpdate, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(collection string, filter, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, fenced(filterUpdate), mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, collection string, filter, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		mockDB.EXPECT().Unlock("lockId").Return(nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
 
-		success := x.SyncBurns()
+		success := x.SyncBurns(context.Background())
 
 		assert.True(t, success)
 	})
@@ -1983,19 +1992,20 @@ func TestBurnExecutorRun(t *testing.T) {
 			Status: models.StatusSubmitted,
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.InvalidMint)
 				*v = []models.InvalidMint{
 					*doc,
 				}
 			}).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil).Once()
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil).Once()
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil).Once()
 
 		tx := &sdk.TxResponse{}
 
-		mockClient.EXPECT().GetTx("").Return(tx, nil).Once()
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil).Once()
 
 		filterUpdate := bson.M{
 			"_id":    doc.Id,
@@ -2004,18 +2014,19 @@ func TestBurnExecutorRun(t *testing.T) {
 
 		update := bson.M{
 			"$set": bson.M{
-				"status":     models.StatusSuccess,
-				"updated_at": time.Now(),
+				"status":        models.StatusSuccess,
+				"updated_at":    time.Now(),
+				"fencing_token": int64(1),
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filterUpdate, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(collection string, filter, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, fenced(filterUpdate), mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, collection string, filter, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		mockDB.EXPECT().Unlock("lockId").Return(nil).Once()
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil).Once()
 	}
 
 	{
@@ -2034,19 +2045,20 @@ func TestBurnExecutorRun(t *testing.T) {
 			Status: models.StatusSubmitted,
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionBurns, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.Burn)
 				*v = []models.Burn{
 					*doc,
 				}
 			}).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil).Once()
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil).Once()
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil).Once()
 
 		tx := &sdk.TxResponse{}
 
-		mockClient.EXPECT().GetTx("").Return(tx, nil).Once()
+		mockClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil).Once()
 
 		filterUpdate := bson.M{
 			"_id":    doc.Id,
@@ -2055,21 +2067,24 @@ func TestBurnExecutorRun(t *testing.T) {
 
 		update := bson.M{
 			"$set": bson.M{
-				"status":     models.StatusSuccess,
-				"updated_at": time.Now(),
+				"status":        models.StatusSuccess,
+				"updated_at":    time.Now(),
+				"fencing_token": int64(1),
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filterUpdate, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(collection string, filter, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, fenced(filterUpdate), mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, collection string, filter, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		mockDB.EXPECT().Unlock("lockId").Return(nil).Once()
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil).Once()
 	}
 
-	x.Run()
+	err := x.Run(context.Background())
+
+	assert.Nil(t, err)
 
 }
 
@@ -0,0 +1,322 @@
+package cosmos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/common"
+	"github.com/dan13ram/wpokt-validator/cosmos/util"
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// returnTransaction holds the fields of a burn or invalid mint that make up its signed return transaction
+type returnTransaction struct {
+	Status     string
+	Body       string
+	Signatures []models.Signature
+	Sequence   *uint64
+	// FencingToken is the token the local document was read with, the peer token is not used
+	FencingToken *int64
+}
+
+var (
+	appFetchPeerInvalidMints = app.FetchPeerDocuments[models.InvalidMint]
+	appFetchPeerBurns        = app.FetchPeerDocuments[models.Burn]
+)
+
+// SyncPeerSignatures merges the return transaction signatures of the gossip peers into the local database
+func (x *BurnSignerRunner) SyncPeerSignatures(ctx context.Context) error {
+	log.Debug("[BURN SIGNER] Syncing peer signatures")
+
+	var errs error
+	for _, peer := range app.Config.Gossip.Peers {
+		peerInvalidMints, err := appFetchPeerInvalidMints(ctx, peer, app.GossipPathInvalidMints)
+		if err != nil {
+			log.Error("[BURN SIGNER] Error fetching peer invalid mints: ", err)
+			errs = errors.Join(errs, err)
+			continue
+		}
+		for i := range peerInvalidMints {
+			if err := x.MergePeerInvalidMint(ctx, &peerInvalidMints[i]); err != nil {
+				log.Error("[BURN SIGNER] Error merging peer invalid mint: ", err)
+				errs = errors.Join(errs, err)
+			}
+		}
+
+		peerBurns, err := appFetchPeerBurns(ctx, peer, app.GossipPathBurns)
+		if err != nil {
+			log.Error("[BURN SIGNER] Error fetching peer burns: ", err)
+			errs = errors.Join(errs, err)
+			continue
+		}
+		for i := range peerBurns {
+			if err := x.MergePeerBurn(ctx, &peerBurns[i]); err != nil {
+				log.Error("[BURN SIGNER] Error merging peer burn: ", err)
+				errs = errors.Join(errs, err)
+			}
+		}
+	}
+
+	log.Debug("[BURN SIGNER] Synced peer signatures")
+	return errs
+}
+
+// MergePeerInvalidMint adds the valid signatures of a peer invalid mint to the local invalid mint with the same transaction hash
+func (x *BurnSignerRunner) MergePeerInvalidMint(ctx context.Context, peerDoc *models.InvalidMint) error {
+	var doc models.InvalidMint
+	filter := bson.M{
+		"transaction_hash": peerDoc.TransactionHash,
+		"vault_address":    x.vaultAddress,
+	}
+	if err := app.DB.FindOne(ctx, models.CollectionInvalidMints, filter, &doc); err != nil {
+		// the mint monitor has not seen this transaction yet
+		log.Debug("[BURN SIGNER] Peer invalid mint not found locally: ", peerDoc.TransactionHash)
+		return nil
+	}
+
+	toAddress, err := common.AddressBytesFromBech32(app.Config.Pocket.Bech32Prefix, doc.SenderAddress)
+	if err != nil {
+		return fmt.Errorf("error parsing to address: %w", err)
+	}
+	amount, _ := math.NewIntFromString(doc.Amount)
+
+	return x.mergePeerReturnTransaction(
+		ctx,
+		models.CollectionInvalidMints,
+		doc.Id,
+		doc.TransactionHash,
+		returnTransaction{Status: doc.Status, Body: doc.ReturnTransactionBody, Signatures: doc.Signatures, Sequence: doc.Sequence, FencingToken: doc.FencingToken},
+		returnTransaction{Status: peerDoc.Status, Body: peerDoc.ReturnTransactionBody, Signatures: peerDoc.Signatures, Sequence: peerDoc.Sequence},
+		toAddress,
+		sdk.NewCoin(app.Config.Pocket.CoinDenom, amount),
+		"InvalidMint: "+doc.TransactionHash,
+	)
+}
+
+// MergePeerBurn adds the valid signatures of a peer burn to the local burn with the same transaction hash and log index
+func (x *BurnSignerRunner) MergePeerBurn(ctx context.Context, peerDoc *models.Burn) error {
+	var doc models.Burn
+	filter := bson.M{
+		"transaction_hash": peerDoc.TransactionHash,
+		"log_index":        peerDoc.LogIndex,
+		"wpokt_address":    x.wpoktAddress,
+	}
+	if err := app.DB.FindOne(ctx, models.CollectionBurns, filter, &doc); err != nil {
+		// the burn monitor has not seen this transaction yet
+		log.Debug("[BURN SIGNER] Peer burn not found locally: ", peerDoc.TransactionHash)
+		return nil
+	}
+
+	toAddress, err := common.AddressBytesFromBech32(app.Config.Pocket.Bech32Prefix, doc.RecipientAddress)
+	if err != nil {
+		return fmt.Errorf("error parsing to address: %w", err)
+	}
+	amount, _ := math.NewIntFromString(doc.Amount)
+
+	return x.mergePeerReturnTransaction(
+		ctx,
+		models.CollectionBurns,
+		doc.Id,
+		doc.TransactionHash,
+		returnTransaction{Status: doc.Status, Body: doc.ReturnTransactionBody, Signatures: doc.Signatures, Sequence: doc.Sequence, FencingToken: doc.FencingToken},
+		returnTransaction{Status: peerDoc.Status, Body: peerDoc.ReturnTransactionBody, Signatures: peerDoc.Signatures, Sequence: peerDoc.Sequence},
+		toAddress,
+		sdk.NewCoin(app.Config.Pocket.CoinDenom, amount),
+		"Burn: "+doc.TransactionHash,
+	)
+}
+
+func (x *BurnSignerRunner) mergePeerReturnTransaction(
+	ctx context.Context,
+	collection string,
+	id *primitive.ObjectID,
+	transactionHash string,
+	local returnTransaction,
+	peer returnTransaction,
+	toAddress []byte,
+	amount sdk.Coin,
+	memo string,
+) error {
+	if peer.Body == "" || peer.Sequence == nil || len(peer.Signatures) == 0 {
+		return nil
+	}
+	if local.Status != models.StatusPending && local.Status != models.StatusConfirmed && local.Status != models.StatusSigned {
+		return nil
+	}
+	// validators converge on the lowest sequence, the local signatures are dropped when the peer used a lower one
+	adopt := local.Body != "" && (local.Sequence == nil || *local.Sequence != *peer.Sequence)
+	if adopt {
+		if local.Sequence != nil && *local.Sequence < *peer.Sequence {
+			log.Debug("[BURN SIGNER] Peer return transaction uses a higher sequence: ", transactionHash)
+			return nil
+		}
+		if local.Status == models.StatusSigned {
+			// the local return transaction may already be broadcast
+			log.Warn("[BURN SIGNER] Peer return transaction uses a lower sequence than the signed local transaction: ", transactionHash)
+			return nil
+		}
+		log.Info("[BURN SIGNER] Adopting the lower sequence of the peer return transaction: ", transactionHash)
+	}
+
+	config := app.Config.Pocket
+	multisigAddressBytes, err := common.AddressBytesFromBech32(config.Bech32Prefix, config.MultisigAddress)
+	if err != nil {
+		return fmt.Errorf("error parsing multisig address: %w", err)
+	}
+
+	expected, err := util.NewSendTx(
+		config.Bech32Prefix,
+		multisigAddressBytes,
+		toAddress,
+		amount,
+		memo,
+		sdk.NewCoin(config.CoinDenom, math.NewIntFromUint64(uint64(config.TxFee))),
+	)
+	if err != nil {
+		return fmt.Errorf("error creating tx body: %w", err)
+	}
+
+	if equal, err := util.CompareTxBodies(config.Bech32Prefix, expected, peer.Body); err != nil || !equal {
+		log.Warn("[BURN SIGNER] Peer return transaction does not match local transaction: ", transactionHash)
+		return nil
+	}
+
+	body := local.Body
+	if body == "" || adopt {
+		body = expected
+	}
+	txBuilder, txConfig, err := util.WrapTxBuilder(config.Bech32Prefix, body)
+	if err != nil {
+		return fmt.Errorf("error wrapping tx builder: %w", err)
+	}
+	sigV2s, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return fmt.Errorf("error getting signatures: %w", err)
+	}
+
+	peerTxBuilder, _, err := util.WrapTxBuilder(config.Bech32Prefix, peer.Body)
+	if err != nil {
+		return fmt.Errorf("error wrapping peer tx builder: %w", err)
+	}
+	peerSigV2s, err := peerTxBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return fmt.Errorf("error getting peer signatures: %w", err)
+	}
+
+	var accountNumber *uint64
+	merged := len(sigV2s)
+	for i := range peerSigV2s {
+		sig := peerSigV2s[i]
+		if hasSignatureFrom(sigV2s, sig) {
+			continue
+		}
+		if !x.isMultisigMember(sig.PubKey.Address().Bytes()) {
+			log.Warn("[BURN SIGNER] Peer return transaction signer is not a multisig member: ", transactionHash)
+			continue
+		}
+		if accountNumber == nil {
+			account, err := x.cosmosClient.GetAccount(ctx, config.MultisigAddress)
+			if err != nil {
+				return fmt.Errorf("error getting account: %w", err)
+			}
+			number := account.AccountNumber
+			accountNumber = &number
+		}
+		if err := utilValidateSignature(config, &sig, *accountNumber, *peer.Sequence, txConfig, txBuilder); err != nil {
+			log.Warn("[BURN SIGNER] Invalid peer return transaction signature: ", err)
+			continue
+		}
+		sigV2s = append(sigV2s, sig)
+	}
+
+	if len(sigV2s) == merged {
+		return nil
+	}
+
+	if err := txBuilder.SetSignatures(sigV2s...); err != nil {
+		return fmt.Errorf("error setting signatures: %w", err)
+	}
+	txBody, err := txConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return fmt.Errorf("error encoding tx: %w", err)
+	}
+
+	signatures := []models.Signature{}
+	for _, sig := range sigV2s {
+		signer, _ := common.AddressHexFromBytes(sig.PubKey.Address().Bytes())
+		signatures = append(signatures, models.Signature{
+			Signer:    signer,
+			Signature: common.HexFromBytes(sig.Data.(*signingtypes.SingleSignatureData).Signature),
+		})
+	}
+
+	status := local.Status
+	if len(signatures) >= int(config.MultisigThreshold) {
+		status = models.StatusSigned
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"return_transaction_body": string(txBody),
+			"signatures":              signatures,
+			"sequence":                peer.Sequence,
+			"status":                  status,
+			"updated_at":              time.Now(),
+		},
+	}
+	// only update the version of the document the signatures were merged into
+	filter := bson.M{
+		"_id":        id,
+		"status":     local.Status,
+		"signatures": local.Signatures,
+	}
+
+	lock, lockCtx, err := app.AcquireLock(ctx, fmt.Sprintf("%s/%s", collection, id.Hex()))
+	if err != nil {
+		return fmt.Errorf("error locking %s: %w", collection, err)
+	}
+	defer func() {
+		if err := lock.Release(ctx); err != nil {
+			log.Error("[BURN SIGNER] Error unlocking ", collection, ": ", err)
+		}
+	}()
+
+	updateCtx := lockCtx
+	if local.Sequence == nil || adopt {
+		sequenceCtx, release, err := LockWriteSequence(lockCtx)
+		if err != nil {
+			return fmt.Errorf("error locking sequence: %w", err)
+		}
+		defer release()
+		updateCtx = sequenceCtx
+	}
+
+	filter, update, err = app.Fence(updateCtx, filter, update, local.FencingToken)
+	if err != nil {
+		return fmt.Errorf("lock lost before updating %s: %w", collection, err)
+	}
+
+	if err := updateWithSequence(updateCtx, collection, id, filter, update); err != nil {
+		return fmt.Errorf("error updating %s: %w", collection, err)
+	}
+	log.Info("[BURN SIGNER] Merged peer signatures into ", collection, ": ", transactionHash)
+	return nil
+}
+
+func hasSignatureFrom(sigV2s []signingtypes.SignatureV2, sig signingtypes.SignatureV2) bool {
+	for _, s := range sigV2s {
+		if s.PubKey.Equals(sig.PubKey) {
+			return true
+		}
+	}
+	return false
+}
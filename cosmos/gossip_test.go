@@ -0,0 +1,265 @@
+package cosmos
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/dan13ram/wpokt-validator/app"
+	appMocks "github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/common"
+	cosmosMocks "github.com/dan13ram/wpokt-validator/cosmos/client/mocks"
+	"github.com/dan13ram/wpokt-validator/cosmos/util"
+	ethMocks "github.com/dan13ram/wpokt-validator/eth/client/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBurnSignerMergePeerBurn(t *testing.T) {
+	// earlier tests leave the signing helpers mocked
+	oldUtilNewSendTx, oldUtilWrapTxBuilder, oldUtilSignWithPrivKey, oldUtilValidateSignature := utilNewSendTx, utilWrapTxBuilder, utilSignWithPrivKey, utilValidateSignature
+	defer func() {
+		utilNewSendTx, utilWrapTxBuilder, utilSignWithPrivKey, utilValidateSignature = oldUtilNewSendTx, oldUtilWrapTxBuilder, oldUtilSignWithPrivKey, oldUtilValidateSignature
+	}()
+	utilNewSendTx, utilWrapTxBuilder, utilSignWithPrivKey, utilValidateSignature = util.NewSendTx, util.WrapTxBuilder, util.SignWithPrivKey, util.ValidateSignature
+	oldLockWriteSequence := LockWriteSequence
+	defer func() { LockWriteSequence = oldLockWriteSequence }()
+	LockWriteSequence = lockWriteSequence
+
+	setup := func(t *testing.T) (*BurnSignerRunner, *cosmosMocks.MockCosmosClient, *appMocks.MockDatabase) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		expectTransactions(mockDB)
+		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+		x := NewTestBurnSigner(t, ethMocks.NewMockWrappedPocketContract(t), ethMocks.NewMockMintControllerContract(t), ethMocks.NewMockEthereumClient(t), mockCosmosClient)
+		app.Config.Pocket.ChainID = "testnet"
+		app.Config.Pocket.CoinDenom = "upokt"
+		mockCosmosClient.EXPECT().GetAccount(mock.Anything, app.Config.Pocket.MultisigAddress).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil).Maybe()
+		return x, mockCosmosClient, mockDB
+	}
+
+	recipient, _ := common.Bech32FromBytes("pokt", common.HexToAddress("0x2345").Bytes())
+	toAddress := common.HexToAddress("0x2345").Bytes()
+	amount := sdk.NewCoin("upokt", math.NewInt(20000))
+	sequence := uint64(1)
+
+	peerBurn := func(t *testing.T, x *BurnSignerRunner, signer common.Signer, memo string) *models.Burn {
+		txBody, signatures, err := SignTx(context.Background(), signer, app.Config.Pocket, x.cosmosClient, sequence, nil, "", toAddress, amount, memo)
+		assert.NoError(t, err)
+		return &models.Burn{
+			TransactionHash:       "hash",
+			LogIndex:              "0",
+			Status:                models.StatusConfirmed,
+			ReturnTransactionBody: txBody,
+			Signatures:            signatures,
+			Sequence:              &sequence,
+		}
+	}
+
+	expectLocalBurn := func(mockDB *appMocks.MockDatabase, local models.Burn) {
+		filter := bson.M{"transaction_hash": "hash", "log_index": "0", "wpokt_address": "wpoktaddress"}
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionBurns, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				*result.(*models.Burn) = local
+			}).Return(nil)
+	}
+
+	newLocalBurn := func() models.Burn {
+		id := primitive.NewObjectID()
+		return models.Burn{
+			Id:               &id,
+			TransactionHash:  "hash",
+			LogIndex:         "0",
+			Amount:           "20000",
+			RecipientAddress: recipient,
+			Status:           models.StatusConfirmed,
+			Signatures:       []models.Signature{},
+		}
+	}
+
+	t.Run("Peer signature merged into unsigned burn", func(t *testing.T) {
+		x, _, mockDB := setup(t)
+		local := newLocalBurn()
+		expectLocalBurn(mockDB, local)
+		peer := peerBurn(t, x, x.signer.Signer, "Burn: hash")
+
+		mockDB.EXPECT().XLock(mock.Anything, "burns/"+local.Id.Hex()).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, "burns/"+local.Id.Hex()).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+		mockDB.EXPECT().XLock(mock.Anything, sequenceResourseID).Return("sequenceLockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, sequenceResourseID).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "sequenceLockId").Return(nil)
+		mockDB.EXPECT().CountDocuments(mock.Anything, mock.Anything, mock.Anything).Return(0, nil).Times(2)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, filter interface{}, update interface{}) {
+				assert.Equal(t, local.Id, filter.(bson.M)["$and"].([]bson.M)[0]["_id"])
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, peer.Signatures, set["signatures"])
+				assert.Equal(t, &sequence, set["sequence"])
+				assert.Equal(t, models.StatusConfirmed, set["status"])
+				equal, err := util.CompareTxBodies("pokt", peer.ReturnTransactionBody, set["return_transaction_body"].(string))
+				assert.NoError(t, err)
+				assert.True(t, equal)
+			}).Return(*local.Id, nil)
+
+		err := x.MergePeerBurn(context.Background(), peer)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Peer signature already present", func(t *testing.T) {
+		x, _, mockDB := setup(t)
+		peer := peerBurn(t, x, x.signer.Signer, "Burn: hash")
+		local := newLocalBurn()
+		local.ReturnTransactionBody = peer.ReturnTransactionBody
+		local.Signatures = peer.Signatures
+		local.Sequence = &sequence
+		expectLocalBurn(mockDB, local)
+
+		err := x.MergePeerBurn(context.Background(), peer)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Peer uses a higher sequence", func(t *testing.T) {
+		x, _, mockDB := setup(t)
+		peer := peerBurn(t, x, x.signer.Signer, "Burn: hash")
+		otherSequence := uint64(0)
+		local := newLocalBurn()
+		local.ReturnTransactionBody = "body"
+		local.Sequence = &otherSequence
+		expectLocalBurn(mockDB, local)
+
+		err := x.MergePeerBurn(context.Background(), peer)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Peer uses a lower sequence", func(t *testing.T) {
+		x, _, mockDB := setup(t)
+		peer := peerBurn(t, x, x.signer.Signer, "Burn: hash")
+		otherSequence := uint64(2)
+		local := newLocalBurn()
+		local.ReturnTransactionBody = "body"
+		local.Sequence = &otherSequence
+		local.Signatures = []models.Signature{{Signer: "other", Signature: "signature"}}
+		expectLocalBurn(mockDB, local)
+
+		mockDB.EXPECT().XLock(mock.Anything, "burns/"+local.Id.Hex()).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, "burns/"+local.Id.Hex()).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+		mockDB.EXPECT().XLock(mock.Anything, sequenceResourseID).Return("sequenceLockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, sequenceResourseID).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "sequenceLockId").Return(nil)
+		mockDB.EXPECT().CountDocuments(mock.Anything, mock.Anything, mock.Anything).Return(0, nil).Times(2)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, filter interface{}, update interface{}) {
+				assert.Equal(t, local.Signatures, filter.(bson.M)["$and"].([]bson.M)[0]["signatures"])
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, peer.Signatures, set["signatures"])
+				assert.Equal(t, &sequence, set["sequence"])
+				assert.Equal(t, models.StatusConfirmed, set["status"])
+			}).Return(*local.Id, nil)
+
+		err := x.MergePeerBurn(context.Background(), peer)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Signed local burn keeps its sequence", func(t *testing.T) {
+		x, _, mockDB := setup(t)
+		peer := peerBurn(t, x, x.signer.Signer, "Burn: hash")
+		otherSequence := uint64(2)
+		local := newLocalBurn()
+		local.Status = models.StatusSigned
+		local.ReturnTransactionBody = "body"
+		local.Sequence = &otherSequence
+		expectLocalBurn(mockDB, local)
+
+		err := x.MergePeerBurn(context.Background(), peer)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Peer transaction does not match", func(t *testing.T) {
+		x, _, mockDB := setup(t)
+		expectLocalBurn(mockDB, newLocalBurn())
+
+		err := x.MergePeerBurn(context.Background(), peerBurn(t, x, x.signer.Signer, "Burn: other"))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Peer signer is not a multisig member", func(t *testing.T) {
+		x, _, mockDB := setup(t)
+		expectLocalBurn(mockDB, newLocalBurn())
+		other, _ := common.NewMnemonicSigner("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+
+		err := x.MergePeerBurn(context.Background(), peerBurn(t, x, other, "Burn: hash"))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Local burn not found", func(t *testing.T) {
+		x, _, mockDB := setup(t)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(errors.New("not found"))
+
+		err := x.MergePeerBurn(context.Background(), peerBurn(t, x, x.signer.Signer, "Burn: hash"))
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestBurnSignerSyncPeerSignatures(t *testing.T) {
+	oldConfig, oldFetchPeerInvalidMints, oldFetchPeerBurns := app.Config.Gossip, appFetchPeerInvalidMints, appFetchPeerBurns
+	defer func() {
+		app.Config.Gossip, appFetchPeerInvalidMints, appFetchPeerBurns = oldConfig, oldFetchPeerInvalidMints, oldFetchPeerBurns
+	}()
+	app.Config.Gossip = models.GossipConfig{Enabled: true, TimeoutMillis: 1000, Peers: []string{"https://peer1", "https://peer2"}}
+
+	x := NewTestBurnSigner(t, ethMocks.NewMockWrappedPocketContract(t), ethMocks.NewMockMintControllerContract(t), ethMocks.NewMockEthereumClient(t), cosmosMocks.NewMockCosmosClient(t))
+
+	t.Run("No Error", func(t *testing.T) {
+		burnPeers := []string{}
+		appFetchPeerInvalidMints = func(_ context.Context, _ string, path string) ([]models.InvalidMint, error) {
+			assert.Equal(t, app.GossipPathInvalidMints, path)
+			return []models.InvalidMint{}, nil
+		}
+		appFetchPeerBurns = func(_ context.Context, peer string, path string) ([]models.Burn, error) {
+			assert.Equal(t, app.GossipPathBurns, path)
+			burnPeers = append(burnPeers, peer)
+			return []models.Burn{}, nil
+		}
+
+		err := x.SyncPeerSignatures(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, app.Config.Gossip.Peers, burnPeers)
+	})
+
+	t.Run("Peer unreachable", func(t *testing.T) {
+		burnPeers := []string{}
+		appFetchPeerInvalidMints = func(_ context.Context, peer string, _ string) ([]models.InvalidMint, error) {
+			if peer == "https://peer1" {
+				return nil, errors.New("unreachable")
+			}
+			return []models.InvalidMint{}, nil
+		}
+		appFetchPeerBurns = func(_ context.Context, peer string, _ string) ([]models.Burn, error) {
+			burnPeers = append(burnPeers, peer)
+			return []models.Burn{}, nil
+		}
+
+		err := x.SyncPeerSignatures(context.Background())
+
+		assert.Error(t, err)
+		assert.Equal(t, []string{"https://peer2"}, burnPeers)
+	})
+}
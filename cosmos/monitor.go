@@ -3,6 +3,9 @@ package cosmos
 import (
 	"context"
 	"cosmossdk.io/math"
+	"errors"
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -27,40 +30,79 @@ const (
 	MintMonitorName = "MINT MONITOR"
 )
 
+// SubscriptionRetryDelay is how long the subscription waits before reconnecting after an error
+var SubscriptionRetryDelay = 5 * time.Second
+
+// errClassifiedAsOther aborts storing a deposit that is already stored as a mint or an invalid mint
+var errClassifiedAsOther = errors.New("deposit already stored with another classification")
+
 type MintMonitorRunner struct {
 	client                 cosmos.CosmosClient
 	ethClient              eth.EthereumClient
 	mintControllerContract eth.MintControllerContract
 	wpoktAddress           string
 	vaultAddress           string
+	validatorId            string
 	startHeight            int64
 	currentHeight          int64
 	minimumAmount          math.Int
 	maximumAmount          math.Int
+	failedRange            string
 }
 
-func (x *MintMonitorRunner) Run() {
-	x.UpdateCurrentHeight()
-	x.SyncTxs()
+func (x *MintMonitorRunner) Run(ctx context.Context) error {
+	err := x.UpdateCurrentHeight(ctx)
+	if !app.SyncFromCheckpoint(ctx, x.validatorId, MintMonitorName, x.vaultAddress, x.ReloadCheckpoint, x.SyncTxs) {
+		err = errors.Join(err, app.ErrSyncTxs)
+	}
+	return err
 }
 
 func (x *MintMonitorRunner) Status() models.RunnerStatus {
 	return models.RunnerStatus{
-		PoktHeight: strconv.FormatInt(x.startHeight, 10),
+		PoktHeight:  strconv.FormatInt(x.startHeight, 10),
+		FailedRange: x.failedRange,
+		Endpoints:   app.EndpointHealth(x.client, x.ethClient),
 	}
 }
 
-func (x *MintMonitorRunner) UpdateCurrentHeight() {
-	res, err := x.client.GetLatestBlockHeight()
+func (x *MintMonitorRunner) UpdateCurrentHeight(ctx context.Context) error {
+	res, err := x.client.GetLatestBlockHeight(ctx)
 	if err != nil {
 		log.Error("[MINT MONITOR] Error getting current height: ", err)
-		return
+		return fmt.Errorf("error getting current height: %w", err)
 	}
 	x.currentHeight = res
 	log.Info("[MINT MONITOR] Current height: ", x.currentHeight)
+	return nil
 }
 
-func (x *MintMonitorRunner) HandleFailedMint(tx *sdk.TxResponse, result *util.ValidateTxResult) bool {
+// storeDeposit stores a deposit in the mints or invalid mints collection, in one transaction with its deposit marker.
+// Every classification writes the same marker, so when validators classify a deposit differently at the same time
+// only one of them commits. Deposits stored before the markers existed are found by checking the other collection.
+func storeDeposit(ctx context.Context, collection string, txHash string, doc interface{}) error {
+	other, existing := models.CollectionMints, interface{}(&models.Mint{})
+	if collection == models.CollectionMints {
+		other, existing = models.CollectionInvalidMints, &models.InvalidMint{}
+	}
+
+	return app.DB.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := app.DB.FindOne(ctx, other, bson.M{"transaction_hash": txHash}, existing); err == nil {
+			return errClassifiedAsOther
+		}
+
+		marker := models.Deposit{TransactionHash: txHash, Collection: collection, CreatedAt: time.Now()}
+		if _, err := app.DB.InsertOne(ctx, models.CollectionDeposits, marker); err != nil {
+			return err
+		}
+		if _, err := app.DB.InsertOne(ctx, collection, doc); err != nil {
+			return err
+		}
+		return app.CommitCheckpoint(ctx)
+	})
+}
+
+func (x *MintMonitorRunner) HandleFailedMint(ctx context.Context, tx *sdk.TxResponse, result *util.ValidateTxResult) bool {
 	if tx == nil || result == nil {
 		log.Debug("[MINT MONITOR] Invalid tx response")
 		return false
@@ -69,8 +111,12 @@ func (x *MintMonitorRunner) HandleFailedMint(tx *sdk.TxResponse, result *util.Va
 	doc := util.CreateFailedMint(tx, result, x.vaultAddress)
 
 	log.Debug("[MINT MONITOR] Storing failed mint tx")
-	_, err := app.DB.InsertOne(models.CollectionInvalidMints, doc)
+	err := storeDeposit(ctx, models.CollectionInvalidMints, doc.TransactionHash, doc)
 	if err != nil {
+		if err == errClassifiedAsOther {
+			log.Warn("[MINT MONITOR] Ignoring failed mint since it exists as a valid mint")
+			return true
+		}
 		if mongo.IsDuplicateKeyError(err) {
 			log.Info("[MINT MONITOR] Found duplicate failed mint tx")
 			return true
@@ -83,7 +129,7 @@ func (x *MintMonitorRunner) HandleFailedMint(tx *sdk.TxResponse, result *util.Va
 	return true
 }
 
-func (x *MintMonitorRunner) HandleInvalidMint(tx *sdk.TxResponse, result *util.ValidateTxResult) bool {
+func (x *MintMonitorRunner) HandleInvalidMint(ctx context.Context, tx *sdk.TxResponse, result *util.ValidateTxResult) bool {
 	if tx == nil || result == nil {
 		log.Debug("[MINT MONITOR] Invalid tx response")
 		return false
@@ -91,18 +137,13 @@ func (x *MintMonitorRunner) HandleInvalidMint(tx *sdk.TxResponse, result *util.V
 
 	doc := util.CreateInvalidMint(tx, result, x.vaultAddress)
 
-	if app.Config.Pocket.MintDisabled {
-		// ensure that existing mints are not counted as invalid mints after mint is disabled
-		err := app.DB.FindOne(models.CollectionMints, bson.M{"transaction_hash": doc.TransactionHash}, &models.Mint{})
-		if err == nil {
+	log.Debug("[MINT MONITOR] Storing invalid mint tx")
+	err := storeDeposit(ctx, models.CollectionInvalidMints, doc.TransactionHash, doc)
+	if err != nil {
+		if err == errClassifiedAsOther {
 			log.Warn("[MINT MONITOR] Ignoring invalid mint since it exists as a valid mint")
 			return true
 		}
-	}
-
-	log.Debug("[MINT MONITOR] Storing invalid mint tx")
-	_, err := app.DB.InsertOne(models.CollectionInvalidMints, doc)
-	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
 			log.Info("[MINT MONITOR] Found duplicate invalid mint tx")
 			return true
@@ -115,7 +156,7 @@ func (x *MintMonitorRunner) HandleInvalidMint(tx *sdk.TxResponse, result *util.V
 	return true
 }
 
-func (x *MintMonitorRunner) HandleValidMint(tx *sdk.TxResponse, result *util.ValidateTxResult) bool {
+func (x *MintMonitorRunner) HandleValidMint(ctx context.Context, tx *sdk.TxResponse, result *util.ValidateTxResult) bool {
 	if tx == nil || result == nil {
 		log.Debug("[MINT MONITOR] Invalid tx response")
 		return false
@@ -128,14 +169,13 @@ func (x *MintMonitorRunner) HandleValidMint(tx *sdk.TxResponse, result *util.Val
 
 	doc := util.CreateMint(tx, result, x.wpoktAddress, x.vaultAddress)
 
-	// ensure that existing invalid mints are not counted as valid mints after mint is enabled
-	if err := app.DB.FindOne(models.CollectionInvalidMints, bson.M{"transaction_hash": doc.TransactionHash}, &models.InvalidMint{}); err == nil {
-		log.Warn("[MINT MONITOR] Ignoring valid mint since it exists as an invalid mint")
-		return true
-	}
-
 	log.Debug("[MINT MONITOR] Storing mint tx")
-	if _, err := app.DB.InsertOne(models.CollectionMints, doc); err != nil {
+	err := storeDeposit(ctx, models.CollectionMints, doc.TransactionHash, doc)
+	if err != nil {
+		if err == errClassifiedAsOther {
+			log.Warn("[MINT MONITOR] Ignoring valid mint since it exists as an invalid mint")
+			return true
+		}
 		if mongo.IsDuplicateKeyError(err) {
 			log.Info("[MINT MONITOR] Found duplicate mint tx")
 			return true
@@ -148,54 +188,185 @@ func (x *MintMonitorRunner) HandleValidMint(tx *sdk.TxResponse, result *util.Val
 	return true
 }
 
-func (x *MintMonitorRunner) SyncTxs() bool {
+func (x *MintMonitorRunner) HandleTx(ctx context.Context, txResponse *sdk.TxResponse) bool {
+	result := utilValidateTxToCosmosMultisig(txResponse, app.Config.Pocket, x.minimumAmount, x.maximumAmount)
+
+	if !result.TxValid {
+		log.Info("[MINT MONITOR] Found invalid mint tx: ", result.TxHash)
+		return x.HandleFailedMint(ctx, txResponse, result)
+	}
+	if result.NeedsRefund || app.Config.Pocket.MintDisabled {
+		log.Info("[MINT MONITOR] Found invalid mint tx: ", result.TxHash)
+		return x.HandleInvalidMint(ctx, txResponse, result)
+	}
+	log.Info("[MINT MONITOR] Found valid mint tx: ", result.TxHash)
+	return x.HandleValidMint(ctx, txResponse, result)
+}
+
+// Subscribe stores deposits to the vault as soon as they are committed, the periodic sync backfills anything the subscription missed
+func (x *MintMonitorRunner) Subscribe(ctx context.Context) {
+	if !app.Config.Pocket.Subscribe {
+		return
+	}
+
+	for ctx.Err() == nil {
+		log.Info("[MINT MONITOR] Subscribing to txs sent to the vault")
+		err := cosmosSubscribeTxsSentToAddress(ctx, app.Config.Pocket, x.vaultAddress, func(tx *sdk.TxResponse) {
+			x.HandleTx(ctx, tx)
+		})
+		if err != nil {
+			log.Errorf("[MINT MONITOR] Subscription failed, reconnecting in %s: %s", SubscriptionRetryDelay, err)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(SubscriptionRetryDelay):
+		}
+	}
+	log.Info("[MINT MONITOR] Subscription stopped")
+}
+
+func (x *MintMonitorRunner) SyncTxs(ctx context.Context) bool {
+	if app.Config.Pocket.ScanMode == models.ScanModeBlocks {
+		return x.SyncBlocks(ctx)
+	}
 
 	if x.currentHeight <= x.startHeight {
 		log.Info("[MINT MONITOR] No new blocks to sync")
+		x.failedRange = ""
 		return true
 	}
 
-	txResponses, err := x.client.GetTxsSentToAddressAfterHeight(x.vaultAddress, uint64(x.startHeight))
+	for x.startHeight < x.currentHeight {
+		endHeight := x.startHeight + cosmos.MAX_QUERY_BLOCKS
+		if endHeight > x.currentHeight {
+			endHeight = x.currentHeight
+		}
+
+		log.Info("[MINT MONITOR] Syncing mint txs from height: ", x.startHeight, " to height: ", endHeight)
+		if !x.SyncHeightRange(ctx, endHeight) {
+			x.failedRange = fmt.Sprintf("%d-%d", x.startHeight, endHeight)
+			log.Error("[MINT MONITOR] Failed to sync mint txs in range: ", x.failedRange)
+			return false
+		}
+
+		// commit progress after every window so that only the failing window is retried
+		x.startHeight = endHeight
+		if !x.SaveCheckpoint(ctx) {
+			return false
+		}
+	}
+
+	x.failedRange = ""
+	return true
+}
+
+// SyncHeightRange stores the txs sent to the vault from the start height up to the end height
+func (x *MintMonitorRunner) SyncHeightRange(ctx context.Context, endHeight int64) bool {
+	txResponses, err := x.client.GetTxsSentToAddressInRange(ctx, x.vaultAddress, uint64(x.startHeight), uint64(endHeight))
 	if err != nil {
 		log.Error("[MINT MONITOR] Error getting txs: ", err)
 		return false
 	}
 	log.Info("[MINT MONITOR] Found ", len(txResponses), " txs to sync")
-	var success = true
-	for _, txResponse := range txResponses {
-
-		result := utilValidateTxToCosmosMultisig(txResponse, app.Config.Pocket, x.minimumAmount, x.maximumAmount)
 
-		if !result.TxValid {
-			log.Info("[MINT MONITOR] Found invalid mint tx: ", result.TxHash)
-			success = x.HandleFailedMint(txResponse, result) && success
-			continue
+	// each tx is stored together with the checkpoint at its height, or at the end height for the last tx,
+	// so syncing stops at the first tx that could not be stored and only the rest is retried
+	sort.SliceStable(txResponses, func(i, j int) bool { return txResponses[i].Height < txResponses[j].Height })
+	for i, txResponse := range txResponses {
+		height := txResponse.Height
+		if i == len(txResponses)-1 {
+			height = endHeight
 		}
+		if !x.HandleTx(app.WithCheckpoint(ctx, x.validatorId, MintMonitorName, x.vaultAddress, height), txResponse) {
+			if txResponse.Height > x.startHeight {
+				x.startHeight = min(txResponse.Height, endHeight)
+			}
+			return false
+		}
+	}
+	return true
+}
 
-		if result.NeedsRefund || app.Config.Pocket.MintDisabled {
-			log.Info("[MINT MONITOR] Found invalid mint tx: ", result.TxHash)
-			success = x.HandleInvalidMint(txResponse, result) && success
-			continue
+// SyncBlocks reads every block from the start height, so it works against nodes without a tx indexer.
+// The start height is the next block to read and is committed with the transfers to the vault stored from every block.
+func (x *MintMonitorRunner) SyncBlocks(ctx context.Context) bool {
+	if x.startHeight > x.currentHeight {
+		log.Info("[MINT MONITOR] No new blocks to sync")
+		x.failedRange = ""
+		return true
+	}
+
+	endHeight := x.currentHeight
+	if maxBlocks := app.Config.Pocket.ScanMaxBlocks; maxBlocks > 0 && endHeight-x.startHeight+1 > maxBlocks {
+		endHeight = x.startHeight + maxBlocks - 1
+	}
+	log.Infof("[MINT MONITOR] Scanning blocks %d-%d", x.startHeight, endHeight)
+
+	for height := x.startHeight; height <= endHeight; height++ {
+		txResponses, err := x.client.GetTxsSentToAddressInBlock(ctx, x.vaultAddress, height)
+		if err != nil {
+			log.Error("[MINT MONITOR] Error getting txs in block: ", err)
+			x.failedRange = fmt.Sprintf("%d-%d", height, x.currentHeight)
+			return false
 		}
 
-		log.Info("[MINT MONITOR] Found valid mint tx: ", result.TxHash)
-		success = x.HandleValidMint(txResponse, result) && success
+		// the last tx of the block is stored together with the checkpoint past the block
+		for i, txResponse := range txResponses {
+			checkpoint := height
+			if i == len(txResponses)-1 {
+				checkpoint = height + 1
+			}
+			if !x.HandleTx(app.WithCheckpoint(ctx, x.validatorId, MintMonitorName, x.vaultAddress, checkpoint), txResponse) {
+				x.failedRange = fmt.Sprintf("%d-%d", height, x.currentHeight)
+				log.Error("[MINT MONITOR] Failed to sync mint txs in range: ", x.failedRange)
+				return false
+			}
+		}
 
+		x.startHeight = height + 1
+		if len(txResponses) > 0 {
+			log.Info("[MINT MONITOR] Synced ", len(txResponses), " txs in block ", height)
+		}
 	}
 
-	if success {
-		x.startHeight = x.currentHeight
+	x.failedRange = ""
+	return x.SaveCheckpoint(ctx)
+}
+
+// ReloadCheckpoint resumes from the stored checkpoint when it was rewound behind the synced height
+func (x *MintMonitorRunner) ReloadCheckpoint(height int64) {
+	if height < x.startHeight {
+		log.Warn("[MINT MONITOR] Checkpoint was rewound to height: ", height)
+		x.startHeight = height
 	}
+}
 
-	return success
+func (x *MintMonitorRunner) SaveCheckpoint(ctx context.Context) bool {
+	err := app.SaveCheckpoint(ctx, x.validatorId, MintMonitorName, x.vaultAddress, x.startHeight)
+	if err != nil {
+		log.Error("[MINT MONITOR] Error saving checkpoint: ", err)
+		return false
+	}
+	log.Debug("[MINT MONITOR] Saved checkpoint at height: ", x.startHeight)
+	return true
 }
 
-func (x *MintMonitorRunner) InitStartHeight(lastHealth models.ServiceHealth) {
+func (x *MintMonitorRunner) InitStartHeight(ctx context.Context, lastHealth models.ServiceHealth) {
 	startHeight := (app.Config.Pocket.StartHeight)
 
-	if (lastHealth.PoktHeight) != "" {
-		if lastHeight, err := strconv.ParseInt(lastHealth.PoktHeight, 10, 64); err == nil {
-			startHeight = lastHeight
+	checkpoint, err := app.FindCheckpoint(ctx, x.validatorId, MintMonitorName, x.vaultAddress)
+	if err == nil {
+		log.Info("[MINT MONITOR] Found checkpoint at height: ", checkpoint.Height)
+		startHeight = checkpoint.Height
+	} else {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			log.Warn("[MINT MONITOR] Error finding checkpoint: ", err)
+		}
+		if (lastHealth.PoktHeight) != "" {
+			if lastHeight, err := strconv.ParseInt(lastHealth.PoktHeight, 10, 64); err == nil {
+				startHeight = lastHeight
+			}
 		}
 	}
 	if startHeight > 0 {
@@ -207,19 +378,20 @@ func (x *MintMonitorRunner) InitStartHeight(lastHealth models.ServiceHealth) {
 	log.Info("[MINT MONITOR] Start height: ", x.startHeight)
 }
 
-func (x *MintMonitorRunner) UpdateMaxMintLimit() {
+func (x *MintMonitorRunner) UpdateMaxMintLimit(ctx context.Context) error {
 	log.Debug("[MINT MONITOR] Fetching mint controller max mint limit")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
 	defer cancel()
 	opts := &bind.CallOpts{Context: ctx, Pending: false}
 	mintLimit, err := x.mintControllerContract.MaxMintLimit(opts)
 
 	if err != nil {
 		log.Error("[MINT MONITOR] Error fetching mint controller max mint limit: ", err)
-		return
+		return fmt.Errorf("error fetching mint controller max mint limit: %w", err)
 	}
 	log.Debug("[MINT MONITOR] Fetched mint controller max mint limit: ", mintLimit)
 	x.maximumAmount = math.NewIntFromBigInt(mintLimit)
+	return nil
 }
 
 func NewMintMonitor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Service {
@@ -254,6 +426,7 @@ func NewMintMonitor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Ser
 
 	x := &MintMonitorRunner{
 		vaultAddress:           signer.MultisigAddress,
+		validatorId:            signer.ValidatorId(),
 		wpoktAddress:           strings.ToLower(app.Config.Ethereum.WrappedPocketAddress),
 		startHeight:            0,
 		currentHeight:          0,
@@ -263,11 +436,11 @@ func NewMintMonitor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Ser
 		mintControllerContract: eth.NewMintControllerContract(mintControllerContract),
 	}
 
-	x.UpdateCurrentHeight()
+	x.UpdateCurrentHeight(context.Background())
 
-	x.InitStartHeight(lastHealth)
+	x.InitStartHeight(context.Background(), lastHealth)
 
-	x.UpdateMaxMintLimit()
+	x.UpdateMaxMintLimit(context.Background())
 
 	if x.maximumAmount.LT(x.minimumAmount) {
 		log.Fatal("[MINT MONITOR] Invalid max mint limit")
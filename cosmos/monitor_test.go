@@ -1,11 +1,13 @@
 package cosmos
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"sync"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +19,7 @@ import (
 	"github.com/dan13ram/wpokt-validator/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 
@@ -31,6 +34,7 @@ func NewTestMintMonitor(t *testing.T, mockClient *cosmosMocks.MockCosmosClient)
 	x := &MintMonitorRunner{
 		vaultAddress:  "vaultaddress",
 		wpoktAddress:  "wpoktaddress",
+		validatorId:   "validatorId",
 		startHeight:   0,
 		currentHeight: 0,
 		client:        mockClient,
@@ -56,9 +60,9 @@ func TestMintMonitorUpdateCurrentHeight(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		x := NewTestMintMonitor(t, mockClient)
 
-		mockClient.EXPECT().GetLatestBlockHeight().Return(200, nil)
+		mockClient.EXPECT().GetLatestBlockHeight(mock.Anything).Return(200, nil)
 
-		x.UpdateCurrentHeight()
+		x.UpdateCurrentHeight(context.Background())
 
 		assert.Equal(t, x.currentHeight, int64(200))
 	})
@@ -67,15 +71,72 @@ func TestMintMonitorUpdateCurrentHeight(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		x := NewTestMintMonitor(t, mockClient)
 
-		mockClient.EXPECT().GetLatestBlockHeight().Return(200, errors.New("error"))
+		mockClient.EXPECT().GetLatestBlockHeight(mock.Anything).Return(200, errors.New("error"))
 
-		x.UpdateCurrentHeight()
+		x.UpdateCurrentHeight(context.Background())
 
 		assert.Equal(t, x.currentHeight, int64(0))
 	})
 
 }
 
+// expectDeposits stores deposits with their markers, none of them stored with another classification yet
+func expectDeposits(mockDB *appMocks.MockDatabase) {
+	expectTransactions(mockDB)
+	mockDB.EXPECT().FindOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments).Maybe()
+	mockDB.EXPECT().FindOne(mock.Anything, models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments).Maybe()
+	mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionDeposits, mock.Anything).Return(primitive.NewObjectID(), nil).Maybe()
+}
+
+func TestStoreDeposit(t *testing.T) {
+	doc := models.InvalidMint{TransactionHash: "0xabcd"}
+
+	t.Run("No Error", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		expectTransactions(mockDB)
+
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionMints, bson.M{"transaction_hash": "0xabcd"}, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionDeposits, mock.Anything).
+			Run(func(_ context.Context, _ string, data interface{}) {
+				marker := data.(models.Deposit)
+				assert.Equal(t, "0xabcd", marker.TransactionHash)
+				assert.Equal(t, models.CollectionInvalidMints, marker.Collection)
+			}).Return(primitive.NewObjectID(), nil).Once()
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, doc).Return(primitive.NewObjectID(), nil).Once()
+
+		err := storeDeposit(context.Background(), models.CollectionInvalidMints, "0xabcd", doc)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Stored With Another Classification", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		expectTransactions(mockDB)
+
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionInvalidMints, bson.M{"transaction_hash": "0xabcd"}, mock.Anything).Return(nil).Once()
+
+		err := storeDeposit(context.Background(), models.CollectionMints, "0xabcd", models.Mint{TransactionHash: "0xabcd"})
+
+		assert.Equal(t, errClassifiedAsOther, err)
+	})
+
+	t.Run("Marker Already Written", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		expectTransactions(mockDB)
+
+		// another validator stored the deposit with another classification after it was checked
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionDeposits, mock.Anything).Return(primitive.NilObjectID, mongo.CommandError{Code: 11000}).Once()
+
+		err := storeDeposit(context.Background(), models.CollectionInvalidMints, "0xabcd", doc)
+
+		assert.True(t, mongo.IsDuplicateKeyError(err))
+	})
+}
+
 func TestMintMonitorHandleFailedMint(t *testing.T) {
 
 	t.Run("Nil event", func(t *testing.T) {
@@ -84,7 +145,7 @@ func TestMintMonitorHandleFailedMint(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestMintMonitor(t, mockClient)
 
-		success := x.HandleFailedMint(nil, nil)
+		success := x.HandleFailedMint(context.Background(), nil, nil)
 
 		assert.False(t, success)
 	})
@@ -93,9 +154,10 @@ func TestMintMonitorHandleFailedMint(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 
-		mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), nil)
 
 		result := &util.ValidateTxResult{
 			Memo: models.MintMemo{},
@@ -108,7 +170,7 @@ func TestMintMonitorHandleFailedMint(t *testing.T) {
 			NeedsRefund:   false,
 		}
 
-		success := x.HandleFailedMint(&sdk.TxResponse{}, result)
+		success := x.HandleFailedMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.True(t, success)
 	})
@@ -117,9 +179,10 @@ func TestMintMonitorHandleFailedMint(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 
-		mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), mongo.CommandError{Code: 11000})
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), mongo.CommandError{Code: 11000})
 
 		result := &util.ValidateTxResult{
 			Memo: models.MintMemo{},
@@ -132,7 +195,7 @@ func TestMintMonitorHandleFailedMint(t *testing.T) {
 			NeedsRefund:   false,
 		}
 
-		success := x.HandleFailedMint(&sdk.TxResponse{}, result)
+		success := x.HandleFailedMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.True(t, success)
 	})
@@ -141,9 +204,10 @@ func TestMintMonitorHandleFailedMint(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 
-		mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
 
 		result := &util.ValidateTxResult{
 			Memo: models.MintMemo{},
@@ -156,7 +220,7 @@ func TestMintMonitorHandleFailedMint(t *testing.T) {
 			NeedsRefund:   false,
 		}
 
-		success := x.HandleFailedMint(&sdk.TxResponse{}, result)
+		success := x.HandleFailedMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.False(t, success)
 	})
@@ -171,7 +235,7 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestMintMonitor(t, mockClient)
 
-		success := x.HandleInvalidMint(nil, nil)
+		success := x.HandleInvalidMint(context.Background(), nil, nil)
 
 		assert.False(t, success)
 	})
@@ -180,9 +244,10 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 
-		mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), nil)
 
 		result := &util.ValidateTxResult{
 			Memo: models.MintMemo{},
@@ -195,7 +260,7 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 			NeedsRefund:   true,
 		}
 
-		success := x.HandleInvalidMint(&sdk.TxResponse{}, result)
+		success := x.HandleInvalidMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.True(t, success)
 	})
@@ -204,9 +269,10 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 
-		mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), mongo.CommandError{Code: 11000})
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), mongo.CommandError{Code: 11000})
 
 		result := &util.ValidateTxResult{
 			Memo: models.MintMemo{},
@@ -219,7 +285,7 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 			NeedsRefund:   true,
 		}
 
-		success := x.HandleInvalidMint(&sdk.TxResponse{}, result)
+		success := x.HandleInvalidMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.True(t, success)
 	})
@@ -228,9 +294,10 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 
-		mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
 
 		result := &util.ValidateTxResult{
 			Memo: models.MintMemo{},
@@ -243,7 +310,7 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 			NeedsRefund:   true,
 		}
 
-		success := x.HandleInvalidMint(&sdk.TxResponse{}, result)
+		success := x.HandleInvalidMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.False(t, success)
 	})
@@ -252,6 +319,7 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 
 		app.Config.Pocket.MintDisabled = true
@@ -260,9 +328,7 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 			app.Config.Pocket.MintDisabled = false
 		}()
 
-		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(errors.New("not found"))
-
-		mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
 
 		result := &util.ValidateTxResult{
 			Memo: models.MintMemo{},
@@ -275,7 +341,7 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 			NeedsRefund:   true,
 		}
 
-		success := x.HandleInvalidMint(&sdk.TxResponse{}, result)
+		success := x.HandleInvalidMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.False(t, success)
 	})
@@ -284,6 +350,7 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 
 		app.Config.Pocket.MintDisabled = true
@@ -292,7 +359,7 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 			app.Config.Pocket.MintDisabled = false
 		}()
 
-		mockDB.EXPECT().FindOne(models.CollectionMints, mock.Anything, mock.Anything).Return(nil)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(nil)
 
 		result := &util.ValidateTxResult{
 			Memo: models.MintMemo{},
@@ -305,7 +372,7 @@ func TestMintMonitorHandleInvalidMint(t *testing.T) {
 			NeedsRefund:   true,
 		}
 
-		success := x.HandleInvalidMint(&sdk.TxResponse{}, result)
+		success := x.HandleInvalidMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.True(t, success)
 	})
@@ -320,7 +387,7 @@ func TestMintMonitorHandleValidMint(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestMintMonitor(t, mockClient)
 
-		success := x.HandleValidMint(nil, nil)
+		success := x.HandleValidMint(context.Background(), nil, nil)
 
 		assert.False(t, success)
 	})
@@ -329,11 +396,10 @@ func TestMintMonitorHandleValidMint(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 
-		mockDB.EXPECT().FindOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(errors.New("not found"))
-
-		mockDB.EXPECT().InsertOne(models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), nil)
 
 		result := &util.ValidateTxResult{
 			Memo: models.MintMemo{ChainID: "31337", Address: "0x1c"},
@@ -346,7 +412,7 @@ func TestMintMonitorHandleValidMint(t *testing.T) {
 			NeedsRefund:   false,
 		}
 
-		success := x.HandleValidMint(&sdk.TxResponse{}, result)
+		success := x.HandleValidMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.True(t, success)
 	})
@@ -355,11 +421,10 @@ func TestMintMonitorHandleValidMint(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 
-		mockDB.EXPECT().FindOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(errors.New("not found"))
-
-		mockDB.EXPECT().InsertOne(models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), mongo.CommandError{Code: 11000})
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), mongo.CommandError{Code: 11000})
 
 		result := &util.ValidateTxResult{
 			Memo: models.MintMemo{ChainID: "31337", Address: "0x1c"},
@@ -372,7 +437,7 @@ func TestMintMonitorHandleValidMint(t *testing.T) {
 			NeedsRefund:   false,
 		}
 
-		success := x.HandleValidMint(&sdk.TxResponse{}, result)
+		success := x.HandleValidMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.True(t, success)
 	})
@@ -381,11 +446,10 @@ func TestMintMonitorHandleValidMint(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 
-		mockDB.EXPECT().FindOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(errors.New("not found"))
-
-		mockDB.EXPECT().InsertOne(models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
 
 		result := &util.ValidateTxResult{
 			Memo: models.MintMemo{ChainID: "31337", Address: "0x1c"},
@@ -398,7 +462,7 @@ func TestMintMonitorHandleValidMint(t *testing.T) {
 			NeedsRefund:   false,
 		}
 
-		success := x.HandleValidMint(&sdk.TxResponse{}, result)
+		success := x.HandleValidMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.False(t, success)
 	})
@@ -426,7 +490,7 @@ func TestMintMonitorHandleValidMint(t *testing.T) {
 			NeedsRefund:   false,
 		}
 
-		success := x.HandleValidMint(&sdk.TxResponse{}, result)
+		success := x.HandleValidMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.True(t, success)
 	})
@@ -435,9 +499,10 @@ func TestMintMonitorHandleValidMint(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 
-		mockDB.EXPECT().FindOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(nil)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(nil)
 
 		result := &util.ValidateTxResult{
 			Memo: models.MintMemo{ChainID: "31337", Address: "0x1c"},
@@ -450,7 +515,7 @@ func TestMintMonitorHandleValidMint(t *testing.T) {
 			NeedsRefund:   false,
 		}
 
-		success := x.HandleValidMint(&sdk.TxResponse{}, result)
+		success := x.HandleValidMint(context.Background(), &sdk.TxResponse{}, result)
 
 		assert.True(t, success)
 	})
@@ -469,7 +534,9 @@ func TestMintMonitorInitStartHeight(t *testing.T) {
 			PoktHeight: "10",
 		}
 
-		x.InitStartHeight(lastHealth)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+
+		x.InitStartHeight(context.Background(), lastHealth)
 
 		assert.Equal(t, int64(x.startHeight), int64(10))
 	})
@@ -484,11 +551,34 @@ func TestMintMonitorInitStartHeight(t *testing.T) {
 			PoktHeight: "invalid",
 		}
 
-		x.InitStartHeight(lastHealth)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+
+		x.InitStartHeight(context.Background(), lastHealth)
 
 		assert.Equal(t, int64(x.startHeight), int64(0))
 	})
 
+	t.Run("Checkpoint is found", func(t *testing.T) {
+		mockClient := cosmosMocks.NewMockCosmosClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintMonitor(t, mockClient)
+
+		lastHealth := models.ServiceHealth{
+			PoktHeight: "10",
+		}
+
+		filter := bson.M{"validator_id": "validatorId", "service": MintMonitorName, "address": x.vaultAddress}
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				result.(*models.Checkpoint).Height = 50
+			}).Once()
+
+		x.InitStartHeight(context.Background(), lastHealth)
+
+		assert.Equal(t, int64(x.startHeight), int64(50))
+	})
+
 }
 
 func TestMintMonitorSyncTxs(t *testing.T) {
@@ -501,7 +591,7 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		x.currentHeight = 100
 		x.startHeight = 100
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
@@ -514,7 +604,7 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		x.currentHeight = 100
 		x.startHeight = 101
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
@@ -527,9 +617,9 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		x.currentHeight = 100
 		x.startHeight = 1
 
-		mockClient.EXPECT().GetTxsSentToAddressAfterHeight(x.vaultAddress, uint64(x.startHeight)).Return(nil, errors.New("error"))
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(x.startHeight), uint64(x.currentHeight)).Return(nil, errors.New("error"))
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.False(t, success)
 	})
@@ -544,17 +634,57 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 
 		txs := []*sdk.TxResponse{}
 
-		mockClient.EXPECT().GetTxsSentToAddressAfterHeight(x.vaultAddress, uint64(x.startHeight)).Return(txs, nil)
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(x.startHeight), uint64(x.currentHeight)).Return(txs, nil)
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
 
+	t.Run("Syncs in bounded windows", func(t *testing.T) {
+		mockClient := cosmosMocks.NewMockCosmosClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintMonitor(t, mockClient)
+		x.currentHeight = 1000
+		x.startHeight = 1
+
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(1), uint64(500)).Return([]*sdk.TxResponse{}, nil).Once()
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(500), uint64(999)).Return([]*sdk.TxResponse{}, nil).Once()
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(999), uint64(1000)).Return([]*sdk.TxResponse{}, nil).Once()
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(3)
+
+		success := x.SyncTxs(context.Background())
+
+		assert.True(t, success)
+		assert.Equal(t, int64(1000), x.startHeight)
+	})
+
+	t.Run("Error in later window keeps earlier progress", func(t *testing.T) {
+		mockClient := cosmosMocks.NewMockCosmosClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintMonitor(t, mockClient)
+		x.currentHeight = 1000
+		x.startHeight = 1
+
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(1), uint64(500)).Return([]*sdk.TxResponse{}, nil).Once()
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(500), uint64(999)).Return(nil, errors.New("error")).Once()
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+
+		success := x.SyncTxs(context.Background())
+
+		assert.False(t, success)
+		assert.Equal(t, int64(500), x.startHeight)
+		assert.Equal(t, "500-999", x.failedRange)
+	})
+
 	t.Run("Invalid tx and insert failed", func(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 		x.currentHeight = 100
 		x.startHeight = 1
@@ -579,13 +709,13 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		mockClient.EXPECT().GetTxsSentToAddressAfterHeight(x.vaultAddress, uint64(x.startHeight)).Return(txs, nil)
-		mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error")).
-			Run(func(_ string, doc interface{}) {
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(x.startHeight), uint64(x.currentHeight)).Return(txs, nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error")).
+			Run(func(_ context.Context, _ string, doc interface{}) {
 				assert.Equal(t, doc.(models.InvalidMint).Status, models.StatusFailed)
 			})
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.False(t, success)
 	})
@@ -594,6 +724,7 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 		x.currentHeight = 100
 		x.startHeight = 1
@@ -618,13 +749,14 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		mockClient.EXPECT().GetTxsSentToAddressAfterHeight(x.vaultAddress, uint64(x.startHeight)).Return(txs, nil)
-		mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, doc interface{}) {
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(x.startHeight), uint64(x.currentHeight)).Return(txs, nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, doc interface{}) {
 				assert.Equal(t, doc.(models.InvalidMint).Status, models.StatusFailed)
 			})
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
@@ -633,6 +765,7 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 		x.currentHeight = 100
 		x.startHeight = 1
@@ -657,13 +790,14 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		mockClient.EXPECT().GetTxsSentToAddressAfterHeight(x.vaultAddress, uint64(x.startHeight)).Return(txs, nil)
-		mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, doc interface{}) {
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(x.startHeight), uint64(x.currentHeight)).Return(txs, nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, doc interface{}) {
 				assert.Equal(t, doc.(models.InvalidMint).Status, models.StatusFailed)
 			})
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
@@ -672,6 +806,7 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 		x.currentHeight = 100
 		x.startHeight = 1
@@ -695,13 +830,13 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		mockClient.EXPECT().GetTxsSentToAddressAfterHeight(x.vaultAddress, uint64(x.startHeight)).Return(txs, nil)
-		mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error")).
-			Run(func(_ string, doc interface{}) {
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(x.startHeight), uint64(x.currentHeight)).Return(txs, nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error")).
+			Run(func(_ context.Context, _ string, doc interface{}) {
 				assert.Equal(t, doc.(models.InvalidMint).Status, models.StatusPending)
 			})
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.False(t, success)
 	})
@@ -710,6 +845,7 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 		x.currentHeight = 100
 		x.startHeight = 1
@@ -735,13 +871,14 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		mockClient.EXPECT().GetTxsSentToAddressAfterHeight(x.vaultAddress, uint64(x.startHeight)).Return(txs, nil)
-		mockDB.EXPECT().InsertOne(models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, doc interface{}) {
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(x.startHeight), uint64(x.currentHeight)).Return(txs, nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, doc interface{}) {
 				assert.Equal(t, doc.(models.InvalidMint).Status, models.StatusPending)
 			})
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
@@ -750,6 +887,7 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 		x.currentHeight = 100
 		x.startHeight = 1
@@ -777,22 +915,68 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		mockClient.EXPECT().GetTxsSentToAddressAfterHeight(x.vaultAddress, uint64(x.startHeight)).Return(txs, nil)
-		mockDB.EXPECT().FindOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(errors.New("not found"))
-		mockDB.EXPECT().InsertOne(models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error")).
-			Run(func(_ string, doc interface{}) {
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(x.startHeight), uint64(x.currentHeight)).Return(txs, nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error")).
+			Run(func(_ context.Context, _ string, doc interface{}) {
 				assert.Equal(t, doc.(models.Mint).Status, models.StatusPending)
 			})
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
+
+		assert.False(t, success)
+	})
+
+	t.Run("insert failed after some txs were stored", func(t *testing.T) {
+		mockClient := cosmosMocks.NewMockCosmosClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		expectDeposits(mockDB)
+		x := NewTestMintMonitor(t, mockClient)
+		x.currentHeight = 100
+		x.startHeight = 1
+
+		app.Config.Ethereum.ChainID = "31337"
+
+		txs := []*sdk.TxResponse{
+			{Height: 10, TxHash: "abcd"},
+			{Height: 20, TxHash: "efgh"},
+			{Height: 30, TxHash: "ijkl"},
+		}
+
+		oldValidateTxToCosmosMultisig := utilValidateTxToCosmosMultisig
+		utilValidateTxToCosmosMultisig = func(txResponse *sdk.TxResponse, config models.CosmosConfig, minAmount math.Int, maxAmount math.Int) *util.ValidateTxResult {
+			return &util.ValidateTxResult{
+				Memo:          models.MintMemo{ChainID: "31337", Address: "0x1c"},
+				TxValid:       true,
+				Tx:            &tx.Tx{},
+				TxHash:        txResponse.TxHash,
+				Amount:        sdk.NewCoin("pokt", math.NewInt(10000)),
+				SenderAddress: "sender",
+			}
+		}
+		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
+
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(x.startHeight), uint64(x.currentHeight)).Return(txs, nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), errors.New("error")).Once()
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				assert.Equal(t, int64(10), update.(bson.M)["$max"].(bson.M)["height"])
+			}).Return(primitive.NewObjectID(), nil).Once()
+
+		success := x.SyncTxs(context.Background())
 
 		assert.False(t, success)
+		assert.Equal(t, int64(20), x.startHeight)
+		assert.Equal(t, "20-100", x.failedRange)
+		assert.Equal(t, "20-100", x.Status().FailedRange)
 	})
 
 	t.Run("valid memo and insert successful", func(t *testing.T) {
 		mockClient := cosmosMocks.NewMockCosmosClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectDeposits(mockDB)
 		x := NewTestMintMonitor(t, mockClient)
 		x.currentHeight = 100
 		x.startHeight = 1
@@ -820,32 +1004,189 @@ func TestMintMonitorSyncTxs(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		mockClient.EXPECT().GetTxsSentToAddressAfterHeight(x.vaultAddress, uint64(x.startHeight)).Return(txs, nil)
-		mockDB.EXPECT().FindOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(errors.New("not found"))
-		mockDB.EXPECT().InsertOne(models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, doc interface{}) {
+		mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(x.startHeight), uint64(x.currentHeight)).Return(txs, nil)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, doc interface{}) {
 				assert.Equal(t, doc.(models.Mint).Status, models.StatusPending)
 			})
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
 
 }
 
+func TestMintMonitorSyncBlocks(t *testing.T) {
+	app.Config.Pocket.ScanMode = models.ScanModeBlocks
+	app.Config.Pocket.ScanMaxBlocks = 3
+	defer func() {
+		app.Config.Pocket.ScanMode = ""
+		app.Config.Pocket.ScanMaxBlocks = 0
+	}()
+
+	oldValidateTxToCosmosMultisig := utilValidateTxToCosmosMultisig
+	utilValidateTxToCosmosMultisig = func(txResponse *sdk.TxResponse, config models.CosmosConfig, minAmount math.Int, maxAmount math.Int) *util.ValidateTxResult {
+		return &util.ValidateTxResult{
+			TxValid: false,
+			Tx:      &tx.Tx{Body: &tx.TxBody{Memo: "invalid"}},
+			TxHash:  txResponse.TxHash,
+			Amount:  sdk.NewCoin("pokt", math.NewInt(10000)),
+		}
+	}
+	defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
+
+	t.Run("Start Height is greater than Current Height", func(t *testing.T) {
+		mockClient := cosmosMocks.NewMockCosmosClient(t)
+		x := NewTestMintMonitor(t, mockClient)
+		x.currentHeight = 100
+		x.startHeight = 101
+
+		success := x.SyncTxs(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("Scans at most max blocks", func(t *testing.T) {
+		mockClient := cosmosMocks.NewMockCosmosClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		expectDeposits(mockDB)
+		x := NewTestMintMonitor(t, mockClient)
+		x.currentHeight = 100
+		x.startHeight = 1
+
+		mockClient.EXPECT().GetTxsSentToAddressInBlock(mock.Anything, x.vaultAddress, int64(1)).Return([]*sdk.TxResponse{}, nil).Once()
+		mockClient.EXPECT().GetTxsSentToAddressInBlock(mock.Anything, x.vaultAddress, int64(2)).Return([]*sdk.TxResponse{{Height: 2, TxHash: "abcd"}}, nil).Once()
+		mockClient.EXPECT().GetTxsSentToAddressInBlock(mock.Anything, x.vaultAddress, int64(3)).Return([]*sdk.TxResponse{}, nil).Once()
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+
+		var checkpoints []int64
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				checkpoints = append(checkpoints, update.(bson.M)["$max"].(bson.M)["height"].(int64))
+			}).Return(primitive.NewObjectID(), nil).Times(2)
+
+		success := x.SyncTxs(context.Background())
+
+		assert.True(t, success)
+		assert.Equal(t, int64(4), x.startHeight)
+		assert.Equal(t, "", x.failedRange)
+		assert.Equal(t, []int64{3, 4}, checkpoints)
+	})
+
+	t.Run("Error fetching block", func(t *testing.T) {
+		mockClient := cosmosMocks.NewMockCosmosClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintMonitor(t, mockClient)
+		x.currentHeight = 100
+		x.startHeight = 1
+
+		mockClient.EXPECT().GetTxsSentToAddressInBlock(mock.Anything, x.vaultAddress, int64(1)).Return([]*sdk.TxResponse{}, nil).Once()
+		mockClient.EXPECT().GetTxsSentToAddressInBlock(mock.Anything, x.vaultAddress, int64(2)).Return(nil, errors.New("error")).Once()
+
+		success := x.SyncTxs(context.Background())
+
+		assert.False(t, success)
+		assert.Equal(t, int64(2), x.startHeight)
+		assert.Equal(t, "2-100", x.failedRange)
+	})
+
+	t.Run("Error storing tx", func(t *testing.T) {
+		mockClient := cosmosMocks.NewMockCosmosClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		expectDeposits(mockDB)
+		x := NewTestMintMonitor(t, mockClient)
+		x.currentHeight = 100
+		x.startHeight = 1
+
+		mockClient.EXPECT().GetTxsSentToAddressInBlock(mock.Anything, x.vaultAddress, int64(1)).Return([]*sdk.TxResponse{{Height: 1, TxHash: "abcd"}}, nil).Once()
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(primitive.NilObjectID, errors.New("error")).Once()
+
+		success := x.SyncTxs(context.Background())
+
+		assert.False(t, success)
+		assert.Equal(t, int64(1), x.startHeight)
+		assert.Equal(t, "1-100", x.failedRange)
+	})
+}
+
+func TestMintMonitorSubscribe(t *testing.T) {
+	oldValidateTxToCosmosMultisig := utilValidateTxToCosmosMultisig
+	utilValidateTxToCosmosMultisig = func(txResponse *sdk.TxResponse, config models.CosmosConfig, minAmount math.Int, maxAmount math.Int) *util.ValidateTxResult {
+		return &util.ValidateTxResult{
+			TxValid: false,
+			Tx:      &tx.Tx{Body: &tx.TxBody{Memo: "invalid"}},
+			TxHash:  txResponse.TxHash,
+			Amount:  sdk.NewCoin("pokt", math.NewInt(10000)),
+		}
+	}
+	oldSubscribe, oldDelay := cosmosSubscribeTxsSentToAddress, SubscriptionRetryDelay
+	SubscriptionRetryDelay = 10 * time.Millisecond
+	defer func() {
+		utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig
+		cosmosSubscribeTxsSentToAddress, SubscriptionRetryDelay = oldSubscribe, oldDelay
+		app.Config.Pocket.Subscribe = false
+	}()
+
+	t.Run("Disabled", func(t *testing.T) {
+		x := NewTestMintMonitor(t, cosmosMocks.NewMockCosmosClient(t))
+		cosmosSubscribeTxsSentToAddress = func(ctx context.Context, config models.CosmosConfig, address string, handle func(tx *sdk.TxResponse)) error {
+			t.Fatal("subscribed while disabled")
+			return nil
+		}
+
+		x.Subscribe(context.Background())
+	})
+
+	t.Run("Handles txs and reconnects", func(t *testing.T) {
+		app.Config.Pocket.Subscribe = true
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		expectDeposits(mockDB)
+		x := NewTestMintMonitor(t, cosmosMocks.NewMockCosmosClient(t))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		subscriptions := 0
+		cosmosSubscribeTxsSentToAddress = func(ctx context.Context, config models.CosmosConfig, address string, handle func(tx *sdk.TxResponse)) error {
+			subscriptions++
+			assert.Equal(t, x.vaultAddress, address)
+			if subscriptions == 1 {
+				return errors.New("connection lost")
+			}
+			handle(&sdk.TxResponse{Height: 10, TxHash: "abcd"})
+			<-ctx.Done()
+			return nil
+		}
+
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionInvalidMints, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}) { cancel() }).
+			Return(primitive.NewObjectID(), nil).Once()
+
+		x.Subscribe(ctx)
+
+		assert.Equal(t, 2, subscriptions)
+	})
+}
+
 func TestMintMonitorRun(t *testing.T) {
 
 	mockClient := cosmosMocks.NewMockCosmosClient(t)
 	mockDB := appMocks.NewMockDatabase(t)
 	app.DB = mockDB
+	expectDeposits(mockDB)
 	x := NewTestMintMonitor(t, mockClient)
 	x.currentHeight = 100
 	x.startHeight = 1
 
 	app.Config.Ethereum.ChainID = "31337"
 
-	mockClient.EXPECT().GetLatestBlockHeight().Return(200, nil).Once()
+	mockClient.EXPECT().GetLatestBlockHeight(mock.Anything).Return(200, nil).Once()
 
 	txs := []*sdk.TxResponse{
 		{},
@@ -868,14 +1209,23 @@ func TestMintMonitorRun(t *testing.T) {
 	}
 	defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-	mockClient.EXPECT().GetTxsSentToAddressAfterHeight(x.vaultAddress, uint64(x.startHeight)).Return(txs, nil)
-	mockDB.EXPECT().FindOne(models.CollectionInvalidMints, mock.Anything, mock.Anything).Return(errors.New("not found"))
-	mockDB.EXPECT().InsertOne(models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), nil).
-		Run(func(_ string, doc interface{}) {
+	mockClient.EXPECT().GetTxsSentToAddressInRange(mock.Anything, x.vaultAddress, uint64(x.startHeight), uint64(200)).Return(txs, nil)
+	mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionMints, mock.Anything).Return(primitive.NewObjectID(), nil).
+		Run(func(_ context.Context, _ string, doc interface{}) {
 			assert.Equal(t, doc.(models.Mint).Status, models.StatusPending)
 		})
-
-	x.Run()
+	mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
+	mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+	mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+	mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+	mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+			result.(*models.Checkpoint).Height = 1
+		}).Return(nil)
+
+	err := x.Run(context.Background())
+
+	assert.Nil(t, err)
 
 }
 
@@ -1,7 +1,13 @@
 package cosmos
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/dan13ram/wpokt-validator/app"
@@ -10,15 +16,22 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// sequenceFilter matches the return transactions of the vault in a collection,
+// invalid mints are stored with the vault address and burns with the wpokt address
+func sequenceFilter(collection string) bson.M {
+	if collection == models.CollectionBurns {
+		return bson.M{"wpokt_address": strings.ToLower(app.Config.Ethereum.WrappedPocketAddress)}
+	}
+	return bson.M{"vault_address": app.Config.Pocket.MultisigAddress}
+}
+
 type resultMaxSequence struct {
 	MaxSequence uint64 `bson:"max_sequence"`
 }
 
-func findMaxSequenceFromInvalidMints() (*uint64, error) {
-	filter := bson.M{
-		"sequence":      bson.M{"$ne": nil},
-		"vault_address": app.Config.Pocket.MultisigAddress,
-	}
+func findMaxSequenceFromInvalidMints(ctx context.Context) (*uint64, error) {
+	filter := sequenceFilter(models.CollectionInvalidMints)
+	filter["sequence"] = bson.M{"$ne": nil}
 	pipeline := mongo.Pipeline{
 		{{Key: "$match", Value: filter}},
 		{{Key: "$group", Value: bson.D{
@@ -28,7 +41,7 @@ func findMaxSequenceFromInvalidMints() (*uint64, error) {
 	}
 
 	var result resultMaxSequence
-	err := app.DB.AggregateOne(models.CollectionInvalidMints, pipeline, &result)
+	err := app.DB.AggregateOne(ctx, models.CollectionInvalidMints, pipeline, &result)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, nil
@@ -41,11 +54,9 @@ func findMaxSequenceFromInvalidMints() (*uint64, error) {
 	return &maxSequence, nil
 }
 
-func findMaxSequenceFromBurns() (*uint64, error) {
-	filter := bson.M{
-		"sequence":      bson.M{"$ne": nil},
-		"vault_address": app.Config.Pocket.MultisigAddress,
-	}
+func findMaxSequenceFromBurns(ctx context.Context) (*uint64, error) {
+	filter := sequenceFilter(models.CollectionBurns)
+	filter["sequence"] = bson.M{"$ne": nil}
 	pipeline := mongo.Pipeline{
 		{{Key: "$match", Value: filter}},
 		{{Key: "$group", Value: bson.D{
@@ -55,7 +66,7 @@ func findMaxSequenceFromBurns() (*uint64, error) {
 	}
 
 	var result resultMaxSequence
-	err := app.DB.AggregateOne(models.CollectionBurns, pipeline, &result)
+	err := app.DB.AggregateOne(ctx, models.CollectionBurns, pipeline, &result)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, nil
@@ -68,13 +79,13 @@ func findMaxSequenceFromBurns() (*uint64, error) {
 	return &maxSequence, nil
 }
 
-func findMaxSequence() (*uint64, error) {
-	maxSequenceInvalidMints, err := findMaxSequenceFromInvalidMints()
+func findMaxSequence(ctx context.Context) (*uint64, error) {
+	maxSequenceInvalidMints, err := findMaxSequenceFromInvalidMints(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	maxSequenceBurns, err := findMaxSequenceFromBurns()
+	maxSequenceBurns, err := findMaxSequenceFromBurns(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -102,26 +113,73 @@ var FindMaxSequence = findMaxSequence
 
 const sequenceResourseID = "comsos_sequence"
 
-func lockReadSequences() (lockID string, err error) {
-	lockID, err = app.DB.SLock(sequenceResourseID)
+// lockSequences locks the sequences until release is called, the returned context is cancelled if the lock is lost
+func lockSequences(ctx context.Context, shared bool) (sequenceCtx context.Context, release func(), err error) {
+	acquire, access := app.AcquireLock, "write"
+	if shared {
+		acquire, access = app.AcquireSharedLock, "read"
+	}
+	lock, sequenceCtx, err := acquire(ctx, sequenceResourseID)
 	if err != nil {
 		log.WithError(err).Error("Error locking max sequence")
-		return
+		return nil, nil, err
+	}
+	log.WithField("resource_id", sequenceResourseID).Debugf("Locked %s sequence", access)
+
+	release = func() {
+		if err := lock.Release(ctx); err != nil {
+			log.WithError(err).Error("Error unlocking sequence")
+		}
 	}
-	log.WithField("resource_id", sequenceResourseID).Debug("Locked read sequences")
-	return
+	return sequenceCtx, release, nil
+}
+
+func lockReadSequences(ctx context.Context) (context.Context, func(), error) {
+	return lockSequences(ctx, true)
 }
 
 var LockReadSequences = lockReadSequences
 
-func lockWriteSequence() (lockID string, err error) {
-	lockID, err = app.DB.XLock(sequenceResourseID)
-	if err != nil {
-		log.WithError(err).Error("Error locking max sequence")
-		return
-	}
-	log.WithField("resource_id", sequenceResourseID).Debug("Locked write sequence")
-	return
+func lockWriteSequence(ctx context.Context) (context.Context, func(), error) {
+	return lockSequences(ctx, false)
 }
 
 var LockWriteSequence = lockWriteSequence
+
+// ErrSequenceInUse is returned when another invalid mint or burn was given the sequence after it was read
+var ErrSequenceInUse = errors.New("sequence is already used by another return transaction")
+
+// checkSequenceUnused fails if an invalid mint or burn other than the one with id uses the sequence,
+// it is called in the transaction that writes the sequence
+func checkSequenceUnused(ctx context.Context, collection string, id *primitive.ObjectID, sequence uint64) error {
+	for _, c := range []string{models.CollectionInvalidMints, models.CollectionBurns} {
+		filter := sequenceFilter(c)
+		filter["sequence"] = sequence
+		if c == collection {
+			filter["_id"] = bson.M{"$ne": id}
+		}
+		count, err := app.DB.CountDocuments(ctx, c, filter)
+		if err != nil {
+			return fmt.Errorf("error checking sequence in %s: %w", c, err)
+		}
+		if count > 0 {
+			return ErrSequenceInUse
+		}
+	}
+	return nil
+}
+
+// updateWithSequence updates a return transaction in one transaction with the check that its sequence is unused
+func updateWithSequence(ctx context.Context, collection string, id *primitive.ObjectID, filter interface{}, update bson.M) error {
+	return app.DB.WithTransaction(ctx, func(ctx context.Context) error {
+		if set, ok := update["$set"].(bson.M); ok {
+			if sequence, ok := set["sequence"].(*uint64); ok && sequence != nil {
+				if err := checkSequenceUnused(ctx, collection, id, *sequence); err != nil {
+					return err
+				}
+			}
+		}
+		_, err := app.DB.UpdateOne(ctx, collection, filter, update)
+		return err
+	})
+}
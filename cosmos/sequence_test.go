@@ -0,0 +1,119 @@
+package cosmos
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	appMocks "github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// expectTransactions runs transactions of the mock database directly
+func expectTransactions(mockDB *appMocks.MockDatabase) {
+	mockDB.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).Maybe()
+}
+
+// fenced is the filter of a fenced update of a document no lock holder has written yet
+func fenced(filter bson.M) bson.M {
+	return bson.M{
+		"$and": []bson.M{
+			filter,
+			{"fencing_token": nil},
+		},
+	}
+}
+
+func TestUpdateWithSequence(t *testing.T) {
+	id := primitive.NewObjectID()
+	sequence := uint64(5)
+	filter := bson.M{"_id": &id}
+	update := bson.M{"$set": bson.M{"sequence": &sequence}}
+
+	t.Run("Sequence Unused", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		expectTransactions(mockDB)
+		app.Config.Pocket.MultisigAddress = "vault"
+		app.Config.Ethereum.WrappedPocketAddress = "0xWPOKT"
+
+		mockDB.EXPECT().CountDocuments(mock.Anything, models.CollectionInvalidMints, bson.M{"sequence": sequence, "vault_address": "vault"}).Return(0, nil).Once()
+		mockDB.EXPECT().CountDocuments(mock.Anything, models.CollectionBurns, bson.M{"sequence": sequence, "wpokt_address": "0xwpokt", "_id": bson.M{"$ne": &id}}).Return(0, nil).Once()
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, update).Return(id, nil).Once()
+
+		err := updateWithSequence(context.Background(), models.CollectionBurns, &id, filter, update)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Sequence In Use", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		expectTransactions(mockDB)
+
+		mockDB.EXPECT().CountDocuments(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(1, nil).Once()
+
+		err := updateWithSequence(context.Background(), models.CollectionBurns, &id, filter, update)
+
+		assert.ErrorIs(t, err, ErrSequenceInUse)
+	})
+
+	t.Run("Count Error", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		expectTransactions(mockDB)
+
+		mockDB.EXPECT().CountDocuments(mock.Anything, models.CollectionInvalidMints, mock.Anything).Return(0, errors.New("error")).Once()
+
+		err := updateWithSequence(context.Background(), models.CollectionBurns, &id, filter, update)
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Without Sequence", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		expectTransactions(mockDB)
+
+		update := bson.M{"$set": bson.M{"status": models.StatusPending}}
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, update).Return(id, nil).Once()
+
+		err := updateWithSequence(context.Background(), models.CollectionBurns, &id, filter, update)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestFindMaxSequence(t *testing.T) {
+	mockDB := appMocks.NewMockDatabase(t)
+	app.DB = mockDB
+	app.Config.Pocket.MultisigAddress = "vault"
+	app.Config.Ethereum.WrappedPocketAddress = "0xWPOKT"
+
+	match := func(pipeline interface{}) bson.M {
+		return pipeline.(mongo.Pipeline)[0][0].Value.(bson.M)
+	}
+
+	mockDB.EXPECT().AggregateOne(mock.Anything, models.CollectionInvalidMints, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ string, pipeline interface{}, result interface{}) {
+			assert.Equal(t, bson.M{"vault_address": "vault", "sequence": bson.M{"$ne": nil}}, match(pipeline))
+			result.(*resultMaxSequence).MaxSequence = 4
+		}).Return(nil).Once()
+	mockDB.EXPECT().AggregateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ string, pipeline interface{}, result interface{}) {
+			assert.Equal(t, bson.M{"wpokt_address": "0xwpokt", "sequence": bson.M{"$ne": nil}}, match(pipeline))
+			result.(*resultMaxSequence).MaxSequence = 7
+		}).Return(nil).Once()
+
+	maxSequence, err := findMaxSequence(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), *maxSequence)
+}
@@ -43,41 +43,49 @@ type BurnSignerRunner struct {
 	maximumAmount          math.Int
 }
 
-func (x *BurnSignerRunner) Run() {
-	x.UpdateBlocks()
-	x.SyncTxs()
+func (x *BurnSignerRunner) Run(ctx context.Context) error {
+	err := x.UpdateBlocks(ctx)
+	if app.Config.Gossip.Enabled {
+		err = errors.Join(err, x.SyncPeerSignatures(ctx))
+	}
+	if !x.SyncTxs(ctx) {
+		err = errors.Join(err, app.ErrSyncTxs)
+	}
+	return err
 }
 func (x *BurnSignerRunner) Status() models.RunnerStatus {
 	return models.RunnerStatus{
 		PoktHeight:     strconv.FormatInt(x.cosmosHeight, 10),
 		EthBlockNumber: strconv.FormatInt(x.ethBlockNumber, 10),
+		Endpoints:      app.EndpointHealth(x.ethClient, x.cosmosClient),
 	}
 }
 
-func (x *BurnSignerRunner) UpdateBlocks() {
+func (x *BurnSignerRunner) UpdateBlocks(ctx context.Context) error {
 	log.Debug("[BURN SIGNER] Updating blocks")
 
-	poktHeight, err := x.cosmosClient.GetLatestBlockHeight()
+	poktHeight, err := x.cosmosClient.GetLatestBlockHeight(ctx)
 	if err != nil {
 		log.Error("[BURN SIGNER] Error fetching pokt block height: ", err)
-		return
+		return fmt.Errorf("error fetching pokt block height: %w", err)
 	}
 	x.cosmosHeight = poktHeight
 
-	ethBlockNumber, err := x.ethClient.GetBlockNumber()
+	ethBlockNumber, err := x.ethClient.GetBlockNumber(ctx)
 	if err != nil {
 		log.Error("[BURN SIGNER] Error fetching eth block number: ", err)
-		return
+		return fmt.Errorf("error fetching eth block number: %w", err)
 	}
 	x.ethBlockNumber = int64(ethBlockNumber)
 
 	log.Info("[BURN SIGNER] Updated blocks")
+	return nil
 }
 
-func (x *BurnSignerRunner) ValidateInvalidMint(doc *models.InvalidMint) (bool, error) {
+func (x *BurnSignerRunner) ValidateInvalidMint(ctx context.Context, doc *models.InvalidMint) (bool, error) {
 	log.Debug("[BURN SIGNER] Validating invalid mint: ", doc.TransactionHash)
 
-	txResponse, err := x.cosmosClient.GetTx(doc.TransactionHash)
+	txResponse, err := x.cosmosClient.GetTx(ctx, doc.TransactionHash)
 	if err != nil {
 		return false, errors.New("Error fetching transaction: " + err.Error())
 	}
@@ -128,19 +136,23 @@ func (x *BurnSignerRunner) ValidateInvalidMint(doc *models.InvalidMint) (bool, e
 	return true, nil
 }
 
-func (x *BurnSignerRunner) FindMaxSequence() (uint64, error) {
-	lockID, err := LockReadSequences()
+func (x *BurnSignerRunner) FindMaxSequence(ctx context.Context) (uint64, error) {
+	sequenceCtx, release, err := LockReadSequences(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("could not lock sequences: %w", err)
 	}
-	//nolint:errcheck
-	defer app.DB.Unlock(lockID)
+	defer release()
 
-	maxSequence, err := FindMaxSequence()
+	// read both collections from one snapshot
+	var maxSequence *uint64
+	err = app.DB.WithTransaction(sequenceCtx, func(ctx context.Context) error {
+		maxSequence, err = FindMaxSequence(ctx)
+		return err
+	})
 	if err != nil {
 		return 0, err
 	}
-	account, err := x.cosmosClient.GetAccount(x.signer.MultisigAddress)
+	account, err := x.cosmosClient.GetAccount(ctx, x.signer.MultisigAddress)
 	if err != nil {
 		return 0, err
 	}
@@ -156,6 +168,7 @@ func (x *BurnSignerRunner) FindMaxSequence() (uint64, error) {
 }
 
 func (x *BurnSignerRunner) Sign(
+	ctx context.Context,
 	sequence *uint64,
 	signatures []models.Signature,
 	transactionBody string,
@@ -165,7 +178,7 @@ func (x *BurnSignerRunner) Sign(
 ) (bson.M, error) {
 
 	if sequence == nil {
-		gotSequence, err := x.FindMaxSequence()
+		gotSequence, err := x.FindMaxSequence(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("error getting sequence: %w", err)
 		}
@@ -173,6 +186,7 @@ func (x *BurnSignerRunner) Sign(
 	}
 
 	txBody, finalSignatures, err := CosmosSignTx(
+		ctx,
 		x.signer.Signer,
 		app.Config.Pocket,
 		x.cosmosClient,
@@ -203,25 +217,22 @@ func (x *BurnSignerRunner) Sign(
 	return update, nil
 }
 
-func (x *BurnSignerRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
+func (x *BurnSignerRunner) HandleInvalidMint(ctx context.Context, doc *models.InvalidMint) error {
 	if doc == nil {
-		log.Error("[BURN SIGNER] Invalid mint is nil")
-		return false
+		return errors.New("invalid mint is nil")
 	}
 	log.Debug("[BURN SIGNER] Handling invalid mint: ", doc.TransactionHash)
 
 	doc, err := util.UpdateStatusAndConfirmationsForInvalidMint(doc, x.cosmosHeight)
 	if err != nil {
-		log.Error("[BURN SIGNER] Error getting invalid mint status: ", err)
-		return false
+		return fmt.Errorf("error getting invalid mint status: %w", err)
 	}
 
 	var update bson.M
 
-	valid, err := x.ValidateInvalidMint(doc)
+	valid, err := x.ValidateInvalidMint(ctx, doc)
 	if err != nil {
-		log.Error("[BURN SIGNER] Error validating invalid mint: ", err)
-		return false
+		return fmt.Errorf("error validating invalid mint: %w", err)
 	}
 
 	if !valid {
@@ -233,8 +244,7 @@ func (x *BurnSignerRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 			},
 		}
 		if doc.Confirmations == "0" {
-			log.Debug("[BURN SIGNER] Invalid mint has no confirmations, skipping")
-			return false
+			return fmt.Errorf("invalid mint failed validation: %w", app.ErrNotConfirmed)
 		}
 	} else {
 
@@ -246,15 +256,38 @@ func (x *BurnSignerRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 
 			toAddress, err := common.AddressBytesFromBech32(app.Config.Pocket.Bech32Prefix, doc.SenderAddress)
 			if err != nil {
-				log.Error("[BURN SIGNER] Error parsing to address: ", err)
-				return false
+				return fmt.Errorf("error parsing to address: %w", err)
+			}
+
+			memo := "InvalidMint: " + doc.TransactionHash
+
+			if app.Config.Verification.Enabled {
+				verified, err := x.VerifyReturnTransaction(ctx, models.CollectionInvalidMints, doc.TransactionHash, doc.Signatures, doc.ReturnTransactionBody, toAddress, amountCoin, memo)
+				if err != nil {
+					return fmt.Errorf("error verifying invalid mint: %w", err)
+				}
+				if !verified {
+					return errors.New("invalid mint not signed due to failed verification")
+				}
+			}
+
+			signingRequest := app.SigningRequest{
+				Kind:      app.SigningKindRefund,
+				Id:        "invalid-mint:" + doc.TransactionHash,
+				Sender:    doc.SenderAddress,
+				Recipient: doc.SenderAddress,
+				Amount:    amount,
+			}
+			reservation, signCtx, err := app.ReserveSigning(ctx, signingRequest)
+			if err != nil {
+				return fmt.Errorf("invalid mint not signed due to signing policy: %w", err)
 			}
 
-			set, err := x.Sign(doc.Sequence, doc.Signatures, doc.ReturnTransactionBody, toAddress, amountCoin, "InvalidMint: "+doc.TransactionHash)
+			set, err := x.Sign(signCtx, doc.Sequence, doc.Signatures, doc.ReturnTransactionBody, toAddress, amountCoin, memo)
 
 			if err != nil {
-				log.Error("[BURN SIGNER] Error signing invalid mint: ", err)
-				return false
+				reservation.Release()
+				return fmt.Errorf("error signing invalid mint: %w", err)
 			}
 
 			update = bson.M{
@@ -279,29 +312,33 @@ func (x *BurnSignerRunner) HandleInvalidMint(doc *models.InvalidMint) bool {
 	}
 
 	// lock only when updating sequence
+	updateCtx := ctx
 	if update["$set"].(bson.M)["sequence"] != nil {
-		if lockID, err := LockWriteSequence(); err != nil {
-			log.WithError(err).Error("[BURN SIGNER] Error locking sequence for invalid mints")
-			return false
-		} else {
-			//nolint:errcheck
-			defer app.DB.Unlock(lockID)
+		sequenceCtx, release, err := LockWriteSequence(ctx)
+		if err != nil {
+			return fmt.Errorf("error locking sequence for invalid mints: %w", err)
 		}
+		defer release()
+		updateCtx = sequenceCtx
 	}
 
-	_, err = app.DB.UpdateOne(models.CollectionInvalidMints, filter, update)
+	filter, update, err = app.Fence(updateCtx, filter, update, doc.FencingToken)
 	if err != nil {
-		log.Error("[BURN SIGNER] Error updating invalid mint: ", err)
-		return false
+		return fmt.Errorf("lock lost before updating invalid mint: %w", err)
+	}
+
+	err = updateWithSequence(updateCtx, models.CollectionInvalidMints, doc.Id, filter, update)
+	if err != nil {
+		return fmt.Errorf("error updating invalid mint: %w", err)
 	}
 	log.Info("[BURN SIGNER] Handled invalid mint: ", doc.TransactionHash)
-	return true
+	return nil
 }
 
-func (x *BurnSignerRunner) ValidateBurn(doc *models.Burn) (bool, error) {
+func (x *BurnSignerRunner) ValidateBurn(ctx context.Context, doc *models.Burn) (bool, error) {
 	log.Debug("[BURN SIGNER] Validating burn: ", doc.TransactionHash)
 
-	txReceipt, err := x.ethClient.GetTransactionReceipt(doc.TransactionHash)
+	txReceipt, err := x.ethClient.GetTransactionReceipt(ctx, doc.TransactionHash)
 
 	if err != nil {
 		return false, errors.New("Error fetching transaction receipt: " + err.Error())
@@ -359,25 +396,62 @@ func (x *BurnSignerRunner) ValidateBurn(doc *models.Burn) (bool, error) {
 	return true, nil
 }
 
-func (x *BurnSignerRunner) HandleBurn(doc *models.Burn) bool {
+func (x *BurnSignerRunner) IsBurnOrphaned(ctx context.Context, doc *models.Burn) (bool, error) {
+	blockNumber, err := strconv.ParseUint(doc.BlockNumber, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("error parsing block number: %w", err)
+	}
+	return eth.IsBlockOrphaned(ctx, x.ethClient, blockNumber, doc.BlockHash)
+}
+
+func (x *BurnSignerRunner) HandleOrphanedBurn(ctx context.Context, doc *models.Burn) error {
+	log.Warn("[BURN SIGNER] Burn block is no longer canonical, not signing burn: ", doc.TransactionHash)
+
+	filter := bson.M{
+		"_id":    doc.Id,
+		"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":     models.StatusOrphaned,
+			"updated_at": time.Now(),
+		},
+	}
+
+	_, err := app.DB.UpdateOne(ctx, models.CollectionBurns, filter, update)
+	if err != nil {
+		return fmt.Errorf("error marking burn as orphaned: %w", err)
+	}
+	log.Info("[BURN SIGNER] Marked burn as orphaned: ", doc.TransactionHash)
+	return nil
+}
+
+func (x *BurnSignerRunner) HandleBurn(ctx context.Context, doc *models.Burn) error {
 	if doc == nil {
-		log.Error("[BURN SIGNER] Burn is nil")
-		return false
+		return errors.New("burn is nil")
 	}
 	log.Debug("[BURN SIGNER] Handling burn: ", doc.TransactionHash)
 
 	doc, err := util.UpdateStatusAndConfirmationsForBurn(doc, x.ethBlockNumber)
 	if err != nil {
-		log.Error("[BURN SIGNER] Error getting burn status: ", err)
-		return false
+		return fmt.Errorf("error getting burn status: %w", err)
+	}
+
+	if doc.Status == models.StatusConfirmed && doc.BlockHash != "" {
+		orphaned, err := x.IsBurnOrphaned(ctx, doc)
+		if err != nil {
+			return fmt.Errorf("error checking burn block: %w", err)
+		}
+		if orphaned {
+			return x.HandleOrphanedBurn(ctx, doc)
+		}
 	}
 
 	var update bson.M
 
-	valid, err := x.ValidateBurn(doc)
+	valid, err := x.ValidateBurn(ctx, doc)
 	if err != nil {
-		log.Error("[BURN SIGNER] Error validating burn: ", err)
-		return false
+		return fmt.Errorf("error validating burn: %w", err)
 	}
 	if !valid {
 		log.Error("[BURN SIGNER] Burn failed validation")
@@ -388,8 +462,7 @@ func (x *BurnSignerRunner) HandleBurn(doc *models.Burn) bool {
 			},
 		}
 		if doc.Confirmations == "0" {
-			log.Debug("[BURN SIGNER] Burn has no confirmations, skipping")
-			return false
+			return fmt.Errorf("burn failed validation: %w", app.ErrNotConfirmed)
 		}
 	} else {
 
@@ -400,15 +473,38 @@ func (x *BurnSignerRunner) HandleBurn(doc *models.Burn) bool {
 
 			toAddress, err := common.AddressBytesFromBech32(app.Config.Pocket.Bech32Prefix, doc.RecipientAddress)
 			if err != nil {
-				log.Error("[BURN SIGNER] Error parsing to address: ", err)
-				return false
+				return fmt.Errorf("error parsing to address: %w", err)
 			}
 
-			set, err := x.Sign(doc.Sequence, doc.Signatures, doc.ReturnTransactionBody, toAddress, amountCoin, "Burn: "+doc.TransactionHash)
+			memo := "Burn: " + doc.TransactionHash
 
+			if app.Config.Verification.Enabled {
+				verified, err := x.VerifyReturnTransaction(ctx, models.CollectionBurns, doc.TransactionHash, doc.Signatures, doc.ReturnTransactionBody, toAddress, amountCoin, memo)
+				if err != nil {
+					return fmt.Errorf("error verifying burn: %w", err)
+				}
+				if !verified {
+					return errors.New("burn not signed due to failed verification")
+				}
+			}
+
+			signingRequest := app.SigningRequest{
+				Kind:      app.SigningKindRefund,
+				Id:        "burn:" + doc.TransactionHash + ":" + doc.LogIndex,
+				Sender:    doc.SenderAddress,
+				Recipient: doc.RecipientAddress,
+				Amount:    amount,
+			}
+			reservation, signCtx, err := app.ReserveSigning(ctx, signingRequest)
 			if err != nil {
-				log.Error("[BURN SIGNER] Error signing invalid mint: ", err)
-				return false
+				return fmt.Errorf("burn not signed due to signing policy: %w", err)
+			}
+
+			set, err := x.Sign(signCtx, doc.Sequence, doc.Signatures, doc.ReturnTransactionBody, toAddress, amountCoin, memo)
+
+			if err != nil {
+				reservation.Release()
+				return fmt.Errorf("error signing burn: %w", err)
 			}
 
 			update = bson.M{
@@ -427,31 +523,41 @@ func (x *BurnSignerRunner) HandleBurn(doc *models.Burn) bool {
 	}
 
 	// lock only when updating sequence
+	updateCtx := ctx
 	if update["$set"].(bson.M)["sequence"] != nil {
-		if lockID, err := LockWriteSequence(); err != nil {
-			log.WithError(err).Error("[BURN SIGNER] Error locking sequence for burns")
-			return false
-		} else {
-			//nolint:errcheck
-			defer app.DB.Unlock(lockID)
+		sequenceCtx, release, err := LockWriteSequence(ctx)
+		if err != nil {
+			return fmt.Errorf("error locking sequence for burns: %w", err)
 		}
+		defer release()
+		updateCtx = sequenceCtx
 	}
 
 	filter := bson.M{
 		"_id":    doc.Id,
 		"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
 	}
-	_, err = app.DB.UpdateOne(models.CollectionBurns, filter, update)
+	filter, update, err = app.Fence(updateCtx, filter, update, doc.FencingToken)
 	if err != nil {
-		log.Error("[BURN SIGNER] Error updating burn: ", err)
-		return false
+		return fmt.Errorf("lock lost before updating burn: %w", err)
+	}
+
+	err = updateWithSequence(updateCtx, models.CollectionBurns, doc.Id, filter, update)
+	if err != nil {
+		return fmt.Errorf("error updating burn: %w", err)
 	}
 	log.Info("[BURN SIGNER] Handled burn: ", doc.TransactionHash)
 
-	return true
+	return nil
 }
 
-func (x *BurnSignerRunner) SyncInvalidMints() bool {
+// giveUpQuorum is how many multisig members must give up on an invalid mint or burn before it needs attention
+func (x *BurnSignerRunner) giveUpQuorum() int64 {
+	config := app.Config.Pocket
+	return app.GiveUpQuorum(int64(len(config.MultisigPublicKeys)), int64(config.MultisigThreshold))
+}
+
+func (x *BurnSignerRunner) SyncInvalidMints(ctx context.Context) bool {
 	log.Debug("[BURN SIGNER] Syncing invalid mints")
 
 	addressHex, _ := common.AddressHexFromBytes(x.signer.Signer.CosmosPublicKey().Address().Bytes())
@@ -473,7 +579,7 @@ func (x *BurnSignerRunner) SyncInvalidMints() bool {
 	}
 
 	invalidMints := []models.InvalidMint{}
-	err := app.DB.FindMany(models.CollectionInvalidMints, filter, &invalidMints)
+	err := app.DB.FindMany(ctx, models.CollectionInvalidMints, filter, &invalidMints)
 	if err != nil {
 		log.Error("[BURN SIGNER] Error fetching invalid mints: ", err)
 		return false
@@ -484,8 +590,14 @@ func (x *BurnSignerRunner) SyncInvalidMints() bool {
 	for i := range invalidMints {
 		doc := invalidMints[i]
 
+		retry := doc.Retries[addressHex]
+		if !app.RetryDue(retry) {
+			log.Debug("[BURN SIGNER] Skipping invalid mint until its next retry: ", doc.TransactionHash)
+			continue
+		}
+
 		resourceId := fmt.Sprintf("%s/%s", models.CollectionInvalidMints, doc.Id.Hex())
-		lockId, err := app.DB.XLock(resourceId)
+		lock, lockCtx, err := app.AcquireLock(ctx, resourceId)
 		if err != nil {
 			log.Error("[BURN SIGNER] Error locking invalid mint: ", err)
 			success = false
@@ -493,9 +605,17 @@ func (x *BurnSignerRunner) SyncInvalidMints() bool {
 		}
 		log.Debug("[BURN SIGNER] Locked invalid mint: ", doc.TransactionHash)
 
-		success = x.HandleInvalidMint(&doc) && success
+		err = x.HandleInvalidMint(lockCtx, &doc)
+		if err != nil {
+			log.Error("[BURN SIGNER] Error handling invalid mint: ", err)
+			success = false
+		}
+		if err = app.RecordAttempt(lockCtx, models.CollectionInvalidMints, doc.Id, addressHex, retry, x.giveUpQuorum(), err); err != nil {
+			log.Error("[BURN SIGNER] Error recording attempt at invalid mint: ", err)
+			success = false
+		}
 
-		if err = app.DB.Unlock(lockId); err != nil {
+		if err = lock.Release(ctx); err != nil {
 			log.Error("[BURN SIGNER] Error unlocking invalid mint: ", err)
 			success = false
 		} else {
@@ -508,7 +628,7 @@ func (x *BurnSignerRunner) SyncInvalidMints() bool {
 	return success
 }
 
-func (x *BurnSignerRunner) SyncBurns() bool {
+func (x *BurnSignerRunner) SyncBurns(ctx context.Context) bool {
 	log.Debug("[BURN SIGNER] Syncing burns")
 
 	addressHex, _ := common.AddressHexFromBytes(x.signer.Signer.CosmosPublicKey().Address().Bytes())
@@ -530,7 +650,7 @@ func (x *BurnSignerRunner) SyncBurns() bool {
 	}
 
 	burns := []models.Burn{}
-	err := app.DB.FindMany(models.CollectionBurns, filter, &burns)
+	err := app.DB.FindMany(ctx, models.CollectionBurns, filter, &burns)
 	if err != nil {
 		log.Error("[BURN SIGNER] Error fetching burns: ", err)
 		return false
@@ -542,8 +662,14 @@ func (x *BurnSignerRunner) SyncBurns() bool {
 	for i := range burns {
 		doc := burns[i]
 
+		retry := doc.Retries[addressHex]
+		if !app.RetryDue(retry) {
+			log.Debug("[BURN SIGNER] Skipping burn until its next retry: ", doc.TransactionHash)
+			continue
+		}
+
 		resourceId := fmt.Sprintf("%s/%s", models.CollectionBurns, doc.Id.Hex())
-		lockId, err := app.DB.XLock(resourceId)
+		lock, lockCtx, err := app.AcquireLock(ctx, resourceId)
 		if err != nil {
 			log.Error("[BURN SIGNER] Error locking burn: ", err)
 			success = false
@@ -551,9 +677,17 @@ func (x *BurnSignerRunner) SyncBurns() bool {
 		}
 		log.Debug("[BURN SIGNER] Locked burn: ", doc.TransactionHash)
 
-		success = x.HandleBurn(&doc) && success
+		err = x.HandleBurn(lockCtx, &doc)
+		if err != nil {
+			log.Error("[BURN SIGNER] Error handling burn: ", err)
+			success = false
+		}
+		if err = app.RecordAttempt(lockCtx, models.CollectionBurns, doc.Id, addressHex, retry, x.giveUpQuorum(), err); err != nil {
+			log.Error("[BURN SIGNER] Error recording attempt at burn: ", err)
+			success = false
+		}
 
-		if err = app.DB.Unlock(lockId); err != nil {
+		if err = lock.Release(ctx); err != nil {
 			log.Error("[BURN SIGNER] Error unlocking burn: ", err)
 			success = false
 		} else {
@@ -566,29 +700,81 @@ func (x *BurnSignerRunner) SyncBurns() bool {
 	return success
 }
 
-func (x *BurnSignerRunner) SyncTxs() bool {
+// SyncOrphanedBurns rechecks the blocks of orphaned burns and moves a burn whose block is canonical again back to pending,
+// so a burn orphaned in error is not lost. A burn included again in another block is restored by the Burn Monitor.
+func (x *BurnSignerRunner) SyncOrphanedBurns(ctx context.Context) bool {
+	log.Debug("[BURN SIGNER] Syncing orphaned burns")
+
+	filter := bson.M{
+		"wpokt_address": x.wpoktAddress,
+		"status":        models.StatusOrphaned,
+	}
+
+	burns := []models.Burn{}
+	err := app.DB.FindMany(ctx, models.CollectionBurns, filter, &burns)
+	if err != nil {
+		log.Error("[BURN SIGNER] Error fetching orphaned burns: ", err)
+		return false
+	}
+
+	var success = true
+	for i := range burns {
+		doc := burns[i]
+
+		orphaned, err := x.IsBurnOrphaned(ctx, &doc)
+		if err != nil {
+			log.Error("[BURN SIGNER] Error rechecking orphaned burn block: ", err)
+			success = false
+			continue
+		}
+		if orphaned {
+			continue
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"status":        models.StatusPending,
+				"confirmations": "0",
+				"updated_at":    time.Now(),
+			},
+		}
+		_, err = app.DB.UpdateOne(ctx, models.CollectionBurns, bson.M{"_id": doc.Id, "status": models.StatusOrphaned}, update)
+		if err != nil {
+			log.Error("[BURN SIGNER] Error restoring orphaned burn: ", err)
+			success = false
+			continue
+		}
+		log.Warn("[BURN SIGNER] Orphaned burn block is canonical, restored burn: ", doc.TransactionHash)
+	}
+
+	return success
+}
+
+func (x *BurnSignerRunner) SyncTxs(ctx context.Context) bool {
 	log.Debug("[BURN SIGNER] Syncing")
 
-	success := x.SyncInvalidMints()
-	success = x.SyncBurns() && success
+	success := x.SyncInvalidMints(ctx)
+	success = x.SyncOrphanedBurns(ctx) && success
+	success = x.SyncBurns(ctx) && success
 
 	log.Info("[BURN SIGNER] Synced txs")
 	return success
 }
 
-func (x *BurnSignerRunner) UpdateMaxMintLimit() {
+func (x *BurnSignerRunner) UpdateMaxMintLimit(ctx context.Context) error {
 	log.Debug("[BURN SIGNER] Fetching mint controller max mint limit")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
 	defer cancel()
 	opts := &bind.CallOpts{Context: ctx, Pending: false}
 	mintLimit, err := x.mintControllerContract.MaxMintLimit(opts)
 
 	if err != nil {
 		log.Error("[BURN SIGNER] Error fetching mint controller max mint limit: ", err)
-		return
+		return fmt.Errorf("error fetching mint controller max mint limit: %w", err)
 	}
 	log.Debug("[BURN SIGNER] Fetched mint controller max mint limit")
 	x.maximumAmount = math.NewIntFromBigInt(mintLimit)
+	return nil
 }
 
 func NewBurnSigner(wg *sync.WaitGroup, health models.ServiceHealth) app.Service {
@@ -639,9 +825,9 @@ func NewBurnSigner(wg *sync.WaitGroup, health models.ServiceHealth) app.Service
 		minimumAmount:          math.NewIntFromUint64(uint64(app.Config.Pocket.TxFee)),
 	}
 
-	x.UpdateBlocks()
+	x.UpdateBlocks(context.Background())
 
-	x.UpdateMaxMintLimit()
+	x.UpdateMaxMintLimit(context.Background())
 
 	if x.maximumAmount.LT(x.minimumAmount) {
 		log.Fatal("[MINT MONITOR] Invalid max mint limit")
@@ -1,6 +1,7 @@
 package cosmos
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -95,10 +96,10 @@ func TestBurnSignerUpdateBlocks(t *testing.T) {
 		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 		x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
 
-		mockCosmosClient.EXPECT().GetLatestBlockHeight().Return(200, nil)
-		mockEthClient.EXPECT().GetBlockNumber().Return(uint64(200), nil)
+		mockCosmosClient.EXPECT().GetLatestBlockHeight(mock.Anything).Return(200, nil)
+		mockEthClient.EXPECT().GetBlockNumber(mock.Anything).Return(uint64(200), nil)
 
-		x.UpdateBlocks()
+		x.UpdateBlocks(context.Background())
 
 		assert.Equal(t, x.cosmosHeight, int64(200))
 		assert.Equal(t, x.ethBlockNumber, int64(200))
@@ -111,9 +112,9 @@ func TestBurnSignerUpdateBlocks(t *testing.T) {
 		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 		x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
 
-		mockCosmosClient.EXPECT().GetLatestBlockHeight().Return(200, errors.New("error"))
+		mockCosmosClient.EXPECT().GetLatestBlockHeight(mock.Anything).Return(200, errors.New("error"))
 
-		x.UpdateBlocks()
+		x.UpdateBlocks(context.Background())
 
 		assert.Equal(t, x.cosmosHeight, int64(0))
 		assert.Equal(t, x.ethBlockNumber, int64(0))
@@ -126,10 +127,10 @@ func TestBurnSignerUpdateBlocks(t *testing.T) {
 		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 		x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
 
-		mockCosmosClient.EXPECT().GetLatestBlockHeight().Return(200, nil)
-		mockEthClient.EXPECT().GetBlockNumber().Return(uint64(200), errors.New("error"))
+		mockCosmosClient.EXPECT().GetLatestBlockHeight(mock.Anything).Return(200, nil)
+		mockEthClient.EXPECT().GetBlockNumber(mock.Anything).Return(uint64(200), errors.New("error"))
 
-		x.UpdateBlocks()
+		x.UpdateBlocks(context.Background())
 
 		assert.Equal(t, x.cosmosHeight, int64(200))
 		assert.Equal(t, x.ethBlockNumber, int64(0))
@@ -149,9 +150,9 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 
 		mint := &models.InvalidMint{}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(nil, errors.New("error"))
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(nil, errors.New("error"))
 
-		valid, err := x.ValidateInvalidMint(mint)
+		valid, err := x.ValidateInvalidMint(context.Background(), mint)
 
 		assert.False(t, valid)
 		assert.NotNil(t, err)
@@ -170,9 +171,9 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 
 		txResponse := &sdk.TxResponse{}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
 
-		valid, err := x.ValidateInvalidMint(mint)
+		valid, err := x.ValidateInvalidMint(context.Background(), mint)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -191,7 +192,7 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 
 		txResponse := &sdk.TxResponse{}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
 
 		result := &util.ValidateTxResult{
 			Memo:          models.MintMemo{},
@@ -209,7 +210,7 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		valid, err := x.ValidateInvalidMint(mint)
+		valid, err := x.ValidateInvalidMint(context.Background(), mint)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -231,7 +232,7 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 
 		txResponse := &sdk.TxResponse{}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
 
 		result := &util.ValidateTxResult{
 			Memo:          models.MintMemo{},
@@ -249,7 +250,7 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		valid, err := x.ValidateInvalidMint(mint)
+		valid, err := x.ValidateInvalidMint(context.Background(), mint)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -272,7 +273,7 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 		app.Config.Ethereum.ChainID = "31337"
 
 		txResponse := &sdk.TxResponse{}
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
 
 		result := &util.ValidateTxResult{
 			Memo:          models.MintMemo{},
@@ -290,7 +291,7 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		valid, err := x.ValidateInvalidMint(mint)
+		valid, err := x.ValidateInvalidMint(context.Background(), mint)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -312,7 +313,7 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 		}
 
 		txResponse := &sdk.TxResponse{}
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
 
 		result := &util.ValidateTxResult{
 			Memo:          models.MintMemo{},
@@ -330,7 +331,7 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		valid, err := x.ValidateInvalidMint(mint)
+		valid, err := x.ValidateInvalidMint(context.Background(), mint)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -356,7 +357,7 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 		app.Config.Ethereum.ChainID = "31337"
 
 		txResponse := &sdk.TxResponse{}
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
 
 		result := &util.ValidateTxResult{
 			Memo:          models.MintMemo{},
@@ -374,7 +375,7 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		valid, err := x.ValidateInvalidMint(mint)
+		valid, err := x.ValidateInvalidMint(context.Background(), mint)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -399,7 +400,7 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 
 		txResponse := &sdk.TxResponse{}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
 
 		result := &util.ValidateTxResult{
 			Memo:          models.MintMemo{},
@@ -417,7 +418,7 @@ func TestBurnSignerValidateInvalidMint(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		valid, err := x.ValidateInvalidMint(mint)
+		valid, err := x.ValidateInvalidMint(context.Background(), mint)
 
 		assert.True(t, valid)
 		assert.Nil(t, err)
@@ -438,9 +439,9 @@ func TestBurnSignerValidateBurn(t *testing.T) {
 
 		burn := &models.Burn{}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(nil, errors.New("error"))
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(nil, errors.New("error"))
 
-		valid, err := x.ValidateBurn(burn)
+		valid, err := x.ValidateBurn(context.Background(), burn)
 
 		assert.False(t, valid)
 		assert.NotNil(t, err)
@@ -461,9 +462,9 @@ func TestBurnSignerValidateBurn(t *testing.T) {
 
 		txReceipt := &types.Receipt{}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 
-		valid, err := x.ValidateBurn(burn)
+		valid, err := x.ValidateBurn(context.Background(), burn)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -484,9 +485,9 @@ func TestBurnSignerValidateBurn(t *testing.T) {
 
 		txReceipt := &types.Receipt{}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 
-		valid, err := x.ValidateBurn(burn)
+		valid, err := x.ValidateBurn(context.Background(), burn)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -511,10 +512,10 @@ func TestBurnSignerValidateBurn(t *testing.T) {
 			},
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(nil, errors.New("error"))
 
-		valid, err := x.ValidateBurn(burn)
+		valid, err := x.ValidateBurn(context.Background(), burn)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -542,10 +543,10 @@ func TestBurnSignerValidateBurn(t *testing.T) {
 			Amount: big.NewInt(10),
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
-		valid, err := x.ValidateBurn(burn)
+		valid, err := x.ValidateBurn(context.Background(), burn)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -573,10 +574,10 @@ func TestBurnSignerValidateBurn(t *testing.T) {
 			Amount: big.NewInt(200000),
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
-		valid, err := x.ValidateBurn(burn)
+		valid, err := x.ValidateBurn(context.Background(), burn)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -606,10 +607,10 @@ func TestBurnSignerValidateBurn(t *testing.T) {
 			From:   common.HexToAddress("0x1234"),
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
-		valid, err := x.ValidateBurn(burn)
+		valid, err := x.ValidateBurn(context.Background(), burn)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -643,10 +644,10 @@ func TestBurnSignerValidateBurn(t *testing.T) {
 			PoktAddress: common.HexToAddress("0x1234"),
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
-		valid, err := x.ValidateBurn(burn)
+		valid, err := x.ValidateBurn(context.Background(), burn)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -680,10 +681,10 @@ func TestBurnSignerValidateBurn(t *testing.T) {
 			PoktAddress: common.HexToAddress("0x2345"),
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
-		valid, err := x.ValidateBurn(burn)
+		valid, err := x.ValidateBurn(context.Background(), burn)
 
 		assert.True(t, valid)
 		assert.Nil(t, err)
@@ -703,9 +704,9 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 		x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
 
-		success := x.HandleInvalidMint(nil)
+		err := x.HandleInvalidMint(context.Background(), nil)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Error updating confirmations", func(t *testing.T) {
@@ -724,9 +725,9 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 			Height:        "invalid",
 		}
 
-		success := x.HandleInvalidMint(invalidMint)
+		err := x.HandleInvalidMint(context.Background(), invalidMint)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Error validating", func(t *testing.T) {
@@ -746,16 +747,17 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 			Height:        "99",
 		}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(nil, errors.New("error"))
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(nil, errors.New("error"))
 
-		success := x.HandleInvalidMint(invalidMint)
+		err := x.HandleInvalidMint(context.Background(), invalidMint)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Validation failure and update successful", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -790,21 +792,22 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 			},
 		}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(invalidMint)
+		err := x.HandleInvalidMint(context.Background(), invalidMint)
 
-		assert.True(t, success)
+		assert.Nil(t, err)
 	})
 
 	t.Run("Validation failure and update failed", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -839,16 +842,16 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 			},
 		}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), errors.New("error")).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), errors.New("error")).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleInvalidMint(invalidMint)
+		err := x.HandleInvalidMint(context.Background(), invalidMint)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Validation successful and invalid mint confirmed and signing failed", func(t *testing.T) {
@@ -892,15 +895,17 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
-		success := x.HandleInvalidMint(invalidMint)
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
+		err := x.HandleInvalidMint(context.Background(), invalidMint)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Validation successful and invalid mint confirmed and update successful", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
+		mockDB.EXPECT().CountDocuments(mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -944,9 +949,9 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 			},
 		}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
@@ -969,6 +974,7 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 
 		oldCosmosSignTx := CosmosSignTx
 		CosmosSignTx = func(
+			_ context.Context,
 			signerKey common.Signer,
 			config models.CosmosConfig,
 			client cosmos.CosmosClient,
@@ -983,38 +989,37 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 		}
 		defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-		mockDB.EXPECT().Unlock(mock.Anything).Return(nil)
-
 		oldLockReadSequences := LockReadSequences
-		LockReadSequences = func() (string, error) {
-			return "lock-id", nil
+		LockReadSequences = func(ctx context.Context) (context.Context, func(), error) {
+			return ctx, func() {}, nil
 		}
 		defer func() { LockReadSequences = oldLockReadSequences }()
 
-		// mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		// mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
 
 		oldLockWriteSequence := LockWriteSequence
-		LockWriteSequence = func() (string, error) {
-			return "lockId", nil
+		LockWriteSequence = func(ctx context.Context) (context.Context, func(), error) {
+			return ctx, func() {}, nil
 		}
 		defer func() { LockWriteSequence = oldLockWriteSequence }()
 
 		oldFindMaxSequence := FindMaxSequence
-		FindMaxSequence = func() (*uint64, error) {
+		FindMaxSequence = func(context.Context) (*uint64, error) {
 			return nil, nil
 		}
 		defer func() { FindMaxSequence = oldFindMaxSequence }()
 
-		mockCosmosClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
+		mockCosmosClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
 
-		success := x.HandleInvalidMint(invalidMint)
+		err := x.HandleInvalidMint(context.Background(), invalidMint)
 
-		assert.True(t, success)
+		assert.Nil(t, err)
 	})
 
 	t.Run("Validation successful and invalid mint pending and update successful", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -1049,9 +1054,9 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 			},
 		}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
@@ -1072,9 +1077,9 @@ func TestBurnSignerHandleInvalidMint(t *testing.T) {
 		}
 		defer func() { utilValidateTxToCosmosMultisig = oldValidateTxToCosmosMultisig }()
 
-		success := x.HandleInvalidMint(invalidMint)
+		err := x.HandleInvalidMint(context.Background(), invalidMint)
 
-		assert.True(t, success)
+		assert.Nil(t, err)
 	})
 
 }
@@ -1090,9 +1095,9 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 		x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
 
-		success := x.HandleBurn(nil)
+		err := x.HandleBurn(context.Background(), nil)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Error updating confirmations", func(t *testing.T) {
@@ -1111,9 +1116,9 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 			BlockNumber:   "invalid",
 		}
 
-		success := x.HandleBurn(burn)
+		err := x.HandleBurn(context.Background(), burn)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Error validating", func(t *testing.T) {
@@ -1133,16 +1138,100 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 			BlockNumber:   "99",
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(nil, errors.New("error"))
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(nil, errors.New("error"))
 
-		success := x.HandleBurn(burn)
+		err := x.HandleBurn(context.Background(), burn)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Burn block orphaned", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
+		mockMintController := ethMocks.NewMockMintControllerContract(t)
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+		x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
+
+		x.ethBlockNumber = 100
+		app.Config.Ethereum.Confirmations = 0
+
+		burn := &models.Burn{
+			Confirmations: "1",
+			BlockNumber:   "99",
+			BlockHash:     "0xabcd",
+			Status:        models.StatusConfirmed,
+		}
+
+		mockEthClient.EXPECT().GetBlockHash(mock.Anything, uint64(99)).Return("0xdcba", nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				assert.Equal(t, models.StatusOrphaned, update.(bson.M)["$set"].(bson.M)["status"])
+			})
+
+		err := x.HandleBurn(context.Background(), burn)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Error checking burn block", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
+		mockMintController := ethMocks.NewMockMintControllerContract(t)
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+		x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
+
+		x.ethBlockNumber = 100
+		app.Config.Ethereum.Confirmations = 0
+
+		burn := &models.Burn{
+			Confirmations: "1",
+			BlockNumber:   "99",
+			BlockHash:     "0xabcd",
+			Status:        models.StatusConfirmed,
+		}
+
+		mockEthClient.EXPECT().GetBlockHash(mock.Anything, uint64(99)).Return("", errors.New("error"))
+
+		err := x.HandleBurn(context.Background(), burn)
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Burn block canonical and validation error", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
+		mockMintController := ethMocks.NewMockMintControllerContract(t)
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+		x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
+
+		x.ethBlockNumber = 100
+		app.Config.Ethereum.Confirmations = 0
+
+		burn := &models.Burn{
+			Confirmations: "1",
+			BlockNumber:   "99",
+			BlockHash:     "0xabcd",
+			Status:        models.StatusConfirmed,
+		}
+
+		mockEthClient.EXPECT().GetBlockHash(mock.Anything, uint64(99)).Return("0xABCD", nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(nil, errors.New("error"))
+
+		err := x.HandleBurn(context.Background(), burn)
+
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Validation failure and update successful", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -1164,7 +1253,7 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 			Logs: []*types.Log{{}},
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 
 		filter := bson.M{
 			"_id":    burn.Id,
@@ -1178,20 +1267,21 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(burn)
+		err := x.HandleBurn(context.Background(), burn)
 
-		assert.True(t, success)
+		assert.Nil(t, err)
 	})
 
 	t.Run("Validation failure and update failed", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -1213,7 +1303,7 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 			Logs: []*types.Log{{}},
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 
 		filter := bson.M{
 			"_id":    burn.Id,
@@ -1227,20 +1317,21 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), errors.New("error")).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), errors.New("error")).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(burn)
+		err := x.HandleBurn(context.Background(), burn)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Validation successful and burn confirmed and signing failed", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -1273,11 +1364,12 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 			PoktAddress: common.HexToAddress("0x2345"),
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
 		oldCosmosSignTx := CosmosSignTx
 		CosmosSignTx = func(
+			_ context.Context,
 			signerKey common.Signer,
 			config models.CosmosConfig,
 			client cosmos.CosmosClient,
@@ -1292,32 +1384,77 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 		}
 		defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-		mockDB.EXPECT().Unlock("lock-id").Return(nil)
-
 		oldLockReadSequences := LockReadSequences
-		LockReadSequences = func() (string, error) {
-			return "lock-id", nil
+		LockReadSequences = func(ctx context.Context) (context.Context, func(), error) {
+			return ctx, func() {}, nil
 		}
 		defer func() { LockReadSequences = oldLockReadSequences }()
 
 		oldFindMaxSequence := FindMaxSequence
-		FindMaxSequence = func() (*uint64, error) {
+		FindMaxSequence = func(context.Context) (*uint64, error) {
 			return nil, nil
 		}
 		defer func() { FindMaxSequence = oldFindMaxSequence }()
 
 		sequence := uint64(2)
 
-		mockCosmosClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
+		mockCosmosClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
 
-		success := x.HandleBurn(burn)
+		err := x.HandleBurn(context.Background(), burn)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Validation successful and burn confirmed and rejected by signing policy", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
+		mockMintController := ethMocks.NewMockMintControllerContract(t)
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+		x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
+
+		x.ethBlockNumber = 100
+		app.Config.Ethereum.Confirmations = 0
+		app.Config.Ethereum.ChainID = "31337"
+
+		recipient, _ := common.Bech32FromBytes("pokt", common.HexToAddress("0x2345").Bytes())
+		app.Config.SigningPolicy.Refund.DeniedRecipients = []string{recipient}
+		defer func() { app.Config.SigningPolicy.Refund.DeniedRecipients = nil }()
+
+		burn := &models.Burn{
+			Confirmations:    "1",
+			BlockNumber:      "99",
+			Status:           models.StatusPending,
+			LogIndex:         "0",
+			Amount:           "20000",
+			SenderAddress:    common.HexToAddress("0x1234").Hex(),
+			RecipientAddress: recipient,
+		}
+
+		txReceipt := &types.Receipt{
+			Logs: []*types.Log{{}},
+		}
+
+		event := &autogen.WrappedPocketBurnAndBridge{
+			Amount:      big.NewInt(20000),
+			From:        common.HexToAddress("0x1234"),
+			PoktAddress: common.HexToAddress("0x2345"),
+		}
+
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
+		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
+
+		err := x.HandleBurn(context.Background(), burn)
+
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Validation successful and burn confirmed and update successful", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
+		mockDB.EXPECT().CountDocuments(mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -1352,11 +1489,12 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 			PoktAddress: common.HexToAddress("0x2345"),
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
 		oldCosmosSignTx := CosmosSignTx
 		CosmosSignTx = func(
+			_ context.Context,
 			signerKey common.Signer,
 			config models.CosmosConfig,
 			client cosmos.CosmosClient,
@@ -1371,31 +1509,29 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 		}
 		defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-		mockDB.EXPECT().Unlock(mock.Anything).Return(nil)
-
 		oldLockReadSequences := LockReadSequences
-		LockReadSequences = func() (string, error) {
-			return "lock-id", nil
+		LockReadSequences = func(ctx context.Context) (context.Context, func(), error) {
+			return ctx, func() {}, nil
 		}
 		defer func() { LockReadSequences = oldLockReadSequences }()
 
-		// mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		// mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
 
 		oldLockWriteSequence := LockWriteSequence
-		LockWriteSequence = func() (string, error) {
-			return "lockId", nil
+		LockWriteSequence = func(ctx context.Context) (context.Context, func(), error) {
+			return ctx, func() {}, nil
 		}
 		defer func() { LockWriteSequence = oldLockWriteSequence }()
 
 		oldFindMaxSequence := FindMaxSequence
-		FindMaxSequence = func() (*uint64, error) {
+		FindMaxSequence = func(context.Context) (*uint64, error) {
 			return nil, nil
 		}
 		defer func() { FindMaxSequence = oldFindMaxSequence }()
 
 		sequence := uint64(2)
 
-		mockCosmosClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
+		mockCosmosClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
 
 		filter := bson.M{
 			"_id":    burn.Id,
@@ -1412,20 +1548,21 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(burn)
+		err := x.HandleBurn(context.Background(), burn)
 
-		assert.True(t, success)
+		assert.Nil(t, err)
 	})
 
 	t.Run("Validation successful and burn pending and update successful", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -1457,7 +1594,7 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 			PoktAddress: common.HexToAddress("0x2345"),
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
 		filter := bson.M{
@@ -1473,15 +1610,15 @@ func TestBurnSignerHandleBurn(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleBurn(burn)
+		err := x.HandleBurn(context.Background(), burn)
 
-		assert.True(t, success)
+		assert.Nil(t, err)
 	})
 
 }
@@ -1497,9 +1634,9 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 		x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
 
-		mockDB.EXPECT().FindMany(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"))
+		mockDB.EXPECT().FindMany(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"))
 
-		success := x.SyncInvalidMints()
+		success := x.SyncInvalidMints(context.Background())
 
 		assert.False(t, success)
 
@@ -1532,9 +1669,9 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filter, mock.Anything).Return(nil)
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionInvalidMints, filter, mock.Anything).Return(nil)
 
-		success := x.SyncInvalidMints()
+		success := x.SyncInvalidMints(context.Background())
 
 		assert.True(t, success)
 	})
@@ -1566,8 +1703,8 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.InvalidMint)
 				*v = []models.InvalidMint{
 					{
@@ -1576,8 +1713,8 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", errors.New("error"))
-		success := x.SyncInvalidMints()
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", errors.New("error"))
+		success := x.SyncInvalidMints(context.Background())
 
 		assert.False(t, success)
 
@@ -1586,6 +1723,8 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 	t.Run("Error unlocking", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
+		mockDB.EXPECT().CountDocuments(mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -1628,13 +1767,14 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 				"return_transaction_body": "encoded tx",
 				"signatures":              []models.Signature{{}},
 				"sequence":                &sequence,
+				"fencing_token":           int64(1),
 				"updated_at":              time.Now(),
 			},
 		}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, fenced(filter), mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
@@ -1657,6 +1797,7 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 
 		oldCosmosSignTx := CosmosSignTx
 		CosmosSignTx = func(
+			_ context.Context,
 			signerKey common.Signer,
 			config models.CosmosConfig,
 			client cosmos.CosmosClient,
@@ -1671,21 +1812,19 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 		}
 		defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-		mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
-
 		oldLockReadSequences := LockReadSequences
-		LockReadSequences = func() (string, error) {
-			return "lock-id", nil
+		LockReadSequences = func(ctx context.Context) (context.Context, func(), error) {
+			return ctx, func() {}, nil
 		}
 		defer func() { LockReadSequences = oldLockReadSequences }()
 
 		oldFindMaxSequence := FindMaxSequence
-		FindMaxSequence = func() (*uint64, error) {
+		FindMaxSequence = func(context.Context) (*uint64, error) {
 			return nil, nil
 		}
 		defer func() { FindMaxSequence = oldFindMaxSequence }()
 
-		mockCosmosClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
+		mockCosmosClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
 
 		addressHex, _ := common.AddressHexFromBytes(x.signer.Signer.CosmosPublicKey().Address().Bytes())
 		filterFind := bson.M{
@@ -1704,19 +1843,20 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 				}},
 			},
 		}
-		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.InvalidMint)
 				*v = []models.InvalidMint{
 					*invalidMint,
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
 
-		mockDB.EXPECT().Unlock("lockId").Return(errors.New("error"))
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(errors.New("error"))
 
-		success := x.SyncInvalidMints()
+		success := x.SyncInvalidMints(context.Background())
 
 		assert.False(t, success)
 	})
@@ -1724,6 +1864,8 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 	t.Run("Successful case", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
+		mockDB.EXPECT().CountDocuments(mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -1766,13 +1908,14 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 				"return_transaction_body": "encoded tx",
 				"signatures":              []models.Signature{{}},
 				"sequence":                &sequence,
+				"fencing_token":           int64(1),
 				"updated_at":              time.Now(),
 			},
 		}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, fenced(filter), mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
@@ -1795,6 +1938,7 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 
 		oldCosmosSignTx := CosmosSignTx
 		CosmosSignTx = func(
+			_ context.Context,
 			signerKey common.Signer,
 			config models.CosmosConfig,
 			client cosmos.CosmosClient,
@@ -1809,29 +1953,30 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 		}
 		defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-		mockDB.EXPECT().Unlock(mock.Anything).Return(nil)
+		mockDB.EXPECT().Unlock(mock.Anything, mock.Anything).Return(nil)
 
 		oldLockReadSequences := LockReadSequences
-		LockReadSequences = func() (string, error) {
-			return "lock-id", nil
+		LockReadSequences = func(ctx context.Context) (context.Context, func(), error) {
+			return ctx, func() {}, nil
 		}
 		defer func() { LockReadSequences = oldLockReadSequences }()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
 
 		oldLockWriteSequence := LockWriteSequence
-		LockWriteSequence = func() (string, error) {
-			return "lockId", nil
+		LockWriteSequence = func(ctx context.Context) (context.Context, func(), error) {
+			return ctx, func() {}, nil
 		}
 		defer func() { LockWriteSequence = oldLockWriteSequence }()
 
 		oldFindMaxSequence := FindMaxSequence
-		FindMaxSequence = func() (*uint64, error) {
+		FindMaxSequence = func(context.Context) (*uint64, error) {
 			return nil, nil
 		}
 		defer func() { FindMaxSequence = oldFindMaxSequence }()
 
-		mockCosmosClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
+		mockCosmosClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
 
 		addressHex, _ := common.AddressHexFromBytes(x.signer.Signer.CosmosPublicKey().Address().Bytes())
 		filterFind := bson.M{
@@ -1850,15 +1995,15 @@ func TestBurnSignerSyncInvalidMints(t *testing.T) {
 				}},
 			},
 		}
-		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.InvalidMint)
 				*v = []models.InvalidMint{
 					*invalidMint,
 				}
 			})
 
-		success := x.SyncInvalidMints()
+		success := x.SyncInvalidMints(context.Background())
 
 		assert.True(t, success)
 	})
@@ -1875,9 +2020,9 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 		x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
 
-		mockDB.EXPECT().FindMany(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"))
+		mockDB.EXPECT().FindMany(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"))
 
-		success := x.SyncBurns()
+		success := x.SyncBurns(context.Background())
 
 		assert.False(t, success)
 
@@ -1910,9 +2055,34 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionBurns, filter, mock.Anything).Return(nil)
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, filter, mock.Anything).Return(nil)
 
-		success := x.SyncBurns()
+		success := x.SyncBurns(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("Skips burn until next retry", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
+		mockMintController := ethMocks.NewMockMintControllerContract(t)
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+		x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
+
+		addressHex, _ := common.AddressHexFromBytes(x.signer.Signer.CosmosPublicKey().Address().Bytes())
+		nextRetryAt := time.Now().Add(time.Minute)
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				v := result.(*[]models.Burn)
+				*v = []models.Burn{
+					{Retries: map[string]models.Retry{addressHex: {Attempts: 1, NextRetryAt: &nextRetryAt}}},
+					{Retries: map[string]models.Retry{addressHex: {Attempts: 10, NeedsAttention: true}}},
+				}
+			})
+
+		success := x.SyncBurns(context.Background())
 
 		assert.True(t, success)
 	})
@@ -1944,8 +2114,8 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionBurns, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.Burn)
 				*v = []models.Burn{
 					{
@@ -1954,8 +2124,8 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", errors.New("error"))
-		success := x.SyncBurns()
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", errors.New("error"))
+		success := x.SyncBurns(context.Background())
 
 		assert.False(t, success)
 
@@ -1964,6 +2134,8 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 	t.Run("Error unlocking", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
+		mockDB.EXPECT().CountDocuments(mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -2016,11 +2188,12 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 			PoktAddress: common.HexToAddress("0x2345"),
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
 		oldCosmosSignTx := CosmosSignTx
 		CosmosSignTx = func(
+			_ context.Context,
 			signerKey common.Signer,
 			config models.CosmosConfig,
 			client cosmos.CosmosClient,
@@ -2035,23 +2208,21 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 		}
 		defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-		mockDB.EXPECT().Unlock("lock-id").Return(nil)
-
 		oldLockReadSequences := LockReadSequences
-		LockReadSequences = func() (string, error) {
-			return "lock-id", nil
+		LockReadSequences = func(ctx context.Context) (context.Context, func(), error) {
+			return ctx, func() {}, nil
 		}
 		defer func() { LockReadSequences = oldLockReadSequences }()
 
 		oldFindMaxSequence := FindMaxSequence
-		FindMaxSequence = func() (*uint64, error) {
+		FindMaxSequence = func(context.Context) (*uint64, error) {
 			return nil, nil
 		}
 		defer func() { FindMaxSequence = oldFindMaxSequence }()
 
 		sequence := uint64(2)
 
-		mockCosmosClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
+		mockCosmosClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
 
 		filter := bson.M{
 			"_id":    burn.Id,
@@ -2064,29 +2235,31 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 				"return_transaction_body": "encoded tx",
 				"signatures":              []models.Signature{{}},
 				"sequence":                &sequence,
+				"fencing_token":           int64(1),
 				"updated_at":              time.Now(),
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, fenced(filter), mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		mockDB.EXPECT().FindMany(models.CollectionBurns, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.Burn)
 				*v = []models.Burn{
 					*burn,
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
 
-		mockDB.EXPECT().Unlock("lockId").Return(errors.New("error"))
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(errors.New("error"))
 
-		success := x.SyncBurns()
+		success := x.SyncBurns(context.Background())
 
 		assert.False(t, success)
 	})
@@ -2094,6 +2267,8 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 	t.Run("Successful case", func(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		app.DB = mockDB
+		expectTransactions(mockDB)
+		mockDB.EXPECT().CountDocuments(mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
 		mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintController := ethMocks.NewMockMintControllerContract(t)
 		mockEthClient := ethMocks.NewMockEthereumClient(t)
@@ -2146,11 +2321,12 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 			PoktAddress: common.HexToAddress("0x2345"),
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
 		oldCosmosSignTx := CosmosSignTx
 		CosmosSignTx = func(
+			_ context.Context,
 			signerKey common.Signer,
 			config models.CosmosConfig,
 			client cosmos.CosmosClient,
@@ -2165,23 +2341,21 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 		}
 		defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-		mockDB.EXPECT().Unlock("lock-id").Return(nil)
-
 		oldLockReadSequences := LockReadSequences
-		LockReadSequences = func() (string, error) {
-			return "lock-id", nil
+		LockReadSequences = func(ctx context.Context) (context.Context, func(), error) {
+			return ctx, func() {}, nil
 		}
 		defer func() { LockReadSequences = oldLockReadSequences }()
 
 		oldFindMaxSequence := FindMaxSequence
-		FindMaxSequence = func() (*uint64, error) {
+		FindMaxSequence = func(context.Context) (*uint64, error) {
 			return nil, nil
 		}
 		defer func() { FindMaxSequence = oldFindMaxSequence }()
 
 		sequence := uint64(2)
 
-		mockCosmosClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
+		mockCosmosClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
 
 		filter := bson.M{
 			"_id":    burn.Id,
@@ -2194,47 +2368,130 @@ func TestBurnSignerSyncBurns(t *testing.T) {
 				"return_transaction_body": "encoded tx",
 				"signatures":              []models.Signature{{}},
 				"sequence":                &sequence,
+				"fencing_token":           int64(1),
 				"updated_at":              time.Now(),
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, fenced(filter), mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		mockDB.EXPECT().FindMany(models.CollectionBurns, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.Burn)
 				*v = []models.Burn{
 					*burn,
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
 
-		mockDB.EXPECT().Unlock("lockId").Return(nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
 
-		success := x.SyncBurns()
+		success := x.SyncBurns(context.Background())
 
 		assert.True(t, success)
 	})
 
 }
 
+func TestBurnSignerSyncOrphanedBurns(t *testing.T) {
+
+	newBurns := func(result interface{}) {
+		burns := result.(*[]models.Burn)
+		*burns = append(*burns, models.Burn{
+			Id:              &primitive.NilObjectID,
+			TransactionHash: "0x1234",
+			BlockNumber:     "99",
+			BlockHash:       "0xabcd",
+			Status:          models.StatusOrphaned,
+		})
+	}
+
+	t.Run("Error fetching burns", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		x := NewTestBurnSigner(t, ethMocks.NewMockWrappedPocketContract(t), ethMocks.NewMockMintControllerContract(t), mockEthClient, cosmosMocks.NewMockCosmosClient(t))
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(errors.New("error"))
+
+		success := x.SyncOrphanedBurns(context.Background())
+
+		assert.False(t, success)
+	})
+
+	t.Run("Still orphaned", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		x := NewTestBurnSigner(t, ethMocks.NewMockWrappedPocketContract(t), ethMocks.NewMockMintControllerContract(t), mockEthClient, cosmosMocks.NewMockCosmosClient(t))
+
+		filter := bson.M{"wpokt_address": x.wpoktAddress, "status": models.StatusOrphaned}
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, filter, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newBurns(result) })
+		mockEthClient.EXPECT().GetBlockHash(mock.Anything, uint64(99)).Return("0xdcba", nil)
+
+		success := x.SyncOrphanedBurns(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("Block canonical again", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		x := NewTestBurnSigner(t, ethMocks.NewMockWrappedPocketContract(t), ethMocks.NewMockMintControllerContract(t), mockEthClient, cosmosMocks.NewMockCosmosClient(t))
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newBurns(result) })
+		mockEthClient.EXPECT().GetBlockHash(mock.Anything, uint64(99)).Return("0xabcd", nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, bson.M{"_id": &primitive.NilObjectID, "status": models.StatusOrphaned}, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusPending, set["status"])
+				assert.Equal(t, "0", set["confirmations"])
+			})
+
+		success := x.SyncOrphanedBurns(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("Error checking block", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		x := NewTestBurnSigner(t, ethMocks.NewMockWrappedPocketContract(t), ethMocks.NewMockMintControllerContract(t), mockEthClient, cosmosMocks.NewMockCosmosClient(t))
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newBurns(result) })
+		mockEthClient.EXPECT().GetBlockHash(mock.Anything, uint64(99)).Return("", errors.New("error"))
+
+		success := x.SyncOrphanedBurns(context.Background())
+
+		assert.False(t, success)
+	})
+}
+
 func TestBurnSignerRun(t *testing.T) {
 
 	mockDB := appMocks.NewMockDatabase(t)
 	app.DB = mockDB
+	expectTransactions(mockDB)
+	mockDB.EXPECT().CountDocuments(mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
 	mockWPOKT := ethMocks.NewMockWrappedPocketContract(t)
 	mockMintController := ethMocks.NewMockMintControllerContract(t)
 	mockEthClient := ethMocks.NewMockEthereumClient(t)
 	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 	x := NewTestBurnSigner(t, mockWPOKT, mockMintController, mockEthClient, mockCosmosClient)
 
-	mockCosmosClient.EXPECT().GetLatestBlockHeight().Return(200, nil)
-	mockEthClient.EXPECT().GetBlockNumber().Return(uint64(200), nil)
+	mockCosmosClient.EXPECT().GetLatestBlockHeight(mock.Anything).Return(200, nil)
+	mockEthClient.EXPECT().GetBlockNumber(mock.Anything).Return(uint64(200), nil)
 
 	{
 		x.cosmosHeight = 100
@@ -2273,13 +2530,14 @@ func TestBurnSignerRun(t *testing.T) {
 				"return_transaction_body": "encoded tx",
 				"signatures":              []models.Signature{{}},
 				"sequence":                &sequence,
+				"fencing_token":           int64(1),
 				"updated_at":              time.Now(),
 			},
 		}
 
-		mockCosmosClient.EXPECT().GetTx("").Return(txResponse, nil)
-		mockDB.EXPECT().UpdateOne(models.CollectionInvalidMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockCosmosClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionInvalidMints, fenced(filter), mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
@@ -2302,6 +2560,7 @@ func TestBurnSignerRun(t *testing.T) {
 
 		oldCosmosSignTx := CosmosSignTx
 		CosmosSignTx = func(
+			_ context.Context,
 			signerKey common.Signer,
 			config models.CosmosConfig,
 			client cosmos.CosmosClient,
@@ -2316,21 +2575,19 @@ func TestBurnSignerRun(t *testing.T) {
 		}
 		defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-		mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
-
 		oldLockReadSequences := LockReadSequences
-		LockReadSequences = func() (string, error) {
-			return "lock-id", nil
+		LockReadSequences = func(ctx context.Context) (context.Context, func(), error) {
+			return ctx, func() {}, nil
 		}
 		defer func() { LockReadSequences = oldLockReadSequences }()
 
 		oldFindMaxSequence := FindMaxSequence
-		FindMaxSequence = func() (*uint64, error) {
+		FindMaxSequence = func(context.Context) (*uint64, error) {
 			return nil, nil
 		}
 		defer func() { FindMaxSequence = oldFindMaxSequence }()
 
-		mockCosmosClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
+		mockCosmosClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
 
 		addressHex, _ := common.AddressHexFromBytes(x.signer.Signer.CosmosPublicKey().Address().Bytes())
 		filterFind := bson.M{
@@ -2349,17 +2606,18 @@ func TestBurnSignerRun(t *testing.T) {
 				}},
 			},
 		}
-		mockDB.EXPECT().FindMany(models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionInvalidMints, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.InvalidMint)
 				*v = []models.InvalidMint{
 					*invalidMint,
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
 
-		mockDB.EXPECT().Unlock("lockId").Return(nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
 
 	}
 
@@ -2411,11 +2669,12 @@ func TestBurnSignerRun(t *testing.T) {
 			PoktAddress: common.HexToAddress("0x2345"),
 		}
 
-		mockEthClient.EXPECT().GetTransactionReceipt("").Return(txReceipt, nil)
+		mockEthClient.EXPECT().GetTransactionReceipt(mock.Anything, "").Return(txReceipt, nil)
 		mockWPOKT.EXPECT().ParseBurnAndBridge(mock.Anything).Return(event, nil)
 
 		oldCosmosSignTx := CosmosSignTx
 		CosmosSignTx = func(
+			_ context.Context,
 			signerKey common.Signer,
 			config models.CosmosConfig,
 			client cosmos.CosmosClient,
@@ -2430,23 +2689,21 @@ func TestBurnSignerRun(t *testing.T) {
 		}
 		defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-		mockDB.EXPECT().Unlock("lock-id").Return(nil)
-
 		oldLockReadSequences := LockReadSequences
-		LockReadSequences = func() (string, error) {
-			return "lock-id", nil
+		LockReadSequences = func(ctx context.Context) (context.Context, func(), error) {
+			return ctx, func() {}, nil
 		}
 		defer func() { LockReadSequences = oldLockReadSequences }()
 
 		oldFindMaxSequence := FindMaxSequence
-		FindMaxSequence = func() (*uint64, error) {
+		FindMaxSequence = func(context.Context) (*uint64, error) {
 			return nil, nil
 		}
 		defer func() { FindMaxSequence = oldFindMaxSequence }()
 
 		sequence := uint64(2)
 
-		mockCosmosClient.EXPECT().GetAccount(mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
+		mockCosmosClient.EXPECT().GetAccount(mock.Anything, mock.Anything).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: sequence}, nil)
 
 		filter := bson.M{
 			"_id":    burn.Id,
@@ -2459,30 +2716,36 @@ func TestBurnSignerRun(t *testing.T) {
 				"return_transaction_body": "encoded tx",
 				"signatures":              []models.Signature{{}},
 				"sequence":                &sequence,
+				"fencing_token":           int64(1),
 				"updated_at":              time.Now(),
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, fenced(filter), mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		mockDB.EXPECT().FindMany(models.CollectionBurns, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.Burn)
 				*v = []models.Burn{
 					*burn,
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionBurns, bson.M{"wpokt_address": x.wpoktAddress, "status": models.StatusOrphaned}, mock.Anything).Return(nil)
 
-		mockDB.EXPECT().Unlock("lockId").Return(nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
 	}
 
-	x.Run()
+	err := x.Run(context.Background())
+
+	assert.Nil(t, err)
 
 }
 
@@ -2,8 +2,11 @@ package cosmos
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
+	"strconv"
 
+	"github.com/dan13ram/wpokt-validator/app"
 	"github.com/dan13ram/wpokt-validator/common"
 	cosmos "github.com/dan13ram/wpokt-validator/cosmos/client"
 	"github.com/dan13ram/wpokt-validator/cosmos/util"
@@ -25,6 +28,7 @@ var ErrAlreadySigned = fmt.Errorf("already signed")
 var CosmosSignTx = SignTx
 
 var cosmosNewClient = cosmos.NewClient
+var cosmosSubscribeTxsSentToAddress = cosmos.SubscribeTxsSentToAddress
 var utilNewSendTx = util.NewSendTx
 var utilWrapTxBuilder = util.WrapTxBuilder
 var utilSignWithPrivKey = util.SignWithPrivKey
@@ -43,6 +47,7 @@ func isTxSigner(user []byte, signers [][]byte) bool {
 }
 
 func SignTx(
+	ctx context.Context,
 	signer common.Signer,
 	config models.CosmosConfig,
 	client cosmos.CosmosClient,
@@ -100,7 +105,7 @@ func SignTx(
 		return "", nil, fmt.Errorf("multisig is not a signer")
 	}
 
-	account, err := client.GetAccount(config.MultisigAddress)
+	account, err := client.GetAccount(ctx, config.MultisigAddress)
 
 	if err != nil {
 		return "", nil, fmt.Errorf("error getting account: %w", err)
@@ -114,8 +119,8 @@ func SignTx(
 		Address:       sdk.AccAddress(signer.CosmosPublicKey().Address()).String(),
 	}
 
-	sigV2, _, err := utilSignWithPrivKey(
-		context.Background(),
+	sigV2, signBytes, err := utilSignWithPrivKey(
+		ctx,
 		signerData,
 		txBuilder,
 		signer,
@@ -126,6 +131,10 @@ func SignTx(
 		return "", nil, fmt.Errorf("error signing tx: %w", err)
 	}
 
+	if err := recordTxSignature(ctx, config, signer, sigV2, signBytes, sequence, toAddress, amount, memo); err != nil {
+		return "", nil, fmt.Errorf("error recording signature: %w", err)
+	}
+
 	var sigV2s []signingtypes.SignatureV2
 
 	if len(signatures) > 0 {
@@ -161,3 +170,37 @@ func SignTx(
 
 	return string(txBody), finalSignatures, nil
 }
+
+// recordTxSignature adds the signature to the audit log, the memo carries the source transaction hash
+func recordTxSignature(
+	ctx context.Context,
+	config models.CosmosConfig,
+	signer common.Signer,
+	sigV2 signingtypes.SignatureV2,
+	signBytes []byte,
+	sequence uint64,
+	toAddress []byte,
+	amount sdk.Coin,
+	memo string,
+) error {
+	signerAddress, _ := common.AddressHexFromBytes(signer.CosmosPublicKey().Address().Bytes())
+	recipient, _ := common.Bech32FromBytes(config.Bech32Prefix, toAddress)
+
+	signature := ""
+	if data, ok := sigV2.Data.(*signingtypes.SingleSignatureData); ok {
+		signature = common.HexFromBytes(data.Signature)
+	}
+
+	digest := sha256.Sum256(signBytes)
+
+	return app.RecordSignature(ctx, models.AuditEntry{
+		Kind:      app.SigningKindRefund,
+		Signer:    signerAddress,
+		Digest:    common.HexFromBytes(digest[:]),
+		Signature: signature,
+		Memo:      memo,
+		Recipient: recipient,
+		Amount:    amount.String(),
+		Sequence:  strconv.FormatUint(sequence, 10),
+	})
+}
@@ -81,11 +81,12 @@ func TestCosmosSignTx(t *testing.T) {
 
 	txConfig.EXPECT().TxJSONEncoder().Return(encoder)
 
-	mockClient.EXPECT().GetAccount(multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+	mockClient.EXPECT().GetAccount(mock.Anything, multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 	amount, _ := sdk.ParseCoinNormalized("100upokt")
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -133,6 +134,7 @@ func TestCosmosSignTx_InvalidSigner(t *testing.T) {
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -180,6 +182,7 @@ func TestCosmosSignTx_AlreadySigned(t *testing.T) {
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -217,6 +220,7 @@ func TestCosmosSignTx_InvalidMultisigAddress(t *testing.T) {
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -261,6 +265,7 @@ func TestCosmosSignTx_ErrorNewTx(t *testing.T) {
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -310,6 +315,7 @@ func TestCosmosSignTx_WrapError(t *testing.T) {
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -367,6 +373,7 @@ func TestCosmosSignTx_ErrorGetSigners(t *testing.T) {
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -427,6 +434,7 @@ func TestCosmosSignTx_MultisigIsNotSigner(t *testing.T) {
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -495,13 +503,14 @@ func TestCosmosSignTx_AccountError(t *testing.T) {
 
 	txBuilder.EXPECT().GetTx().Return(tx)
 
-	mockClient.EXPECT().GetAccount(multisigAddr).Return(nil, assert.AnError)
+	mockClient.EXPECT().GetAccount(mock.Anything, multisigAddr).Return(nil, assert.AnError)
 
 	amount, _ := sdk.ParseCoinNormalized("100upokt")
 
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -569,13 +578,14 @@ func TestCosmosSignTx_ErrorSigning(t *testing.T) {
 
 	txBuilder.EXPECT().GetTx().Return(tx)
 
-	mockClient.EXPECT().GetAccount(multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+	mockClient.EXPECT().GetAccount(mock.Anything, multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 	amount, _ := sdk.ParseCoinNormalized("100upokt")
 
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -649,13 +659,14 @@ func TestCosmosSignTx_ErrorGettingSignatures(t *testing.T) {
 
 	tx.EXPECT().GetSignaturesV2().Return(nil, assert.AnError)
 
-	mockClient.EXPECT().GetAccount(multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+	mockClient.EXPECT().GetAccount(mock.Anything, multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 	amount, _ := sdk.ParseCoinNormalized("100upokt")
 
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -723,13 +734,14 @@ func TestCosmosSignTx_ErrorSettingSignatures(t *testing.T) {
 
 	txBuilder.EXPECT().GetTx().Return(tx)
 	txBuilder.EXPECT().SetSignatures(mock.Anything).Return(assert.AnError)
-	mockClient.EXPECT().GetAccount(multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+	mockClient.EXPECT().GetAccount(mock.Anything, multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 	amount, _ := sdk.ParseCoinNormalized("100upokt")
 
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -804,13 +816,14 @@ func TestCosmosSignTx_ErrorEncoding(t *testing.T) {
 
 	txConfig.EXPECT().TxJSONEncoder().Return(encoder)
 
-	mockClient.EXPECT().GetAccount(multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+	mockClient.EXPECT().GetAccount(mock.Anything, multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 	amount, _ := sdk.ParseCoinNormalized("100upokt")
 
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -886,13 +899,14 @@ func TestCosmosSignTx_TransactionBodyNonEmpty(t *testing.T) {
 
 	txConfig.EXPECT().TxJSONEncoder().Return(encoder)
 
-	mockClient.EXPECT().GetAccount(multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
+	mockClient.EXPECT().GetAccount(mock.Anything, multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 	amount, _ := sdk.ParseCoinNormalized("100upokt")
 
 	sequence := uint64(0)
 
 	txBody, signatures, err := SignTx(
+		context.Background(),
 		signerKey,
 		config,
 		mockClient,
@@ -39,7 +39,7 @@ func SignWithPrivKey(
 	}
 
 	// Sign those bytes
-	signature, err := signer.CosmosSign(msg)
+	signature, err := signer.CosmosSign(ctx, msg)
 	if err != nil {
 		return sigV2, msg, err
 	}
@@ -89,7 +89,7 @@ func TestSignWithPrivKey_ErrorSignBytes(t *testing.T) {
 type mockSigner struct {
 }
 
-func (m *mockSigner) CosmosSign(msg []byte) ([]byte, error) {
+func (m *mockSigner) CosmosSign(_ context.Context, msg []byte) ([]byte, error) {
 	return nil, errors.New("error signing")
 }
 
@@ -97,7 +97,7 @@ func (m *mockSigner) CosmosPublicKey() types.PubKey {
 	return nil
 }
 
-func (m *mockSigner) EthSign(msg []byte) ([]byte, error) {
+func (m *mockSigner) EthSign(_ context.Context, msg []byte) ([]byte, error) {
 	return nil, errors.New("error signing")
 }
 
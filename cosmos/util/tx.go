@@ -1,6 +1,7 @@
 package util
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/dan13ram/wpokt-validator/common"
@@ -94,3 +95,34 @@ func WrapTxBuilder(
 	txBuilder, err := txConfig.WrapTxBuilder(tx)
 	return txBuilder, txConfig, err
 }
+
+// CompareTxBodies reports whether the stored tx body encodes the same unsigned transaction as the expected one
+func CompareTxBodies(
+	bech32Prefix string,
+	expected string,
+	stored string,
+) (bool, error) {
+	expectedBytes, err := encodeUnsignedTx(bech32Prefix, expected)
+	if err != nil {
+		return false, fmt.Errorf("error encoding expected tx: %w", err)
+	}
+	storedBytes, err := encodeUnsignedTx(bech32Prefix, stored)
+	if err != nil {
+		return false, fmt.Errorf("error encoding stored tx: %w", err)
+	}
+	return bytes.Equal(expectedBytes, storedBytes), nil
+}
+
+func encodeUnsignedTx(
+	bech32Prefix string,
+	txBody string,
+) ([]byte, error) {
+	txBuilder, txConfig, err := WrapTxBuilder(bech32Prefix, txBody)
+	if err != nil {
+		return nil, err
+	}
+	if err := txBuilder.SetSignatures(); err != nil {
+		return nil, fmt.Errorf("error clearing signatures: %w", err)
+	}
+	return txConfig.TxEncoder()(txBuilder.GetTx())
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -240,3 +242,69 @@ func TestWrapTxBuilder_ErrorParsingTxBody(t *testing.T) {
 	assert.Nil(t, txBuilder)
 	assert.Nil(t, txConfig)
 }
+
+func TestCompareTxBodies(t *testing.T) {
+	bech32Prefix := "pokt"
+	fromAddr := ethcommon.BytesToAddress([]byte{1, 2, 3})
+	toAddr := ethcommon.BytesToAddress([]byte{4, 5, 6})
+	amountIncludingFees := sdk.NewCoin("upokt", math.NewInt(1000))
+	feeAmount := sdk.NewCoin("upokt", math.NewInt(100))
+	memo := "Test Memo"
+
+	txBody, err := NewSendTx(bech32Prefix, fromAddr[:], toAddr[:], amountIncludingFees, memo, feeAmount)
+	assert.NoError(t, err)
+
+	t.Run("Same tx", func(t *testing.T) {
+		equal, err := CompareTxBodies(bech32Prefix, txBody, txBody)
+		assert.NoError(t, err)
+		assert.True(t, equal)
+	})
+
+	t.Run("Signatures are ignored", func(t *testing.T) {
+		signer, _ := common.NewMnemonicSigner("test test test test test test test test test test test junk")
+		txBuilder, txConfig, err := WrapTxBuilder(bech32Prefix, txBody)
+		assert.NoError(t, err)
+		signerData := authsigning.SignerData{
+			ChainID:       "poktroll",
+			AccountNumber: 1,
+			Sequence:      1,
+			PubKey:        signer.CosmosPublicKey(),
+			Address:       sdk.AccAddress(signer.CosmosPublicKey().Address()).String(),
+		}
+		sigV2, _, err := SignWithPrivKey(context.Background(), signerData, txBuilder, signer, txConfig, 1)
+		assert.NoError(t, err)
+		assert.NoError(t, txBuilder.SetSignatures(sigV2))
+		signedBody, err := txConfig.TxJSONEncoder()(txBuilder.GetTx())
+		assert.NoError(t, err)
+		assert.NotEqual(t, txBody, string(signedBody))
+
+		equal, err := CompareTxBodies(bech32Prefix, txBody, string(signedBody))
+		assert.NoError(t, err)
+		assert.True(t, equal)
+	})
+
+	t.Run("Different recipient", func(t *testing.T) {
+		otherAddr := ethcommon.BytesToAddress([]byte{7, 8, 9})
+		otherBody, err := NewSendTx(bech32Prefix, fromAddr[:], otherAddr[:], amountIncludingFees, memo, feeAmount)
+		assert.NoError(t, err)
+
+		equal, err := CompareTxBodies(bech32Prefix, txBody, otherBody)
+		assert.NoError(t, err)
+		assert.False(t, equal)
+	})
+
+	t.Run("Different memo", func(t *testing.T) {
+		otherBody, err := NewSendTx(bech32Prefix, fromAddr[:], toAddr[:], amountIncludingFees, "Other Memo", feeAmount)
+		assert.NoError(t, err)
+
+		equal, err := CompareTxBodies(bech32Prefix, txBody, otherBody)
+		assert.NoError(t, err)
+		assert.False(t, equal)
+	})
+
+	t.Run("Invalid stored tx", func(t *testing.T) {
+		equal, err := CompareTxBodies(bech32Prefix, txBody, "invalid_tx_body")
+		assert.Error(t, err)
+		assert.False(t, equal)
+	})
+}
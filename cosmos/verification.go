@@ -0,0 +1,117 @@
+package cosmos
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/common"
+	"github.com/dan13ram/wpokt-validator/cosmos/util"
+	"github.com/dan13ram/wpokt-validator/models"
+	log "github.com/sirupsen/logrus"
+)
+
+func (x *BurnSignerRunner) recordDiscrepancy(ctx context.Context, collection string, transactionHash string, field string, expected string, stored string) {
+	err := app.RecordDiscrepancy(ctx, models.Discrepancy{
+		ValidatorId:     x.signer.ValidatorId(),
+		Service:         BurnSignerName,
+		Collection:      collection,
+		TransactionHash: transactionHash,
+		Field:           field,
+		Expected:        expected,
+		Stored:          stored,
+	})
+	if err != nil {
+		log.Error("[BURN SIGNER] Error recording discrepancy: ", err)
+	}
+}
+
+// VerifyReturnTransaction checks the stored return transaction and signatures against the MsgSend rebuilt from chain data
+func (x *BurnSignerRunner) VerifyReturnTransaction(
+	ctx context.Context,
+	collection string,
+	transactionHash string,
+	signatures []models.Signature,
+	transactionBody string,
+	toAddress []byte,
+	amount sdk.Coin,
+	memo string,
+) (bool, error) {
+	log.Debug("[BURN SIGNER] Verifying return transaction for: ", transactionHash)
+
+	if transactionBody == "" {
+		if len(signatures) > 0 {
+			x.recordDiscrepancy(ctx, collection, transactionHash, "signatures", "0 signatures", fmt.Sprintf("%d signatures", len(signatures)))
+			return false, nil
+		}
+		return true, nil
+	}
+
+	config := app.Config.Pocket
+	multisigAddressBytes, err := common.AddressBytesFromBech32(config.Bech32Prefix, config.MultisigAddress)
+	if err != nil {
+		return false, fmt.Errorf("error parsing multisig address: %w", err)
+	}
+
+	expected, err := util.NewSendTx(
+		config.Bech32Prefix,
+		multisigAddressBytes,
+		toAddress,
+		amount,
+		memo,
+		sdk.NewCoin(config.CoinDenom, math.NewIntFromUint64(uint64(config.TxFee))),
+	)
+	if err != nil {
+		return false, fmt.Errorf("error creating tx body: %w", err)
+	}
+
+	equal, err := util.CompareTxBodies(config.Bech32Prefix, expected, transactionBody)
+	if err != nil || !equal {
+		x.recordDiscrepancy(ctx, collection, transactionHash, "return_transaction_body", expected, transactionBody)
+		return false, nil
+	}
+
+	txBuilder, _, err := util.WrapTxBuilder(config.Bech32Prefix, transactionBody)
+	if err != nil {
+		return false, fmt.Errorf("error wrapping tx builder: %w", err)
+	}
+	sigV2s, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return false, fmt.Errorf("error getting signatures: %w", err)
+	}
+
+	if len(sigV2s) != len(signatures) {
+		x.recordDiscrepancy(ctx, collection, transactionHash, "signatures", fmt.Sprintf("%d signatures", len(sigV2s)), fmt.Sprintf("%d signatures", len(signatures)))
+		return false, nil
+	}
+
+	for i, sig := range sigV2s {
+		data, ok := sig.Data.(*signingtypes.SingleSignatureData)
+		if !ok || !x.isMultisigMember(sig.PubKey.Address().Bytes()) {
+			x.recordDiscrepancy(ctx, collection, transactionHash, "signatures", "multisig member", signatures[i].Signer)
+			return false, nil
+		}
+		signer, _ := common.AddressHexFromBytes(sig.PubKey.Address().Bytes())
+		signature := common.HexFromBytes(data.Signature)
+		if signer != signatures[i].Signer || signature != signatures[i].Signature {
+			x.recordDiscrepancy(ctx, collection, transactionHash, "signatures", signer+":"+signature, signatures[i].Signer+":"+signatures[i].Signature)
+			return false, nil
+		}
+	}
+
+	log.Debug("[BURN SIGNER] Verified return transaction")
+	return true, nil
+}
+
+func (x *BurnSignerRunner) isMultisigMember(address []byte) bool {
+	for _, pubKey := range x.signer.Multisig.GetPubKeys() {
+		if bytes.Equal(pubKey.Address().Bytes(), address) {
+			return true
+		}
+	}
+	return false
+}
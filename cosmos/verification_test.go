@@ -0,0 +1,121 @@
+package cosmos
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/dan13ram/wpokt-validator/app"
+	appMocks "github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/common"
+	cosmosMocks "github.com/dan13ram/wpokt-validator/cosmos/client/mocks"
+	"github.com/dan13ram/wpokt-validator/cosmos/util"
+	ethMocks "github.com/dan13ram/wpokt-validator/eth/client/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"cosmossdk.io/math"
+)
+
+func TestBurnSignerVerifyReturnTransaction(t *testing.T) {
+	// earlier tests leave the signing helpers mocked
+	oldUtilNewSendTx, oldUtilWrapTxBuilder, oldUtilSignWithPrivKey := utilNewSendTx, utilWrapTxBuilder, utilSignWithPrivKey
+	defer func() {
+		utilNewSendTx, utilWrapTxBuilder, utilSignWithPrivKey = oldUtilNewSendTx, oldUtilWrapTxBuilder, oldUtilSignWithPrivKey
+	}()
+	utilNewSendTx, utilWrapTxBuilder, utilSignWithPrivKey = util.NewSendTx, util.WrapTxBuilder, util.SignWithPrivKey
+
+	setup := func(t *testing.T) (*BurnSignerRunner, *cosmosMocks.MockCosmosClient, *appMocks.MockDatabase) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+		x := NewTestBurnSigner(t, ethMocks.NewMockWrappedPocketContract(t), ethMocks.NewMockMintControllerContract(t), ethMocks.NewMockEthereumClient(t), mockCosmosClient)
+		app.Config.Pocket.ChainID = "testnet"
+		app.Config.Pocket.CoinDenom = "upokt"
+		return x, mockCosmosClient, mockDB
+	}
+
+	toAddress := common.HexToAddress("0x2345").Bytes()
+	amount := sdk.NewCoin("upokt", math.NewInt(20000))
+	memo := "Burn: hash"
+
+	signedBody := func(t *testing.T, x *BurnSignerRunner, mockCosmosClient *cosmosMocks.MockCosmosClient, signer common.Signer, memo string) (string, []models.Signature) {
+		mockCosmosClient.EXPECT().GetAccount(mock.Anything, app.Config.Pocket.MultisigAddress).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil).Once()
+		txBody, signatures, err := SignTx(context.Background(), signer, app.Config.Pocket, mockCosmosClient, 1, nil, "", toAddress, amount, memo)
+		assert.NoError(t, err)
+		return txBody, signatures
+	}
+
+	expectDiscrepancy := func(mockDB *appMocks.MockDatabase, field string) {
+		filter := bson.M{"validator_id": "wpokt-validator-01", "collection": models.CollectionBurns, "transaction_hash": "hash", "field": field}
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionDiscrepancies, filter, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+	}
+
+	t.Run("Nothing stored yet", func(t *testing.T) {
+		x, _, _ := setup(t)
+
+		verified, err := x.VerifyReturnTransaction(context.Background(), models.CollectionBurns, "hash", nil, "", toAddress, amount, memo)
+
+		assert.NoError(t, err)
+		assert.True(t, verified)
+	})
+
+	t.Run("Signatures without transaction body", func(t *testing.T) {
+		x, _, mockDB := setup(t)
+		expectDiscrepancy(mockDB, "signatures")
+
+		verified, err := x.VerifyReturnTransaction(context.Background(), models.CollectionBurns, "hash", []models.Signature{{Signer: "signer"}}, "", toAddress, amount, memo)
+
+		assert.NoError(t, err)
+		assert.False(t, verified)
+	})
+
+	t.Run("Stored transaction and signatures match", func(t *testing.T) {
+		x, mockCosmosClient, _ := setup(t)
+		txBody, signatures := signedBody(t, x, mockCosmosClient, x.signer.Signer, memo)
+
+		verified, err := x.VerifyReturnTransaction(context.Background(), models.CollectionBurns, "hash", signatures, txBody, toAddress, amount, memo)
+
+		assert.NoError(t, err)
+		assert.True(t, verified)
+	})
+
+	t.Run("Stored transaction tampered", func(t *testing.T) {
+		x, mockCosmosClient, mockDB := setup(t)
+		txBody, signatures := signedBody(t, x, mockCosmosClient, x.signer.Signer, "Burn: other")
+		expectDiscrepancy(mockDB, "return_transaction_body")
+
+		verified, err := x.VerifyReturnTransaction(context.Background(), models.CollectionBurns, "hash", signatures, txBody, toAddress, amount, memo)
+
+		assert.NoError(t, err)
+		assert.False(t, verified)
+	})
+
+	t.Run("Stored signatures tampered", func(t *testing.T) {
+		x, mockCosmosClient, mockDB := setup(t)
+		txBody, signatures := signedBody(t, x, mockCosmosClient, x.signer.Signer, memo)
+		signatures[0].Signature = "0x1234"
+		expectDiscrepancy(mockDB, "signatures")
+
+		verified, err := x.VerifyReturnTransaction(context.Background(), models.CollectionBurns, "hash", signatures, txBody, toAddress, amount, memo)
+
+		assert.NoError(t, err)
+		assert.False(t, verified)
+	})
+
+	t.Run("Signer is not a multisig member", func(t *testing.T) {
+		x, mockCosmosClient, mockDB := setup(t)
+		other, _ := common.NewMnemonicSigner("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+		txBody, signatures := signedBody(t, x, mockCosmosClient, other, memo)
+		expectDiscrepancy(mockDB, "signatures")
+
+		verified, err := x.VerifyReturnTransaction(context.Background(), models.CollectionBurns, "hash", signatures, txBody, toAddress, amount, memo)
+
+		assert.NoError(t, err)
+		assert.False(t, verified)
+	})
+}
@@ -2,16 +2,23 @@ package client
 
 import (
 	"context"
-	"time"
-
+	"errors"
+	"math"
 	"math/big"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -20,60 +27,117 @@ const (
 
 type EthereumClient interface {
 	ValidateNetwork()
-	GetBlockNumber() (uint64, error)
-	GetChainID() (*big.Int, error)
-	GetClient() *ethclient.Client
-	GetTransactionByHash(txHash string) (*types.Transaction, bool, error)
-	GetTransactionReceipt(txHash string) (*types.Receipt, error)
+	GetBlockNumber(ctx context.Context) (uint64, error)
+	GetChainID(ctx context.Context) (*big.Int, error)
+	GetClient() bind.ContractBackend
+	GetTransactionByHash(ctx context.Context, txHash string) (*types.Transaction, bool, error)
+	GetTransactionReceipt(ctx context.Context, txHash string) (*types.Receipt, error)
+	GetBlockHash(ctx context.Context, blockNumber uint64) (string, error)
 }
 
 type ethereumClient struct {
-	client *ethclient.Client
+	client  *ethclient.Client
+	timeout time.Duration
+	limiter *rate.Limiter
 }
 
-var Client EthereumClient = &ethereumClient{}
+// GetClient returns a contract backend that applies the timeout and rate limit of the endpoint
+func (c *ethereumClient) GetClient() bind.ContractBackend {
+	return &endpointBackend{Client: c.client, endpoint: c}
+}
 
-func (c *ethereumClient) GetClient() *ethclient.Client {
-	return c.client
+// withTimeout waits for the rate limit of the endpoint and applies its timeout to the request
+func (c *ethereumClient) withTimeout(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return ctx, func() {}, err
+		}
+	}
+	timeout := c.timeout
+	if timeout == 0 {
+		timeout = time.Duration(app.Config.Ethereum.RPCTimeoutMillis) * time.Millisecond
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, nil
+}
+
+// endpointBackend is the contract backend of a single endpoint, contract reads wait for its rate limit and use its timeout
+type endpointBackend struct {
+	*ethclient.Client
+	endpoint *ethereumClient
 }
-func (c *ethereumClient) GetBlockNumber() (uint64, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+
+func (b *endpointBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "CallContract", time.Now(), &err)
+
+	ctx, cancel, err := b.endpoint.withTimeout(ctx)
 	defer cancel()
+	if err != nil {
+		return nil, err
+	}
 
-	blockNumber, err := c.client.BlockNumber(ctx)
+	return b.Client.CallContract(ctx, call, blockNumber)
+}
+
+func (b *endpointBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (code []byte, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "CodeAt", time.Now(), &err)
+
+	ctx, cancel, err := b.endpoint.withTimeout(ctx)
+	defer cancel()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return blockNumber, nil
+	return b.Client.CodeAt(ctx, contract, blockNumber)
 }
 
-func (c *ethereumClient) GetChainID() (*big.Int, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+func (b *endpointBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (logs []types.Log, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "FilterLogs", time.Now(), &err)
+
+	ctx, cancel, err := b.endpoint.withTimeout(ctx)
 	defer cancel()
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Client.FilterLogs(ctx, query)
+}
+
+func (c *ethereumClient) GetBlockNumber(ctx context.Context) (blockNumber uint64, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "GetBlockNumber", time.Now(), &err)
 
-	chainID, err := c.client.ChainID(ctx)
+	ctx, cancel, err := c.withTimeout(ctx)
+	defer cancel()
+	if err != nil {
+		return 0, err
+	}
+
+	return c.client.BlockNumber(ctx)
+}
+
+func (c *ethereumClient) GetChainID(ctx context.Context) (chainID *big.Int, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "GetChainID", time.Now(), &err)
+
+	ctx, cancel, err := c.withTimeout(ctx)
+	defer cancel()
 	if err != nil {
 		return nil, err
 	}
 
-	return chainID, nil
+	return c.client.ChainID(ctx)
 }
 
 func (c *ethereumClient) ValidateNetwork() {
 	log.Debugln("[ETH]", "Validating network")
 	log.Debugln("[ETH]", "uri", app.Config.Ethereum.RPCURL)
-	client, err := ethclient.Dial(app.Config.Ethereum.RPCURL)
-	if err != nil {
-		log.Fatalln("[ETH]", "Failed to connect to Ethereum node:", err)
-	}
-	c.client = client
 
-	chainID, err := c.GetChainID()
+	ctx := context.Background()
+
+	chainID, err := c.GetChainID(ctx)
 	if err != nil {
 		log.Fatalln("[ETH]", "Failed to get chain ID:", err)
 	}
-	blockNumber, err := c.GetBlockNumber()
+	blockNumber, err := c.GetBlockNumber(ctx)
 	if err != nil {
 		log.Fatalln("[ETH]", "Failed to get block number:", err)
 	}
@@ -89,25 +153,95 @@ func (c *ethereumClient) ValidateNetwork() {
 	log.Infoln("[ETH]", "Validated network")
 }
 
-func (c *ethereumClient) GetTransactionByHash(txHash string) (*types.Transaction, bool, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+func (c *ethereumClient) GetTransactionByHash(ctx context.Context, txHash string) (tx *types.Transaction, isPending bool, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "GetTransactionByHash", time.Now(), &err)
+
+	ctx, cancel, err := c.withTimeout(ctx)
 	defer cancel()
+	if err != nil {
+		return nil, false, err
+	}
 
-	tx, isPending, err := c.client.TransactionByHash(ctx, common.HexToHash(txHash))
-	return tx, isPending, err
+	return c.client.TransactionByHash(ctx, common.HexToHash(txHash))
 }
 
-func (c *ethereumClient) GetTransactionReceipt(txHash string) (*types.Receipt, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+func (c *ethereumClient) GetTransactionReceipt(ctx context.Context, txHash string) (receipt *types.Receipt, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "GetTransactionReceipt", time.Now(), &err)
+
+	ctx, cancel, err := c.withTimeout(ctx)
 	defer cancel()
+	if err != nil {
+		return nil, err
+	}
 
-	receipt, err := c.client.TransactionReceipt(ctx, common.HexToHash(txHash))
-	return receipt, err
+	return c.client.TransactionReceipt(ctx, common.HexToHash(txHash))
+}
+
+func (c *ethereumClient) GetBlockHash(ctx context.Context, blockNumber uint64) (hash string, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "GetBlockHash", time.Now(), &err)
+
+	ctx, cancel, err := c.withTimeout(ctx)
+	defer cancel()
+	if err != nil {
+		return "", err
+	}
+
+	header, err := c.client.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(header.Hash().Hex()), nil
+}
+
+// IsBlockOrphaned reports whether a block recorded for an event is no longer part of the canonical chain
+func IsBlockOrphaned(ctx context.Context, client EthereumClient, blockNumber uint64, blockHash string) (bool, error) {
+	hash, err := client.GetBlockHash(ctx, blockNumber)
+	if errors.Is(err, ethereum.NotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return !strings.EqualFold(hash, blockHash), nil
+}
+
+// endpointConfigs returns the primary endpoint followed by every additional endpoint, with defaults applied
+func endpointConfigs(config models.EthereumConfig) []models.EthereumEndpointConfig {
+	primary := models.EthereumEndpointConfig{URL: config.RPCURL, TimeoutMillis: config.RPCTimeoutMillis, RateLimit: config.RPCRateLimit}
+	endpoints := []models.EthereumEndpointConfig{primary}
+	seen := []string{config.RPCURL}
+	for _, endpoint := range config.RPCEndpoints {
+		if endpoint.URL == "" || slices.Contains(seen, endpoint.URL) {
+			continue
+		}
+		seen = append(seen, endpoint.URL)
+		if endpoint.TimeoutMillis == 0 {
+			endpoint.TimeoutMillis = config.RPCTimeoutMillis
+		}
+		if endpoint.RateLimit == 0 {
+			endpoint.RateLimit = config.RPCRateLimit
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
+}
+
+func dialEndpoint(endpoint models.EthereumEndpointConfig) (*ethereumClient, error) {
+	client, err := ethclient.Dial(endpoint.URL)
+	c := &ethereumClient{
+		client:  client,
+		timeout: time.Duration(endpoint.TimeoutMillis) * time.Millisecond,
+	}
+	if endpoint.RateLimit > 0 {
+		c.limiter = rate.NewLimiter(rate.Limit(endpoint.RateLimit), int(math.Ceil(endpoint.RateLimit)))
+	}
+	return c, err
 }
 
 func NewClient() (EthereumClient, error) {
-	client, err := ethclient.Dial(app.Config.Ethereum.RPCURL)
-	return &ethereumClient{
-		client: client,
-	}, err
+	endpoints := endpointConfigs(app.Config.Ethereum)
+	if len(endpoints) == 1 {
+		return dialEndpoint(endpoints[0])
+	}
+	return newMultiClient(app.Config.Ethereum, endpoints)
 }
@@ -2,7 +2,9 @@ package client
 
 import (
 	"math/big"
+	"time"
 
+	"github.com/dan13ram/wpokt-validator/app"
 	"github.com/dan13ram/wpokt-validator/eth/autogen"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -29,19 +31,23 @@ type MintControllerContractImpl struct {
 	contract *autogen.MintController
 }
 
-func (x *MintControllerContractImpl) SignerThreshold(opts *bind.CallOpts) (*big.Int, error) {
+func (x *MintControllerContractImpl) SignerThreshold(opts *bind.CallOpts) (result *big.Int, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "SignerThreshold", time.Now(), &err)
 	return x.contract.SignerThreshold(opts)
 }
 
-func (x *MintControllerContractImpl) ValidatorCount(opts *bind.CallOpts) (*big.Int, error) {
+func (x *MintControllerContractImpl) ValidatorCount(opts *bind.CallOpts) (result *big.Int, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "ValidatorCount", time.Now(), &err)
 	return x.contract.ValidatorCount(opts)
 }
 
-func (x *MintControllerContractImpl) Eip712Domain(opts *bind.CallOpts) (DomainData, error) {
+func (x *MintControllerContractImpl) Eip712Domain(opts *bind.CallOpts) (result DomainData, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "Eip712Domain", time.Now(), &err)
 	return x.contract.Eip712Domain(opts)
 }
 
-func (x *MintControllerContractImpl) MaxMintLimit(opts *bind.CallOpts) (*big.Int, error) {
+func (x *MintControllerContractImpl) MaxMintLimit(opts *bind.CallOpts) (result *big.Int, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "MaxMintLimit", time.Now(), &err)
 	return x.contract.MaxMintLimit(opts)
 }
 
@@ -5,7 +5,9 @@ package mocks
 import (
 	big "math/big"
 
-	ethclient "github.com/ethereum/go-ethereum/ethclient"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+
+	context "context"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -25,9 +27,66 @@ func (_m *MockEthereumClient) EXPECT() *MockEthereumClient_Expecter {
 	return &MockEthereumClient_Expecter{mock: &_m.Mock}
 }
 
-// GetBlockNumber provides a mock function with no fields
-func (_m *MockEthereumClient) GetBlockNumber() (uint64, error) {
-	ret := _m.Called()
+// GetBlockHash provides a mock function with given fields: ctx, blockNumber
+func (_m *MockEthereumClient) GetBlockHash(ctx context.Context, blockNumber uint64) (string, error) {
+	ret := _m.Called(ctx, blockNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockHash")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (string, error)); ok {
+		return rf(ctx, blockNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) string); ok {
+		r0 = rf(ctx, blockNumber)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, blockNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEthereumClient_GetBlockHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockHash'
+type MockEthereumClient_GetBlockHash_Call struct {
+	*mock.Call
+}
+
+// GetBlockHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockNumber uint64
+func (_e *MockEthereumClient_Expecter) GetBlockHash(ctx interface{}, blockNumber interface{}) *MockEthereumClient_GetBlockHash_Call {
+	return &MockEthereumClient_GetBlockHash_Call{Call: _e.mock.On("GetBlockHash", ctx, blockNumber)}
+}
+
+func (_c *MockEthereumClient_GetBlockHash_Call) Run(run func(ctx context.Context, blockNumber uint64)) *MockEthereumClient_GetBlockHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockEthereumClient_GetBlockHash_Call) Return(_a0 string, _a1 error) *MockEthereumClient_GetBlockHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEthereumClient_GetBlockHash_Call) RunAndReturn(run func(context.Context, uint64) (string, error)) *MockEthereumClient_GetBlockHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlockNumber provides a mock function with given fields: ctx
+func (_m *MockEthereumClient) GetBlockNumber(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBlockNumber")
@@ -35,17 +94,17 @@ func (_m *MockEthereumClient) GetBlockNumber() (uint64, error) {
 
 	var r0 uint64
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (uint64, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() uint64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,13 +118,14 @@ type MockEthereumClient_GetBlockNumber_Call struct {
 }
 
 // GetBlockNumber is a helper method to define mock.On call
-func (_e *MockEthereumClient_Expecter) GetBlockNumber() *MockEthereumClient_GetBlockNumber_Call {
-	return &MockEthereumClient_GetBlockNumber_Call{Call: _e.mock.On("GetBlockNumber")}
+//   - ctx context.Context
+func (_e *MockEthereumClient_Expecter) GetBlockNumber(ctx interface{}) *MockEthereumClient_GetBlockNumber_Call {
+	return &MockEthereumClient_GetBlockNumber_Call{Call: _e.mock.On("GetBlockNumber", ctx)}
 }
 
-func (_c *MockEthereumClient_GetBlockNumber_Call) Run(run func()) *MockEthereumClient_GetBlockNumber_Call {
+func (_c *MockEthereumClient_GetBlockNumber_Call) Run(run func(ctx context.Context)) *MockEthereumClient_GetBlockNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
@@ -75,14 +135,14 @@ func (_c *MockEthereumClient_GetBlockNumber_Call) Return(_a0 uint64, _a1 error)
 	return _c
 }
 
-func (_c *MockEthereumClient_GetBlockNumber_Call) RunAndReturn(run func() (uint64, error)) *MockEthereumClient_GetBlockNumber_Call {
+func (_c *MockEthereumClient_GetBlockNumber_Call) RunAndReturn(run func(context.Context) (uint64, error)) *MockEthereumClient_GetBlockNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetChainID provides a mock function with no fields
-func (_m *MockEthereumClient) GetChainID() (*big.Int, error) {
-	ret := _m.Called()
+// GetChainID provides a mock function with given fields: ctx
+func (_m *MockEthereumClient) GetChainID(ctx context.Context) (*big.Int, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetChainID")
@@ -90,19 +150,19 @@ func (_m *MockEthereumClient) GetChainID() (*big.Int, error) {
 
 	var r0 *big.Int
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (*big.Int, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (*big.Int, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *big.Int); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*big.Int)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,13 +176,14 @@ type MockEthereumClient_GetChainID_Call struct {
 }
 
 // GetChainID is a helper method to define mock.On call
-func (_e *MockEthereumClient_Expecter) GetChainID() *MockEthereumClient_GetChainID_Call {
-	return &MockEthereumClient_GetChainID_Call{Call: _e.mock.On("GetChainID")}
+//   - ctx context.Context
+func (_e *MockEthereumClient_Expecter) GetChainID(ctx interface{}) *MockEthereumClient_GetChainID_Call {
+	return &MockEthereumClient_GetChainID_Call{Call: _e.mock.On("GetChainID", ctx)}
 }
 
-func (_c *MockEthereumClient_GetChainID_Call) Run(run func()) *MockEthereumClient_GetChainID_Call {
+func (_c *MockEthereumClient_GetChainID_Call) Run(run func(ctx context.Context)) *MockEthereumClient_GetChainID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
@@ -132,25 +193,25 @@ func (_c *MockEthereumClient_GetChainID_Call) Return(_a0 *big.Int, _a1 error) *M
 	return _c
 }
 
-func (_c *MockEthereumClient_GetChainID_Call) RunAndReturn(run func() (*big.Int, error)) *MockEthereumClient_GetChainID_Call {
+func (_c *MockEthereumClient_GetChainID_Call) RunAndReturn(run func(context.Context) (*big.Int, error)) *MockEthereumClient_GetChainID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetClient provides a mock function with no fields
-func (_m *MockEthereumClient) GetClient() *ethclient.Client {
+func (_m *MockEthereumClient) GetClient() bind.ContractBackend {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetClient")
 	}
 
-	var r0 *ethclient.Client
-	if rf, ok := ret.Get(0).(func() *ethclient.Client); ok {
+	var r0 bind.ContractBackend
+	if rf, ok := ret.Get(0).(func() bind.ContractBackend); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ethclient.Client)
+			r0 = ret.Get(0).(bind.ContractBackend)
 		}
 	}
 
@@ -174,19 +235,19 @@ func (_c *MockEthereumClient_GetClient_Call) Run(run func()) *MockEthereumClient
 	return _c
 }
 
-func (_c *MockEthereumClient_GetClient_Call) Return(_a0 *ethclient.Client) *MockEthereumClient_GetClient_Call {
+func (_c *MockEthereumClient_GetClient_Call) Return(_a0 bind.ContractBackend) *MockEthereumClient_GetClient_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockEthereumClient_GetClient_Call) RunAndReturn(run func() *ethclient.Client) *MockEthereumClient_GetClient_Call {
+func (_c *MockEthereumClient_GetClient_Call) RunAndReturn(run func() bind.ContractBackend) *MockEthereumClient_GetClient_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTransactionByHash provides a mock function with given fields: txHash
-func (_m *MockEthereumClient) GetTransactionByHash(txHash string) (*types.Transaction, bool, error) {
-	ret := _m.Called(txHash)
+// GetTransactionByHash provides a mock function with given fields: ctx, txHash
+func (_m *MockEthereumClient) GetTransactionByHash(ctx context.Context, txHash string) (*types.Transaction, bool, error) {
+	ret := _m.Called(ctx, txHash)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTransactionByHash")
@@ -195,25 +256,25 @@ func (_m *MockEthereumClient) GetTransactionByHash(txHash string) (*types.Transa
 	var r0 *types.Transaction
 	var r1 bool
 	var r2 error
-	if rf, ok := ret.Get(0).(func(string) (*types.Transaction, bool, error)); ok {
-		return rf(txHash)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.Transaction, bool, error)); ok {
+		return rf(ctx, txHash)
 	}
-	if rf, ok := ret.Get(0).(func(string) *types.Transaction); ok {
-		r0 = rf(txHash)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *types.Transaction); ok {
+		r0 = rf(ctx, txHash)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) bool); ok {
-		r1 = rf(txHash)
+	if rf, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = rf(ctx, txHash)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
 
-	if rf, ok := ret.Get(2).(func(string) error); ok {
-		r2 = rf(txHash)
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, txHash)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -227,14 +288,15 @@ type MockEthereumClient_GetTransactionByHash_Call struct {
 }
 
 // GetTransactionByHash is a helper method to define mock.On call
+//   - ctx context.Context
 //   - txHash string
-func (_e *MockEthereumClient_Expecter) GetTransactionByHash(txHash interface{}) *MockEthereumClient_GetTransactionByHash_Call {
-	return &MockEthereumClient_GetTransactionByHash_Call{Call: _e.mock.On("GetTransactionByHash", txHash)}
+func (_e *MockEthereumClient_Expecter) GetTransactionByHash(ctx interface{}, txHash interface{}) *MockEthereumClient_GetTransactionByHash_Call {
+	return &MockEthereumClient_GetTransactionByHash_Call{Call: _e.mock.On("GetTransactionByHash", ctx, txHash)}
 }
 
-func (_c *MockEthereumClient_GetTransactionByHash_Call) Run(run func(txHash string)) *MockEthereumClient_GetTransactionByHash_Call {
+func (_c *MockEthereumClient_GetTransactionByHash_Call) Run(run func(ctx context.Context, txHash string)) *MockEthereumClient_GetTransactionByHash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -244,14 +306,14 @@ func (_c *MockEthereumClient_GetTransactionByHash_Call) Return(_a0 *types.Transa
 	return _c
 }
 
-func (_c *MockEthereumClient_GetTransactionByHash_Call) RunAndReturn(run func(string) (*types.Transaction, bool, error)) *MockEthereumClient_GetTransactionByHash_Call {
+func (_c *MockEthereumClient_GetTransactionByHash_Call) RunAndReturn(run func(context.Context, string) (*types.Transaction, bool, error)) *MockEthereumClient_GetTransactionByHash_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTransactionReceipt provides a mock function with given fields: txHash
-func (_m *MockEthereumClient) GetTransactionReceipt(txHash string) (*types.Receipt, error) {
-	ret := _m.Called(txHash)
+// GetTransactionReceipt provides a mock function with given fields: ctx, txHash
+func (_m *MockEthereumClient) GetTransactionReceipt(ctx context.Context, txHash string) (*types.Receipt, error) {
+	ret := _m.Called(ctx, txHash)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTransactionReceipt")
@@ -259,19 +321,19 @@ func (_m *MockEthereumClient) GetTransactionReceipt(txHash string) (*types.Recei
 
 	var r0 *types.Receipt
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*types.Receipt, error)); ok {
-		return rf(txHash)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.Receipt, error)); ok {
+		return rf(ctx, txHash)
 	}
-	if rf, ok := ret.Get(0).(func(string) *types.Receipt); ok {
-		r0 = rf(txHash)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *types.Receipt); ok {
+		r0 = rf(ctx, txHash)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Receipt)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(txHash)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, txHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -285,14 +347,15 @@ type MockEthereumClient_GetTransactionReceipt_Call struct {
 }
 
 // GetTransactionReceipt is a helper method to define mock.On call
+//   - ctx context.Context
 //   - txHash string
-func (_e *MockEthereumClient_Expecter) GetTransactionReceipt(txHash interface{}) *MockEthereumClient_GetTransactionReceipt_Call {
-	return &MockEthereumClient_GetTransactionReceipt_Call{Call: _e.mock.On("GetTransactionReceipt", txHash)}
+func (_e *MockEthereumClient_Expecter) GetTransactionReceipt(ctx interface{}, txHash interface{}) *MockEthereumClient_GetTransactionReceipt_Call {
+	return &MockEthereumClient_GetTransactionReceipt_Call{Call: _e.mock.On("GetTransactionReceipt", ctx, txHash)}
 }
 
-func (_c *MockEthereumClient_GetTransactionReceipt_Call) Run(run func(txHash string)) *MockEthereumClient_GetTransactionReceipt_Call {
+func (_c *MockEthereumClient_GetTransactionReceipt_Call) Run(run func(ctx context.Context, txHash string)) *MockEthereumClient_GetTransactionReceipt_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -302,7 +365,7 @@ func (_c *MockEthereumClient_GetTransactionReceipt_Call) Return(_a0 *types.Recei
 	return _c
 }
 
-func (_c *MockEthereumClient_GetTransactionReceipt_Call) RunAndReturn(run func(string) (*types.Receipt, error)) *MockEthereumClient_GetTransactionReceipt_Call {
+func (_c *MockEthereumClient_GetTransactionReceipt_Call) RunAndReturn(run func(context.Context, string) (*types.Receipt, error)) *MockEthereumClient_GetTransactionReceipt_Call {
 	_c.Call.Return(run)
 	return _c
 }
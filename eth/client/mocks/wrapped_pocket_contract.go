@@ -13,6 +13,8 @@ import (
 
 	common "github.com/ethereum/go-ethereum/common"
 
+	event "github.com/ethereum/go-ethereum/event"
+
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/ethereum/go-ethereum/core/types"
@@ -270,6 +272,130 @@ func (_c *MockWrappedPocketContract_ParseBurnAndBridge_Call) RunAndReturn(run fu
 	return _c
 }
 
+// WatchBurnAndBridge provides a mock function with given fields: opts, sink, amount, poktAddress, from
+func (_m *MockWrappedPocketContract) WatchBurnAndBridge(opts *bind.WatchOpts, sink chan<- *autogen.WrappedPocketBurnAndBridge, amount []*big.Int, poktAddress []common.Address, from []common.Address) (event.Subscription, error) {
+	ret := _m.Called(opts, sink, amount, poktAddress, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchBurnAndBridge")
+	}
+
+	var r0 event.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.WatchOpts, chan<- *autogen.WrappedPocketBurnAndBridge, []*big.Int, []common.Address, []common.Address) (event.Subscription, error)); ok {
+		return rf(opts, sink, amount, poktAddress, from)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.WatchOpts, chan<- *autogen.WrappedPocketBurnAndBridge, []*big.Int, []common.Address, []common.Address) event.Subscription); ok {
+		r0 = rf(opts, sink, amount, poktAddress, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(event.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.WatchOpts, chan<- *autogen.WrappedPocketBurnAndBridge, []*big.Int, []common.Address, []common.Address) error); ok {
+		r1 = rf(opts, sink, amount, poktAddress, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWrappedPocketContract_WatchBurnAndBridge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchBurnAndBridge'
+type MockWrappedPocketContract_WatchBurnAndBridge_Call struct {
+	*mock.Call
+}
+
+// WatchBurnAndBridge is a helper method to define mock.On call
+//   - opts *bind.WatchOpts
+//   - sink chan<- *autogen.WrappedPocketBurnAndBridge
+//   - amount []*big.Int
+//   - poktAddress []common.Address
+//   - from []common.Address
+func (_e *MockWrappedPocketContract_Expecter) WatchBurnAndBridge(opts interface{}, sink interface{}, amount interface{}, poktAddress interface{}, from interface{}) *MockWrappedPocketContract_WatchBurnAndBridge_Call {
+	return &MockWrappedPocketContract_WatchBurnAndBridge_Call{Call: _e.mock.On("WatchBurnAndBridge", opts, sink, amount, poktAddress, from)}
+}
+
+func (_c *MockWrappedPocketContract_WatchBurnAndBridge_Call) Run(run func(opts *bind.WatchOpts, sink chan<- *autogen.WrappedPocketBurnAndBridge, amount []*big.Int, poktAddress []common.Address, from []common.Address)) *MockWrappedPocketContract_WatchBurnAndBridge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.WatchOpts), args[1].(chan<- *autogen.WrappedPocketBurnAndBridge), args[2].([]*big.Int), args[3].([]common.Address), args[4].([]common.Address))
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_WatchBurnAndBridge_Call) Return(_a0 event.Subscription, _a1 error) *MockWrappedPocketContract_WatchBurnAndBridge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_WatchBurnAndBridge_Call) RunAndReturn(run func(*bind.WatchOpts, chan<- *autogen.WrappedPocketBurnAndBridge, []*big.Int, []common.Address, []common.Address) (event.Subscription, error)) *MockWrappedPocketContract_WatchBurnAndBridge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchMinted provides a mock function with given fields: opts, sink, recipient, amount, nonce
+func (_m *MockWrappedPocketContract) WatchMinted(opts *bind.WatchOpts, sink chan<- *autogen.WrappedPocketMinted, recipient []common.Address, amount []*big.Int, nonce []*big.Int) (event.Subscription, error) {
+	ret := _m.Called(opts, sink, recipient, amount, nonce)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchMinted")
+	}
+
+	var r0 event.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.WatchOpts, chan<- *autogen.WrappedPocketMinted, []common.Address, []*big.Int, []*big.Int) (event.Subscription, error)); ok {
+		return rf(opts, sink, recipient, amount, nonce)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.WatchOpts, chan<- *autogen.WrappedPocketMinted, []common.Address, []*big.Int, []*big.Int) event.Subscription); ok {
+		r0 = rf(opts, sink, recipient, amount, nonce)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(event.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.WatchOpts, chan<- *autogen.WrappedPocketMinted, []common.Address, []*big.Int, []*big.Int) error); ok {
+		r1 = rf(opts, sink, recipient, amount, nonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWrappedPocketContract_WatchMinted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchMinted'
+type MockWrappedPocketContract_WatchMinted_Call struct {
+	*mock.Call
+}
+
+// WatchMinted is a helper method to define mock.On call
+//   - opts *bind.WatchOpts
+//   - sink chan<- *autogen.WrappedPocketMinted
+//   - recipient []common.Address
+//   - amount []*big.Int
+//   - nonce []*big.Int
+func (_e *MockWrappedPocketContract_Expecter) WatchMinted(opts interface{}, sink interface{}, recipient interface{}, amount interface{}, nonce interface{}) *MockWrappedPocketContract_WatchMinted_Call {
+	return &MockWrappedPocketContract_WatchMinted_Call{Call: _e.mock.On("WatchMinted", opts, sink, recipient, amount, nonce)}
+}
+
+func (_c *MockWrappedPocketContract_WatchMinted_Call) Run(run func(opts *bind.WatchOpts, sink chan<- *autogen.WrappedPocketMinted, recipient []common.Address, amount []*big.Int, nonce []*big.Int)) *MockWrappedPocketContract_WatchMinted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.WatchOpts), args[1].(chan<- *autogen.WrappedPocketMinted), args[2].([]common.Address), args[3].([]*big.Int), args[4].([]*big.Int))
+	})
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_WatchMinted_Call) Return(_a0 event.Subscription, _a1 error) *MockWrappedPocketContract_WatchMinted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWrappedPocketContract_WatchMinted_Call) RunAndReturn(run func(*bind.WatchOpts, chan<- *autogen.WrappedPocketMinted, []common.Address, []*big.Int, []*big.Int) (event.Subscription, error)) *MockWrappedPocketContract_WatchMinted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWrappedPocketContract creates a new instance of MockWrappedPocketContract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWrappedPocketContract(t interface {
@@ -0,0 +1,247 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/common"
+	"github.com/dan13ram/wpokt-validator/models"
+)
+
+// multiClient spreads requests over several endpoints, failing over to the next endpoint on errors.
+// With a quorum, GetBlockNumber, GetBlockHash, GetTransactionReceipt and contract calls only return results that enough endpoints agree on.
+type multiClient struct {
+	config models.EthereumConfig
+	quorum int
+	pool   *common.EndpointPool[EthereumClient]
+}
+
+// endpointName identifies an endpoint in logs without the path or credentials of its url
+func endpointName(index int, endpoint models.EthereumEndpointConfig) string {
+	host := endpoint.URL
+	if u, err := url.Parse(endpoint.URL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	return fmt.Sprintf("#%d %s", index, host)
+}
+
+func newMultiClient(config models.EthereumConfig, endpoints []models.EthereumEndpointConfig) (EthereumClient, error) {
+	names := make([]string, len(endpoints))
+	clients := make([]EthereumClient, len(endpoints))
+	for i, endpoint := range endpoints {
+		names[i] = endpointName(i, endpoint)
+		c, err := dialEndpoint(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("endpoint %s: %w", names[i], err)
+		}
+		clients[i] = c
+	}
+
+	log.Debugf("[ETH] Using %d endpoints with quorum %d", len(endpoints), max(config.Quorum, 1))
+	return &multiClient{
+		config: config,
+		quorum: int(config.Quorum),
+		pool:   common.NewEndpointPool(app.ChainEthereum, names, clients),
+	}, nil
+}
+
+func (c *multiClient) quorumEnabled() bool {
+	return c.quorum > 1
+}
+
+// EndpointHealth reports the health and score of every endpoint
+func (c *multiClient) EndpointHealth() []models.EndpointHealth {
+	return c.pool.Health()
+}
+
+// GetClient returns a contract backend over all endpoints, contract calls use the quorum of the client
+func (c *multiClient) GetClient() bind.ContractBackend {
+	return &multiBackend{quorum: c.quorum, pool: c.pool}
+}
+
+func (c *multiClient) GetBlockNumber(ctx context.Context) (uint64, error) {
+	call := func(client EthereumClient) (uint64, error) { return client.GetBlockNumber(ctx) }
+	if c.quorumEnabled() {
+		return common.QuorumReached(c.pool, c.quorum, call)
+	}
+	return common.Failover(ctx, c.pool, call)
+}
+
+func (c *multiClient) GetChainID(ctx context.Context) (*big.Int, error) {
+	return common.Failover(ctx, c.pool, func(client EthereumClient) (*big.Int, error) { return client.GetChainID(ctx) })
+}
+
+func (c *multiClient) GetTransactionByHash(ctx context.Context, txHash string) (*types.Transaction, bool, error) {
+	type result struct {
+		tx        *types.Transaction
+		isPending bool
+	}
+	res, err := common.Failover(ctx, c.pool, func(client EthereumClient) (result, error) {
+		tx, isPending, err := client.GetTransactionByHash(ctx, txHash)
+		return result{tx, isPending}, err
+	})
+	return res.tx, res.isPending, err
+}
+
+func (c *multiClient) GetTransactionReceipt(ctx context.Context, txHash string) (*types.Receipt, error) {
+	call := func(client EthereumClient) (*types.Receipt, error) { return client.GetTransactionReceipt(ctx, txHash) }
+	if c.quorumEnabled() {
+		return common.Quorum(c.pool, c.quorum, call, receiptKey)
+	}
+	return common.Failover(ctx, c.pool, call)
+}
+
+// GetBlockHash with a quorum only returns ethereum.NotFound when enough endpoints agree the block does not exist,
+// so a single lagging endpoint cannot make a block look orphaned
+func (c *multiClient) GetBlockHash(ctx context.Context, blockNumber uint64) (string, error) {
+	if c.quorumEnabled() {
+		call := func(client EthereumClient) (string, error) {
+			hash, err := client.GetBlockHash(ctx, blockNumber)
+			if errors.Is(err, ethereum.NotFound) {
+				// a missing block is a vote like any other hash
+				return "", nil
+			}
+			return hash, err
+		}
+		hash, err := common.Quorum(c.pool, c.quorum, call, func(hash string) (string, error) { return hash, nil })
+		if err == nil && hash == "" {
+			return "", ethereum.NotFound
+		}
+		return hash, err
+	}
+	call := func(client EthereumClient) (string, error) { return client.GetBlockHash(ctx, blockNumber) }
+	return common.Failover(ctx, c.pool, call)
+}
+
+// ValidateNetwork fails if any endpoint serves another chain, unreachable endpoints are only marked unhealthy
+func (c *multiClient) ValidateNetwork() {
+	log.Debugln("[ETH]", "Validating network")
+	ctx := context.Background()
+
+	reachable := 0
+	for _, e := range c.pool.Ordered() {
+		chainID, err := e.Client.GetChainID(ctx)
+		c.pool.Report(e, err)
+		if err != nil {
+			log.Warnln("[ETH]", "Endpoint", e.Name, "is unreachable:", err)
+			continue
+		}
+		if chainID.String() != c.config.ChainID {
+			log.Fatalln("[ETH]", "Chain ID Mismatch", "endpoint", e.Name, "expected", c.config.ChainID, "got", chainID.Uint64())
+		}
+		reachable++
+	}
+	if reachable < max(c.quorum, 1) {
+		log.Fatalln("[ETH]", reachable, "of", c.pool.Len(), "endpoints are reachable,", max(c.quorum, 1), "required")
+	}
+
+	log.Infoln("[ETH]", "Validated network")
+}
+
+// receiptKey compares the parts of a receipt that validation depends on
+func receiptKey(receipt *types.Receipt) (string, error) {
+	if receipt == nil {
+		return "", fmt.Errorf("empty receipt")
+	}
+	bz, err := json.Marshal(struct {
+		Status      uint64
+		TxHash      ethcommon.Hash
+		BlockHash   ethcommon.Hash
+		BlockNumber *big.Int
+		Logs        []*types.Log
+	}{receipt.Status, receipt.TxHash, receipt.BlockHash, receipt.BlockNumber, receipt.Logs})
+	if err != nil {
+		return "", fmt.Errorf("error encoding receipt: %w", err)
+	}
+	return string(bz), nil
+}
+
+// multiBackend is a contract backend over all endpoints of a multiClient.
+// Contract calls use the quorum, everything else fails over.
+type multiBackend struct {
+	quorum int
+	pool   *common.EndpointPool[EthereumClient]
+}
+
+func (b *multiBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	contractCall := func(client EthereumClient) ([]byte, error) {
+		return client.GetClient().CallContract(ctx, call, blockNumber)
+	}
+	if b.quorum > 1 {
+		return common.Quorum(b.pool, b.quorum, contractCall, func(result []byte) (string, error) { return hex.EncodeToString(result), nil })
+	}
+	return common.Failover(ctx, b.pool, contractCall)
+}
+
+func (b *multiBackend) CodeAt(ctx context.Context, contract ethcommon.Address, blockNumber *big.Int) ([]byte, error) {
+	return common.Failover(ctx, b.pool, func(client EthereumClient) ([]byte, error) {
+		return client.GetClient().CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+func (b *multiBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return common.Failover(ctx, b.pool, func(client EthereumClient) (*types.Header, error) {
+		return client.GetClient().HeaderByNumber(ctx, number)
+	})
+}
+
+func (b *multiBackend) PendingCodeAt(ctx context.Context, account ethcommon.Address) ([]byte, error) {
+	return common.Failover(ctx, b.pool, func(client EthereumClient) ([]byte, error) {
+		return client.GetClient().PendingCodeAt(ctx, account)
+	})
+}
+
+func (b *multiBackend) PendingNonceAt(ctx context.Context, account ethcommon.Address) (uint64, error) {
+	return common.Failover(ctx, b.pool, func(client EthereumClient) (uint64, error) {
+		return client.GetClient().PendingNonceAt(ctx, account)
+	})
+}
+
+func (b *multiBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return common.Failover(ctx, b.pool, func(client EthereumClient) (*big.Int, error) {
+		return client.GetClient().SuggestGasPrice(ctx)
+	})
+}
+
+func (b *multiBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return common.Failover(ctx, b.pool, func(client EthereumClient) (*big.Int, error) {
+		return client.GetClient().SuggestGasTipCap(ctx)
+	})
+}
+
+func (b *multiBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return common.Failover(ctx, b.pool, func(client EthereumClient) (uint64, error) {
+		return client.GetClient().EstimateGas(ctx, call)
+	})
+}
+
+func (b *multiBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	_, err := common.Failover(ctx, b.pool, func(client EthereumClient) (struct{}, error) {
+		return struct{}{}, client.GetClient().SendTransaction(ctx, tx)
+	})
+	return err
+}
+
+func (b *multiBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return common.Failover(ctx, b.pool, func(client EthereumClient) ([]types.Log, error) {
+		return client.GetClient().FilterLogs(ctx, query)
+	})
+}
+
+func (b *multiBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return common.Failover(ctx, b.pool, func(client EthereumClient) (ethereum.Subscription, error) {
+		return client.GetClient().SubscribeFilterLogs(ctx, query, ch)
+	})
+}
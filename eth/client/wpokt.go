@@ -2,11 +2,14 @@ package client
 
 import (
 	"math/big"
+	"time"
 
+	"github.com/dan13ram/wpokt-validator/app"
 	"github.com/dan13ram/wpokt-validator/eth/autogen"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 )
 
 type WrappedPocketContract interface {
@@ -14,6 +17,8 @@ type WrappedPocketContract interface {
 	FilterMinted(opts *bind.FilterOpts, recipient []common.Address, amount []*big.Int, nonce []*big.Int) (WrappedPocketMintedIterator, error)
 	FilterBurnAndBridge(opts *bind.FilterOpts, amount []*big.Int, poktAddress []common.Address, from []common.Address) (WrappedPocketBurnAndBridgeIterator, error)
 	ParseBurnAndBridge(log types.Log) (*autogen.WrappedPocketBurnAndBridge, error)
+	WatchMinted(opts *bind.WatchOpts, sink chan<- *autogen.WrappedPocketMinted, recipient []common.Address, amount []*big.Int, nonce []*big.Int) (event.Subscription, error)
+	WatchBurnAndBridge(opts *bind.WatchOpts, sink chan<- *autogen.WrappedPocketBurnAndBridge, amount []*big.Int, poktAddress []common.Address, from []common.Address) (event.Subscription, error)
 }
 
 type WrappedPocketBurnAndBridgeIterator interface {
@@ -78,7 +83,9 @@ func (x *WrappedPocketContractImpl) ParseBurnAndBridge(log types.Log) (*autogen.
 	return x.contract.ParseBurnAndBridge(log)
 }
 
-func (x *WrappedPocketContractImpl) FilterBurnAndBridge(opts *bind.FilterOpts, amount []*big.Int, poktAddress []common.Address, from []common.Address) (WrappedPocketBurnAndBridgeIterator, error) {
+func (x *WrappedPocketContractImpl) FilterBurnAndBridge(opts *bind.FilterOpts, amount []*big.Int, poktAddress []common.Address, from []common.Address) (_ WrappedPocketBurnAndBridgeIterator, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "FilterBurnAndBridge", time.Now(), &err)
+
 	iterator, err := x.contract.FilterBurnAndBridge(opts, amount, poktAddress, from)
 	if err != nil {
 		return nil, err
@@ -86,7 +93,9 @@ func (x *WrappedPocketContractImpl) FilterBurnAndBridge(opts *bind.FilterOpts, a
 	return &WrappedPocketBurnAndBridgeIteratorImpl{iterator: iterator}, nil
 }
 
-func (x *WrappedPocketContractImpl) FilterMinted(opts *bind.FilterOpts, recipient []common.Address, amount []*big.Int, nonce []*big.Int) (WrappedPocketMintedIterator, error) {
+func (x *WrappedPocketContractImpl) FilterMinted(opts *bind.FilterOpts, recipient []common.Address, amount []*big.Int, nonce []*big.Int) (_ WrappedPocketMintedIterator, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "FilterMinted", time.Now(), &err)
+
 	iterator, err := x.contract.FilterMinted(opts, recipient, amount, nonce)
 	if err != nil {
 		return nil, err
@@ -94,7 +103,18 @@ func (x *WrappedPocketContractImpl) FilterMinted(opts *bind.FilterOpts, recipien
 	return &WrappedPocketMintedIteratorImpl{iterator: iterator}, nil
 }
 
-func (x *WrappedPocketContractImpl) GetUserNonce(opts *bind.CallOpts, user common.Address) (*big.Int, error) {
+func (x *WrappedPocketContractImpl) WatchMinted(opts *bind.WatchOpts, sink chan<- *autogen.WrappedPocketMinted, recipient []common.Address, amount []*big.Int, nonce []*big.Int) (_ event.Subscription, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "WatchMinted", time.Now(), &err)
+	return x.contract.WatchMinted(opts, sink, recipient, amount, nonce)
+}
+
+func (x *WrappedPocketContractImpl) WatchBurnAndBridge(opts *bind.WatchOpts, sink chan<- *autogen.WrappedPocketBurnAndBridge, amount []*big.Int, poktAddress []common.Address, from []common.Address) (_ event.Subscription, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "WatchBurnAndBridge", time.Now(), &err)
+	return x.contract.WatchBurnAndBridge(opts, sink, amount, poktAddress, from)
+}
+
+func (x *WrappedPocketContractImpl) GetUserNonce(opts *bind.CallOpts, user common.Address) (nonce *big.Int, err error) {
+	defer app.ObserveRPC(app.ChainEthereum, "GetUserNonce", time.Now(), &err)
 	return x.contract.GetUserNonce(opts, user)
 }
 
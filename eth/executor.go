@@ -2,6 +2,7 @@ package eth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -13,17 +14,24 @@ import (
 	"github.com/dan13ram/wpokt-validator/eth/autogen"
 	eth "github.com/dan13ram/wpokt-validator/eth/client"
 	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	log "github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 const (
 	MintExecutorName string = "MINT EXECUTOR"
 )
 
+// ErrCheckMintBlocks is returned when the blocks of one or more executed mints could not be checked
+var ErrCheckMintBlocks = errors.New("failed to check mint blocks")
+
 type MintExecutorRunner struct {
 	startBlockNumber   int64
 	currentBlockNumber int64
@@ -32,31 +40,40 @@ type MintExecutorRunner struct {
 	client             eth.EthereumClient
 	vaultAddress       string
 	wpoktAddress       string
+	validatorId        string
 }
 
-func (x *MintExecutorRunner) Run() {
-	x.UpdateCurrentBlockNumber()
-	x.SyncTxs()
+func (x *MintExecutorRunner) Run(ctx context.Context) error {
+	err := x.UpdateCurrentBlockNumber(ctx)
+	if !app.SyncFromCheckpoint(ctx, x.validatorId, MintExecutorName, x.wpoktAddress, x.ReloadCheckpoint, x.SyncTxs) {
+		err = errors.Join(err, app.ErrSyncTxs)
+	}
+	if !x.CheckMintBlocks(ctx) || !x.CheckOrphanedMints(ctx) {
+		err = errors.Join(err, ErrCheckMintBlocks)
+	}
+	return err
 }
 
 func (x *MintExecutorRunner) Status() models.RunnerStatus {
 	return models.RunnerStatus{
 		EthBlockNumber: strconv.FormatInt(x.startBlockNumber, 10),
+		Endpoints:      app.EndpointHealth(x.client),
 	}
 }
 
-func (x *MintExecutorRunner) UpdateCurrentBlockNumber() {
-	res, err := x.client.GetBlockNumber()
+func (x *MintExecutorRunner) UpdateCurrentBlockNumber(ctx context.Context) error {
+	res, err := x.client.GetBlockNumber(ctx)
 	if err != nil {
 		log.Error("[MINT EXECUTOR] Error while getting current block number: ", err)
-		return
+		return fmt.Errorf("error while getting current block number: %w", err)
 	}
 
 	x.currentBlockNumber = int64(res)
 	log.Info("[MINT EXECUTOR] Current block number: ", x.currentBlockNumber)
+	return nil
 }
 
-func (x *MintExecutorRunner) HandleMintEvent(event *autogen.WrappedPocketMinted) bool {
+func (x *MintExecutorRunner) HandleMintEvent(ctx context.Context, event *autogen.WrappedPocketMinted) bool {
 	if event == nil {
 		log.Error("[MINT EXECUTOR] Invalid mint event")
 		return false
@@ -71,7 +88,7 @@ func (x *MintExecutorRunner) HandleMintEvent(event *autogen.WrappedPocketMinted)
 		"amount":            event.Amount.String(),
 		"nonce":             event.Nonce.String(),
 		"status": bson.M{
-			"$in": []string{models.StatusConfirmed, models.StatusSigned, models.StatusSuccess},
+			"$in": []string{models.StatusConfirmed, models.StatusSigned, models.StatusSuccess, models.StatusOrphaned},
 		},
 	}
 
@@ -79,11 +96,30 @@ func (x *MintExecutorRunner) HandleMintEvent(event *autogen.WrappedPocketMinted)
 		"$set": bson.M{
 			"status":                models.StatusSuccess,
 			"mint_transaction_hash": strings.ToLower(event.Raw.TxHash.String()),
+			"mint_block_number":     strconv.FormatUint(event.Raw.BlockNumber, 10),
+			"mint_block_hash":       strings.ToLower(event.Raw.BlockHash.String()),
+			"mint_finalized":        false,
 			"updated_at":            time.Now(),
 		},
 	}
 
-	_, err := app.DB.UpdateOne(models.CollectionMints, filter, update)
+	err := app.PersistWithCheckpoint(ctx, func(ctx context.Context) error {
+		_, err := app.DB.UpdateOne(ctx, models.CollectionMints, filter, update)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// an event without a matching mint must not halt the sync, it is recorded for an operator instead
+			log.Warn("[MINT EXECUTOR] No mint found for mint event: ", event.Raw.TxHash, " ", event.Raw.Index)
+			return app.RecordDiscrepancy(ctx, models.Discrepancy{
+				ValidatorId:     x.validatorId,
+				Service:         MintExecutorName,
+				Collection:      models.CollectionMints,
+				TransactionHash: strings.ToLower(event.Raw.TxHash.String()),
+				Field:           "minted_event",
+				Expected:        fmt.Sprintf("recipient %s, amount %s, nonce %s", strings.ToLower(event.Recipient.Hex()), event.Amount, event.Nonce),
+				Stored:          "",
+			})
+		}
+		return err
+	})
 
 	if err != nil {
 		log.Error("[MINT EXECUTOR] Error while marking mint: ", err)
@@ -95,11 +131,168 @@ func (x *MintExecutorRunner) HandleMintEvent(event *autogen.WrappedPocketMinted)
 	return true
 }
 
-func (x *MintExecutorRunner) SyncBlocks(startBlockNumber uint64, endBlockNumber uint64) bool {
+// ConfirmMintEvent checks through the quorum client that a subscribed mint event was included successfully in the block it was emitted in,
+// a single websocket endpoint could report an event that the other endpoints do not agree on
+func (x *MintExecutorRunner) ConfirmMintEvent(ctx context.Context, event *autogen.WrappedPocketMinted) bool {
+	receipt, err := x.client.GetTransactionReceipt(ctx, event.Raw.TxHash.String())
+	if err != nil {
+		log.Warn("[MINT EXECUTOR] Error confirming mint event, leaving it to the periodic sync: ", err)
+		return false
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful || receipt.BlockHash != event.Raw.BlockHash {
+		log.Warn("[MINT EXECUTOR] Mint event not confirmed by the quorum client, leaving it to the periodic sync: ", event.Raw.TxHash)
+		return false
+	}
+	for _, l := range receipt.Logs {
+		if l.Index == event.Raw.Index && l.Address == event.Raw.Address {
+			return true
+		}
+	}
+	log.Warn("[MINT EXECUTOR] Mint event log not found in the receipt, leaving it to the periodic sync: ", event.Raw.TxHash)
+	return false
+}
+
+// CheckMintBlocks marks executed mints as orphaned when their block is no longer canonical,
+// and as finalized once their block has enough confirmations
+func (x *MintExecutorRunner) CheckMintBlocks(ctx context.Context) bool {
+	filter := bson.M{
+		"wpokt_address":  x.wpoktAddress,
+		"vault_address":  x.vaultAddress,
+		"status":         models.StatusSuccess,
+		"mint_finalized": false,
+	}
+
+	var mints []models.Mint
+	err := app.DB.FindMany(ctx, models.CollectionMints, filter, &mints)
+	if err != nil {
+		log.Error("[MINT EXECUTOR] Error fetching executed mints: ", err)
+		return false
+	}
+
+	var success = true
+	for _, mint := range mints {
+		blockNumber, err := strconv.ParseUint(mint.MintBlockNumber, 10, 64)
+		if err != nil {
+			log.Error("[MINT EXECUTOR] Error parsing mint block number: ", err)
+			success = false
+			continue
+		}
+
+		orphaned, err := eth.IsBlockOrphaned(ctx, x.client, blockNumber, mint.MintBlockHash)
+		if err != nil {
+			log.Error("[MINT EXECUTOR] Error checking mint block: ", err)
+			success = false
+			continue
+		}
+
+		var set bson.M
+		if orphaned {
+			log.Warn("[MINT EXECUTOR] Mint block is no longer canonical: ", mint.MintTransactionHash)
+			set = bson.M{"status": models.StatusOrphaned, "updated_at": time.Now()}
+		} else if x.currentBlockNumber-int64(blockNumber) >= app.Config.Ethereum.Confirmations {
+			set = bson.M{"mint_finalized": true, "updated_at": time.Now()}
+		} else {
+			continue
+		}
+
+		_, err = app.DB.UpdateOne(ctx, models.CollectionMints, bson.M{"_id": mint.Id, "status": models.StatusSuccess}, bson.M{"$set": set})
+		if err != nil {
+			log.Error("[MINT EXECUTOR] Error updating executed mint: ", err)
+			success = false
+		}
+	}
+
+	return success
+}
+
+// CheckOrphanedMints looks up the transactions of orphaned mints. A mint whose transaction was included again
+// is marked as executed in its new block, and a mint whose transaction is still not included once its old block
+// has enough confirmations is moved back to signed, so its signatures can be submitted again, unless its old block
+// turns out to still be canonical.
+func (x *MintExecutorRunner) CheckOrphanedMints(ctx context.Context) bool {
+	filter := bson.M{
+		"wpokt_address": x.wpoktAddress,
+		"vault_address": x.vaultAddress,
+		"status":        models.StatusOrphaned,
+	}
+
+	var mints []models.Mint
+	err := app.DB.FindMany(ctx, models.CollectionMints, filter, &mints)
+	if err != nil {
+		log.Error("[MINT EXECUTOR] Error fetching orphaned mints: ", err)
+		return false
+	}
+
+	var success = true
+	for _, mint := range mints {
+		var set bson.M
+		receipt, err := x.client.GetTransactionReceipt(ctx, mint.MintTransactionHash)
+		if err == nil && receipt.Status == types.ReceiptStatusSuccessful {
+			log.Info("[MINT EXECUTOR] Orphaned mint was included again in block: ", receipt.BlockNumber)
+			set = bson.M{
+				"status":            models.StatusSuccess,
+				"mint_block_number": receipt.BlockNumber.String(),
+				"mint_block_hash":   strings.ToLower(receipt.BlockHash.Hex()),
+				"mint_finalized":    false,
+				"updated_at":        time.Now(),
+			}
+		} else if err == nil || errors.Is(err, ethereum.NotFound) {
+			blockNumber, err := strconv.ParseInt(mint.MintBlockNumber, 10, 64)
+			if err != nil {
+				log.Error("[MINT EXECUTOR] Error parsing mint block number: ", err)
+				success = false
+				continue
+			}
+			if x.currentBlockNumber-blockNumber < app.Config.Ethereum.Confirmations {
+				continue
+			}
+			// an orphan reported in error is restored instead of submitting the mint again
+			orphaned, err := eth.IsBlockOrphaned(ctx, x.client, uint64(blockNumber), mint.MintBlockHash)
+			if err != nil {
+				log.Error("[MINT EXECUTOR] Error rechecking orphaned mint block: ", err)
+				success = false
+				continue
+			}
+			if !orphaned {
+				log.Warn("[MINT EXECUTOR] Orphaned mint block is canonical, restoring mint: ", mint.MintTransactionHash)
+				_, err = app.DB.UpdateOne(ctx, models.CollectionMints, bson.M{"_id": mint.Id, "status": models.StatusOrphaned},
+					bson.M{"$set": bson.M{"status": models.StatusSuccess, "updated_at": time.Now()}})
+				if err != nil {
+					log.Error("[MINT EXECUTOR] Error restoring orphaned mint: ", err)
+					success = false
+				}
+				continue
+			}
+			log.Warn("[MINT EXECUTOR] Orphaned mint was not included again, moving it back to signed: ", mint.MintTransactionHash)
+			set = bson.M{
+				"status":                models.StatusSigned,
+				"mint_transaction_hash": "",
+				"mint_block_number":     "",
+				"mint_block_hash":       "",
+				"mint_finalized":        false,
+				"updated_at":            time.Now(),
+			}
+		} else {
+			log.Error("[MINT EXECUTOR] Error getting orphaned mint receipt: ", err)
+			success = false
+			continue
+		}
+
+		_, err = app.DB.UpdateOne(ctx, models.CollectionMints, bson.M{"_id": mint.Id, "status": models.StatusOrphaned}, bson.M{"$set": set})
+		if err != nil {
+			log.Error("[MINT EXECUTOR] Error updating orphaned mint: ", err)
+			success = false
+		}
+	}
+
+	return success
+}
+
+func (x *MintExecutorRunner) SyncBlocks(ctx context.Context, startBlockNumber uint64, endBlockNumber uint64) bool {
 	filter, err := x.wpoktContract.FilterMinted(&bind.FilterOpts{
 		Start:   startBlockNumber,
 		End:     &endBlockNumber,
-		Context: context.Background(),
+		Context: ctx,
 	}, []common.Address{}, []*big.Int{}, []*big.Int{})
 
 	if filter != nil {
@@ -112,18 +305,15 @@ func (x *MintExecutorRunner) SyncBlocks(startBlockNumber uint64, endBlockNumber
 		return false
 	}
 
-	var success = true
 	for filter.Next() {
 		if err = filter.Error(); err != nil {
-			success = false
 			break
 		}
 
 		event := filter.Event()
 
 		if event == nil {
-			success = false
-			continue
+			return false
 		}
 
 		if event.Raw.Removed {
@@ -131,22 +321,26 @@ func (x *MintExecutorRunner) SyncBlocks(startBlockNumber uint64, endBlockNumber
 		}
 
 		resourceId := fmt.Sprintf("%s/%s", models.CollectionMints, strings.ToLower(event.Recipient.Hex()))
-		lockId, err := app.DB.XLock(resourceId)
+		lock, lockCtx, err := app.AcquireLock(ctx, resourceId)
 		if err != nil {
 			log.Error("[MINT EXECUTOR] Error locking mint: ", err)
-			success = false
-			continue
+			return false
 		}
 		log.Debug("[MINT EXECUTOR] Locked mint: ", event.Raw.TxHash)
 
-		success = x.HandleMintEvent(event) && success
+		// each mint is marked together with the checkpoint at its block, so syncing stops at the first mint that could not be marked
+		checkpointCtx := app.WithCheckpoint(lockCtx, x.validatorId, MintExecutorName, x.wpoktAddress, int64(event.Raw.BlockNumber))
+		success := x.HandleMintEvent(checkpointCtx, event)
 
-		if err = app.DB.Unlock(lockId); err != nil {
+		if err = lock.Release(ctx); err != nil {
 			log.Error("[MINT EXECUTOR] Error unlocking mint: ", err)
 			success = false
 		} else {
 			log.Debug("[MINT EXECUTOR] Unlocked mint: ", event.Raw.TxHash)
 		}
+		if !success {
+			return false
+		}
 	}
 
 	if err = filter.Error(); err != nil {
@@ -154,10 +348,26 @@ func (x *MintExecutorRunner) SyncBlocks(startBlockNumber uint64, endBlockNumber
 		return false
 	}
 
-	return success
+	return true
+}
+
+// Subscribe marks mints as executed as soon as their events are emitted and confirmed through the quorum client,
+// the periodic sync backfills anything the subscription missed
+func (x *MintExecutorRunner) Subscribe(ctx context.Context) {
+	watchEvents(ctx, MintExecutorName,
+		func(contract eth.WrappedPocketContract, sink chan<- *autogen.WrappedPocketMinted) (event.Subscription, error) {
+			return contract.WatchMinted(&bind.WatchOpts{Context: ctx}, sink, []common.Address{}, []*big.Int{}, []*big.Int{})
+		},
+		func(event *autogen.WrappedPocketMinted) {
+			if event == nil || event.Raw.Removed || !x.ConfirmMintEvent(ctx, event) {
+				return
+			}
+			x.HandleMintEvent(ctx, event)
+		},
+	)
 }
 
-func (x *MintExecutorRunner) SyncTxs() bool {
+func (x *MintExecutorRunner) SyncTxs(ctx context.Context) bool {
 
 	if x.currentBlockNumber <= x.startBlockNumber {
 		log.Info("[MINT EXECUTOR] No new blocks to sync")
@@ -176,26 +386,54 @@ func (x *MintExecutorRunner) SyncTxs() bool {
 			}
 
 			log.Info("[MINT EXECUTOR] Syncing mint txs from blockNumber: ", i, " to blockNumber: ", endBlockNumber)
-			success = success && x.SyncBlocks(uint64(i), uint64(endBlockNumber))
+			success = success && x.SyncBlocks(ctx, uint64(i), uint64(endBlockNumber))
 		}
 
 	} else {
 		log.Info("[MINT EXECUTOR] Syncing mint txs from blockNumber: ", x.startBlockNumber, " to blockNumber: ", x.currentBlockNumber)
-		success = success && x.SyncBlocks(uint64(x.startBlockNumber), uint64(x.currentBlockNumber))
+		success = success && x.SyncBlocks(ctx, uint64(x.startBlockNumber), uint64(x.currentBlockNumber))
 	}
 
 	if success {
 		x.startBlockNumber = x.currentBlockNumber
+		success = x.SaveCheckpoint(ctx)
 	}
 
 	return success
 }
 
-func (x *MintExecutorRunner) InitStartBlockNumber(lastHealth models.ServiceHealth) {
+// ReloadCheckpoint resumes from the stored checkpoint when it was rewound behind the synced block number
+func (x *MintExecutorRunner) ReloadCheckpoint(height int64) {
+	if height < x.startBlockNumber {
+		log.Warn("[MINT EXECUTOR] Checkpoint was rewound to block number: ", height)
+		x.startBlockNumber = height
+	}
+}
+
+func (x *MintExecutorRunner) SaveCheckpoint(ctx context.Context) bool {
+	err := app.SaveCheckpoint(ctx, x.validatorId, MintExecutorName, x.wpoktAddress, x.startBlockNumber)
+	if err != nil {
+		log.Error("[MINT EXECUTOR] Error saving checkpoint: ", err)
+		return false
+	}
+	log.Debug("[MINT EXECUTOR] Saved checkpoint at block number: ", x.startBlockNumber)
+	return true
+}
+
+func (x *MintExecutorRunner) InitStartBlockNumber(ctx context.Context, lastHealth models.ServiceHealth) {
 	startBlockNumber := int64(app.Config.Ethereum.StartBlockNumber)
 
-	if lastBlockNumber, err := strconv.ParseInt(lastHealth.EthBlockNumber, 10, 64); err == nil {
-		startBlockNumber = lastBlockNumber
+	checkpoint, err := app.FindCheckpoint(ctx, x.validatorId, MintExecutorName, x.wpoktAddress)
+	if err == nil {
+		log.Info("[MINT EXECUTOR] Found checkpoint at block number: ", checkpoint.Height)
+		startBlockNumber = checkpoint.Height
+	} else {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			log.Warn("[MINT EXECUTOR] Error finding checkpoint: ", err)
+		}
+		if lastBlockNumber, err := strconv.ParseInt(lastHealth.EthBlockNumber, 10, 64); err == nil {
+			startBlockNumber = lastBlockNumber
+		}
 	}
 
 	if startBlockNumber > 0 {
@@ -215,6 +453,11 @@ func NewMintExecutor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Se
 	}
 	log.Debug("[MINT EXECUTOR] Initializing mint executor")
 
+	signer, err := app.GetPocketSignerAndMultisig()
+	if err != nil {
+		log.Fatal("[MINT EXECUTOR] Error getting signer and multisig: ", err)
+	}
+
 	client, err := eth.NewClient()
 	if err != nil {
 		log.Fatal("[MINT EXECUTOR] Error initializing ethereum client", err)
@@ -244,11 +487,12 @@ func NewMintExecutor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Se
 		client:             client,
 		wpoktAddress:       strings.ToLower(app.Config.Ethereum.WrappedPocketAddress),
 		vaultAddress:       strings.ToLower(app.Config.Pocket.MultisigAddress),
+		validatorId:        signer.ValidatorId(),
 	}
 
-	x.UpdateCurrentBlockNumber()
+	x.UpdateCurrentBlockNumber(context.Background())
 
-	x.InitStartBlockNumber(lastHealth)
+	x.InitStartBlockNumber(context.Background(), lastHealth)
 
 	log.Info("[MINT EXECUTOR] Initialized mint executor")
 
@@ -1,6 +1,7 @@
 package eth
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -16,6 +17,7 @@ import (
 	eth "github.com/dan13ram/wpokt-validator/eth/client"
 	ethMocks "github.com/dan13ram/wpokt-validator/eth/client/mocks"
 	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -23,6 +25,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -41,6 +44,7 @@ func NewTestMintExecutor(t *testing.T, mockContract *ethMocks.MockWrappedPocketC
 		client:             mockClient,
 		vaultAddress:       "vaultAddress",
 		wpoktAddress:       "wpoktAddress",
+		validatorId:        "validatorId",
 	}
 	return x
 }
@@ -62,9 +66,9 @@ func TestMintExecutorUpdateCurrentBlockNumber(t *testing.T) {
 		mockClient := ethMocks.NewMockEthereumClient(t)
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 
-		mockClient.EXPECT().GetBlockNumber().Return(uint64(200), nil)
+		mockClient.EXPECT().GetBlockNumber(mock.Anything).Return(uint64(200), nil)
 
-		x.UpdateCurrentBlockNumber()
+		x.UpdateCurrentBlockNumber(context.Background())
 
 		assert.Equal(t, x.currentBlockNumber, int64(200))
 	})
@@ -74,9 +78,9 @@ func TestMintExecutorUpdateCurrentBlockNumber(t *testing.T) {
 		mockClient := ethMocks.NewMockEthereumClient(t)
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 
-		mockClient.EXPECT().GetBlockNumber().Return(uint64(200), errors.New("error"))
+		mockClient.EXPECT().GetBlockNumber(mock.Anything).Return(uint64(200), errors.New("error"))
 
-		x.UpdateCurrentBlockNumber()
+		x.UpdateCurrentBlockNumber(context.Background())
 
 		assert.Equal(t, x.currentBlockNumber, int64(100))
 	})
@@ -92,7 +96,7 @@ func TestMintExecutorHandleMintEvent(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 
-		success := x.HandleMintEvent(nil)
+		success := x.HandleMintEvent(context.Background(), nil)
 
 		assert.False(t, success)
 	})
@@ -113,7 +117,7 @@ func TestMintExecutorHandleMintEvent(t *testing.T) {
 			"amount":            event.Amount.String(),
 			"nonce":             event.Nonce.String(),
 			"status": bson.M{
-				"$in": []string{models.StatusConfirmed, models.StatusSigned, models.StatusSuccess},
+				"$in": []string{models.StatusConfirmed, models.StatusSigned, models.StatusSuccess, models.StatusOrphaned},
 			},
 		}
 
@@ -121,17 +125,20 @@ func TestMintExecutorHandleMintEvent(t *testing.T) {
 			"$set": bson.M{
 				"status":                models.StatusSuccess,
 				"mint_transaction_hash": strings.ToLower(event.Raw.TxHash.String()),
+				"mint_block_number":     "0",
+				"mint_block_hash":       strings.ToLower(event.Raw.BlockHash.String()),
+				"mint_finalized":        false,
 				"updated_at":            time.Now(),
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Once()
 
-		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{})
+		success := x.HandleMintEvent(context.Background(), &autogen.WrappedPocketMinted{})
 
 		assert.True(t, success)
 	})
@@ -143,9 +150,327 @@ func TestMintExecutorHandleMintEvent(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
 
-		success := x.HandleMintEvent(&autogen.WrappedPocketMinted{})
+		success := x.HandleMintEvent(context.Background(), &autogen.WrappedPocketMinted{})
+
+		assert.False(t, success)
+	})
+
+	t.Run("No Matching Mint", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		event := &autogen.WrappedPocketMinted{
+			Recipient: common.HexToAddress("0x01"),
+			Amount:    big.NewInt(100),
+			Nonce:     big.NewInt(1),
+			Raw:       types.Log{TxHash: common.HexToHash("0x1234")},
+		}
+
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments)
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionDiscrepancies, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, filter interface{}, update interface{}) {
+				assert.Equal(t, strings.ToLower(common.HexToHash("0x1234").Hex()), filter.(bson.M)["transaction_hash"])
+				assert.Equal(t, "minted_event", filter.(bson.M)["field"])
+				assert.Contains(t, update.(bson.M)["$set"].(bson.M)["expected"], "nonce 1")
+			})
+
+		success := x.HandleMintEvent(context.Background(), event)
+
+		assert.True(t, success)
+	})
+
+}
+
+func TestMintExecutorConfirmMintEvent(t *testing.T) {
+	blockHash := common.HexToHash("0xb1")
+	event := &autogen.WrappedPocketMinted{Raw: types.Log{TxHash: common.HexToHash("0x1234"), BlockHash: blockHash, Index: 3}}
+
+	tests := []struct {
+		name     string
+		receipt  *types.Receipt
+		err      error
+		expected bool
+	}{
+		{"Confirmed", &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockHash: blockHash, Logs: []*types.Log{{Index: 3}}}, nil, true},
+		{"No Quorum", nil, errors.New("no quorum"), false},
+		{"Reverted", &types.Receipt{Status: types.ReceiptStatusFailed, BlockHash: blockHash, Logs: []*types.Log{{Index: 3}}}, nil, false},
+		{"Different Block", &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockHash: common.HexToHash("0xb2"), Logs: []*types.Log{{Index: 3}}}, nil, false},
+		{"Log Not Found", &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockHash: blockHash, Logs: []*types.Log{{Index: 4}}}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockContract := ethMocks.NewMockWrappedPocketContract(t)
+			mockClient := ethMocks.NewMockEthereumClient(t)
+			x := NewTestMintExecutor(t, mockContract, mockClient)
+
+			mockClient.EXPECT().GetTransactionReceipt(mock.Anything, event.Raw.TxHash.String()).Return(tt.receipt, tt.err)
+
+			assert.Equal(t, tt.expected, x.ConfirmMintEvent(context.Background(), event))
+		})
+	}
+}
+
+func TestMintExecutorCheckMintBlocks(t *testing.T) {
+
+	newMints := func(result interface{}) {
+		mints := result.(*[]models.Mint)
+		*mints = append(*mints, models.Mint{
+			Id:              &primitive.NilObjectID,
+			MintBlockNumber: "90",
+			MintBlockHash:   "0xabcd",
+		})
+	}
+
+	t.Run("Orphaned", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		filter := bson.M{
+			"wpokt_address":  x.wpoktAddress,
+			"vault_address":  x.vaultAddress,
+			"status":         models.StatusSuccess,
+			"mint_finalized": false,
+		}
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newMints(result) }).Return(nil)
+		mockClient.EXPECT().GetBlockHash(mock.Anything, uint64(90)).Return("0xdcba", nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				assert.Equal(t, models.StatusOrphaned, update.(bson.M)["$set"].(bson.M)["status"])
+			}).Return(primitive.NewObjectID(), nil)
+
+		success := x.CheckMintBlocks(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("Block not found", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newMints(result) }).Return(nil)
+		mockClient.EXPECT().GetBlockHash(mock.Anything, uint64(90)).Return("", ethereum.NotFound)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				assert.Equal(t, models.StatusOrphaned, update.(bson.M)["$set"].(bson.M)["status"])
+			}).Return(primitive.NewObjectID(), nil)
+
+		success := x.CheckMintBlocks(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("Finalized", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.Ethereum.Confirmations = 10
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newMints(result) }).Return(nil)
+		mockClient.EXPECT().GetBlockHash(mock.Anything, uint64(90)).Return("0xABCD", nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				assert.Equal(t, true, update.(bson.M)["$set"].(bson.M)["mint_finalized"])
+			}).Return(primitive.NewObjectID(), nil)
+
+		success := x.CheckMintBlocks(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("Not enough confirmations", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.Ethereum.Confirmations = 20
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newMints(result) }).Return(nil)
+		mockClient.EXPECT().GetBlockHash(mock.Anything, uint64(90)).Return("0xabcd", nil)
+
+		success := x.CheckMintBlocks(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("With Error", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newMints(result) }).Return(nil)
+		mockClient.EXPECT().GetBlockHash(mock.Anything, uint64(90)).Return("", errors.New("error"))
+
+		success := x.CheckMintBlocks(context.Background())
+
+		assert.False(t, success)
+	})
+
+}
+
+func TestMintExecutorCheckOrphanedMints(t *testing.T) {
+
+	newMints := func(result interface{}) {
+		mints := result.(*[]models.Mint)
+		*mints = append(*mints, models.Mint{
+			Id:                  &primitive.NilObjectID,
+			Status:              models.StatusOrphaned,
+			MintTransactionHash: "0x1234",
+			MintBlockNumber:     "90",
+			MintBlockHash:       "0xabcd",
+		})
+	}
+
+	t.Run("Included Again", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		filter := bson.M{
+			"wpokt_address": x.wpoktAddress,
+			"vault_address": x.vaultAddress,
+			"status":        models.StatusOrphaned,
+		}
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newMints(result) }).Return(nil)
+		mockClient.EXPECT().GetTransactionReceipt(mock.Anything, "0x1234").Return(&types.Receipt{
+			Status:      types.ReceiptStatusSuccessful,
+			BlockNumber: big.NewInt(92),
+			BlockHash:   common.HexToHash("0x92"),
+		}, nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, bson.M{"_id": &primitive.NilObjectID, "status": models.StatusOrphaned}, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusSuccess, set["status"])
+				assert.Equal(t, "92", set["mint_block_number"])
+				assert.Equal(t, strings.ToLower(common.HexToHash("0x92").Hex()), set["mint_block_hash"])
+			}).Return(primitive.NewObjectID(), nil)
+
+		success := x.CheckOrphanedMints(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("Not Included Again", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.Ethereum.Confirmations = 10
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newMints(result) }).Return(nil)
+		mockClient.EXPECT().GetTransactionReceipt(mock.Anything, "0x1234").Return(nil, ethereum.NotFound)
+		mockClient.EXPECT().GetBlockHash(mock.Anything, uint64(90)).Return("0x9090", nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusSigned, set["status"])
+				assert.Equal(t, "", set["mint_transaction_hash"])
+			}).Return(primitive.NewObjectID(), nil)
+
+		success := x.CheckOrphanedMints(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("Block Still Canonical", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.Ethereum.Confirmations = 10
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newMints(result) }).Return(nil)
+		mockClient.EXPECT().GetTransactionReceipt(mock.Anything, "0x1234").Return(nil, ethereum.NotFound)
+		mockClient.EXPECT().GetBlockHash(mock.Anything, uint64(90)).Return("0xabcd", nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, bson.M{"_id": &primitive.NilObjectID, "status": models.StatusOrphaned}, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusSuccess, set["status"])
+				assert.NotContains(t, set, "mint_transaction_hash")
+			}).Return(primitive.NewObjectID(), nil)
+
+		success := x.CheckOrphanedMints(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("Recheck Fails", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.Ethereum.Confirmations = 10
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newMints(result) }).Return(nil)
+		mockClient.EXPECT().GetTransactionReceipt(mock.Anything, "0x1234").Return(nil, ethereum.NotFound)
+		mockClient.EXPECT().GetBlockHash(mock.Anything, uint64(90)).Return("", errors.New("error"))
+
+		success := x.CheckOrphanedMints(context.Background())
+
+		assert.False(t, success)
+	})
+
+	t.Run("Not enough confirmations", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		app.Config.Ethereum.Confirmations = 20
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newMints(result) }).Return(nil)
+		mockClient.EXPECT().GetTransactionReceipt(mock.Anything, "0x1234").Return(nil, ethereum.NotFound)
+
+		success := x.CheckOrphanedMints(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("With Error", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) { newMints(result) }).Return(nil)
+		mockClient.EXPECT().GetTransactionReceipt(mock.Anything, "0x1234").Return(nil, errors.New("error"))
+
+		success := x.CheckOrphanedMints(context.Background())
 
 		assert.False(t, success)
 	})
@@ -165,7 +490,9 @@ func TestMintExecutorInitStartBlockNumber(t *testing.T) {
 			EthBlockNumber: "10",
 		}
 
-		x.InitStartBlockNumber(lastHealth)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+
+		x.InitStartBlockNumber(context.Background(), lastHealth)
 
 		assert.Equal(t, x.startBlockNumber, int64(10))
 	})
@@ -181,11 +508,35 @@ func TestMintExecutorInitStartBlockNumber(t *testing.T) {
 			EthBlockNumber: "invalid",
 		}
 
-		x.InitStartBlockNumber(lastHealth)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+
+		x.InitStartBlockNumber(context.Background(), lastHealth)
 
 		assert.Equal(t, x.startBlockNumber, int64(100))
 	})
 
+	t.Run("Checkpoint is found", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintExecutor(t, mockContract, mockClient)
+
+		lastHealth := models.ServiceHealth{
+			EthBlockNumber: "10",
+		}
+
+		filter := bson.M{"validator_id": "validatorId", "service": MintExecutorName, "address": x.wpoktAddress}
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				result.(*models.Checkpoint).Height = 50
+			}).Once()
+
+		x.InitStartBlockNumber(context.Background(), lastHealth)
+
+		assert.Equal(t, x.startBlockNumber, int64(50))
+	})
+
 }
 
 func TestMintExecutorSyncBlocks(t *testing.T) {
@@ -201,6 +552,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockFilter.EXPECT().Next().Return(true).Once()
 		mockFilter.EXPECT().Next().Return(false).Once()
 		app.DB = mockDB
+		expectTransactions(mockDB)
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
@@ -209,12 +561,14 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 				assert.Equal(t, opts.Start, uint64(1))
 				assert.Equal(t, *opts.End, uint64(100))
 			}).Once()
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
-		mockDB.EXPECT().Unlock("lockId").Return(nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
 
-		success := x.SyncBlocks(1, 100)
+		success := x.SyncBlocks(context.Background(), 1, 100)
 		assert.True(t, success)
 	})
 
@@ -227,7 +581,6 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
 		mockFilter.EXPECT().Next().Return(true).Once()
-		mockFilter.EXPECT().Next().Return(false).Once()
 		app.DB = mockDB
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
@@ -238,9 +591,9 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 				assert.Equal(t, *opts.End, uint64(100))
 			}).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", errors.New("error"))
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", errors.New("error"))
 
-		success := x.SyncBlocks(1, 100)
+		success := x.SyncBlocks(context.Background(), 1, 100)
 		assert.False(t, success)
 	})
 
@@ -253,8 +606,8 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
 		mockFilter.EXPECT().Next().Return(true).Once()
-		mockFilter.EXPECT().Next().Return(false).Once()
 		app.DB = mockDB
+		expectTransactions(mockDB)
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
@@ -263,12 +616,14 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 				assert.Equal(t, opts.Start, uint64(1))
 				assert.Equal(t, *opts.End, uint64(100))
 			}).Once()
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
-		mockDB.EXPECT().Unlock("lockId").Return(errors.New("error"))
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(errors.New("error"))
 
-		success := x.SyncBlocks(1, 100)
+		success := x.SyncBlocks(context.Background(), 1, 100)
 		assert.False(t, success)
 	})
 
@@ -281,7 +636,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(nil, errors.New("some error")).Once()
 
-		success := x.SyncBlocks(1, 100)
+		success := x.SyncBlocks(context.Background(), 1, 100)
 		assert.False(t, success)
 	})
 
@@ -294,14 +649,13 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
 		mockFilter.EXPECT().Next().Return(true).Once()
-		mockFilter.EXPECT().Next().Return(false).Once()
 		app.DB = mockDB
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).Once()
 
-		assert.False(t, x.SyncBlocks(1, 100))
+		assert.False(t, x.SyncBlocks(context.Background(), 1, 100))
 	})
 
 	t.Run("Some events were removed", func(t *testing.T) {
@@ -319,17 +673,20 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockFilter.EXPECT().Next().Return(true).Times(3)
 		mockFilter.EXPECT().Next().Return(false).Once()
 		app.DB = mockDB
+		expectTransactions(mockDB)
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).Once()
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
-		mockDB.EXPECT().Unlock("lockId").Return(nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
 
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
 
-		assert.True(t, x.SyncBlocks(1, 100))
+		assert.True(t, x.SyncBlocks(context.Background(), 1, 100))
 	})
 
 	t.Run("Error in Handling First Event", func(t *testing.T) {
@@ -337,22 +694,22 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockClient := ethMocks.NewMockEthereumClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		mockFilter := ethMocks.NewMockWrappedPocketMintedIterator(t)
-		mockFilter.EXPECT().Event().Return(nil).Once()
 		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{}).Once()
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
-		mockFilter.EXPECT().Next().Return(true).Times(2)
-		mockFilter.EXPECT().Next().Return(false).Once()
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockFilter.EXPECT().Next().Return(true).Once()
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NilObjectID, errors.New("error")).Once()
 		app.DB = mockDB
+		expectTransactions(mockDB)
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).Once()
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
-		mockDB.EXPECT().Unlock("lockId").Return(nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
 
-		assert.False(t, x.SyncBlocks(1, 100))
+		assert.False(t, x.SyncBlocks(context.Background(), 1, 100))
 	})
 
 	t.Run("Error During Filtering Iteration", func(t *testing.T) {
@@ -369,7 +726,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).Once()
 
-		assert.False(t, x.SyncBlocks(1, 100))
+		assert.False(t, x.SyncBlocks(context.Background(), 1, 100))
 	})
 
 	t.Run("Error After Filtering Iteration", func(t *testing.T) {
@@ -377,7 +734,9 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockClient := ethMocks.NewMockEthereumClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		mockFilter := ethMocks.NewMockWrappedPocketMintedIterator(t)
-		mockFilter.EXPECT().Event().Return(nil).Once()
+		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketMinted{
+			Raw: types.Log{Removed: true},
+		}).Once()
 		mockFilter.EXPECT().Error().Return(nil).Once()
 		mockFilter.EXPECT().Error().Return(errors.New("iteration error")).Once()
 		mockFilter.EXPECT().Close().Return(nil)
@@ -389,7 +748,7 @@ func TestMintExecutorSyncBlocks(t *testing.T) {
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).Once()
 
-		assert.False(t, x.SyncBlocks(1, 100))
+		assert.False(t, x.SyncBlocks(context.Background(), 1, 100))
 	})
 }
 
@@ -404,7 +763,7 @@ func TestMintExecutorSyncTxs(t *testing.T) {
 		x.currentBlockNumber = 100
 		x.startBlockNumber = 100
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
@@ -418,7 +777,7 @@ func TestMintExecutorSyncTxs(t *testing.T) {
 		x.currentBlockNumber = 100
 		x.startBlockNumber = 101
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
@@ -434,6 +793,7 @@ func TestMintExecutorSyncTxs(t *testing.T) {
 		mockFilter.EXPECT().Next().Return(true).Once()
 		mockFilter.EXPECT().Next().Return(false).Once()
 		app.DB = mockDB
+		expectTransactions(mockDB)
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 		x.startBlockNumber = 1
@@ -446,11 +806,13 @@ func TestMintExecutorSyncTxs(t *testing.T) {
 				assert.Equal(t, opts.Start, uint64(1))
 				assert.Equal(t, *opts.End, uint64(final))
 			}).Once()
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
-		mockDB.EXPECT().Unlock("lockId").Return(nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 
@@ -471,6 +833,7 @@ func TestMintExecutorSyncTxs(t *testing.T) {
 		mockFilter.EXPECT().Next().Return(true).Once()
 		mockFilter.EXPECT().Next().Return(false).Once()
 		app.DB = mockDB
+		expectTransactions(mockDB)
 
 		x := NewTestMintExecutor(t, mockContract, mockClient)
 		x.currentBlockNumber = 2*eth.MAX_QUERY_BLOCKS - 1
@@ -478,11 +841,13 @@ func TestMintExecutorSyncTxs(t *testing.T) {
 
 		mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 			Return(mockFilter, nil).Times(2)
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil)
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
-		mockDB.EXPECT().Unlock("lockId").Return(nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(3)
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 
@@ -493,6 +858,16 @@ func TestMintExecutorSyncTxs(t *testing.T) {
 
 func TestNewMintExecutor(t *testing.T) {
 
+	app.Config.Pocket.Mnemonic = "test test test test test test test test test test test junk"
+	app.Config.Pocket.MultisigPublicKeys = []string{
+		"0223aa679d6d5344e201e0df9f02ab15a84726eee0dfb4e953c46a9e2cb52349dc",
+		"02faaaf0f385bb17381f36dcd86ab2486e8ff8d93440436496665ac007953076c2",
+		"02cae233806460db75a941a269490ca5165a620b43241edb8bc72e169f4143a6df",
+	}
+	app.Config.Pocket.MultisigAddress = "pokt10r5n6x28p9qntchsmhxd4ftq9lk6vzcx3dv4gx"
+	app.Config.Pocket.MultisigThreshold = 2
+	app.Config.Pocket.Bech32Prefix = "pokt"
+
 	t.Run("Disabled", func(t *testing.T) {
 
 		app.Config.MintExecutor.Enabled = false
@@ -521,6 +896,9 @@ func TestNewMintExecutor(t *testing.T) {
 	})
 
 	t.Run("Interval is 0", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments)
 
 		app.Config.MintExecutor.Enabled = true
 		app.Config.Ethereum.RPCURL = "https://eth.llamarpc.com"
@@ -531,6 +909,9 @@ func TestNewMintExecutor(t *testing.T) {
 	})
 
 	t.Run("Valid", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments)
 
 		app.Config.MintExecutor.Enabled = true
 		app.Config.MintExecutor.IntervalMillis = 1
@@ -547,6 +928,17 @@ func TestNewMintExecutor(t *testing.T) {
 
 }
 
+func TestMintExecutorReloadCheckpoint(t *testing.T) {
+	x := NewTestMintExecutor(t, ethMocks.NewMockWrappedPocketContract(t), ethMocks.NewMockEthereumClient(t))
+	x.startBlockNumber = 100
+
+	x.ReloadCheckpoint(120)
+	assert.Equal(t, int64(100), x.startBlockNumber)
+
+	x.ReloadCheckpoint(50)
+	assert.Equal(t, int64(50), x.startBlockNumber)
+}
+
 func TestMintExecutorRun(t *testing.T) {
 
 	mockContract := ethMocks.NewMockWrappedPocketContract(t)
@@ -560,21 +952,31 @@ func TestMintExecutorRun(t *testing.T) {
 	mockFilter.EXPECT().Next().Return(false).Once()
 
 	app.DB = mockDB
+	expectTransactions(mockDB)
 	x := NewTestMintExecutor(t, mockContract, mockClient)
 	x.currentBlockNumber = 100
 	x.startBlockNumber = 1
 
-	mockClient.EXPECT().GetBlockNumber().Return(uint64(100), nil)
+	mockClient.EXPECT().GetBlockNumber(mock.Anything).Return(uint64(100), nil)
 	mockContract.EXPECT().FilterMinted(mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
 		Return(mockFilter, nil).
 		Run(func(opts *bind.FilterOpts, recipient []common.Address, amount []*big.Int, nonce []*big.Int) {
 			assert.Equal(t, opts.Start, uint64(1))
 			assert.Equal(t, *opts.End, uint64(100))
 		}).Once()
-	mockDB.EXPECT().UpdateOne(models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
-	mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
-	mockDB.EXPECT().Unlock("lockId").Return(nil)
-
-	x.Run()
+	mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+	mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+	mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+	mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+	mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
+	mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(nil).Times(2)
+	mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+			result.(*models.Checkpoint).Height = 1
+		}).Return(nil)
+
+	err := x.Run(context.Background())
+
+	assert.Nil(t, err)
 
 }
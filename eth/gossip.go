@@ -0,0 +1,224 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	"github.com/dan13ram/wpokt-validator/eth/util"
+	"github.com/dan13ram/wpokt-validator/models"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var appFetchPeerMints = app.FetchPeerDocuments[models.Mint]
+
+// SyncPeerSignatures merges the mint signatures of the gossip peers into the local database
+func (x *MintSignerRunner) SyncPeerSignatures(ctx context.Context) error {
+	log.Debug("[MINT SIGNER] Syncing peer signatures")
+
+	var errs error
+	for _, peer := range app.Config.Gossip.Peers {
+		peerMints, err := appFetchPeerMints(ctx, peer, app.GossipPathMints)
+		if err != nil {
+			log.Error("[MINT SIGNER] Error fetching peer mints: ", err)
+			errs = errors.Join(errs, err)
+			continue
+		}
+
+		for i := range peerMints {
+			if err := x.MergePeerMint(ctx, &peerMints[i]); err != nil {
+				log.Error("[MINT SIGNER] Error merging peer mint: ", err)
+				errs = errors.Join(errs, err)
+			}
+		}
+	}
+
+	log.Debug("[MINT SIGNER] Synced peer signatures")
+	return errs
+}
+
+// MergePeerMint adds the valid signatures of a peer mint to the local mint with the same transaction hash.
+// When the peer signed the mint with a lower nonce than the local mint, every validator converges on the
+// lowest nonce: the local signatures are dropped and the local mint is signed again with the peer data.
+func (x *MintSignerRunner) MergePeerMint(ctx context.Context, peerMint *models.Mint) error {
+	if peerMint.Data == nil || len(peerMint.Signers) == 0 || len(peerMint.Signers) != len(peerMint.Signatures) {
+		return nil
+	}
+
+	var mint models.Mint
+	filter := bson.M{
+		"transaction_hash": peerMint.TransactionHash,
+		"wpokt_address":    x.wpoktAddress,
+		"vault_address":    x.vaultAddress,
+	}
+	if err := app.DB.FindOne(ctx, models.CollectionMints, filter, &mint); err != nil {
+		// the mint monitor has not seen this transaction yet
+		log.Debug("[MINT SIGNER] Peer mint not found locally: ", peerMint.TransactionHash)
+		return nil
+	}
+
+	if mint.Status != models.StatusPending && mint.Status != models.StatusConfirmed && mint.Status != models.StatusSigned {
+		return nil
+	}
+
+	data, err := peerMintData(&mint, peerMint.Data)
+	if err != nil {
+		log.Warn("[MINT SIGNER] Invalid peer mint data: ", err)
+		return nil
+	}
+
+	signers := append([]string{}, mint.Signers...)
+	signatures := append([]string{}, mint.Signatures...)
+	if mint.Data != nil && *mint.Data != *peerMint.Data {
+		localNonce, ok := new(big.Int).SetString(mint.Data.Nonce, 10)
+		if ok && localNonce.Cmp(data.Nonce) <= 0 {
+			// the peer adopts the local nonce once it merges the local signatures
+			log.Debug("[MINT SIGNER] Peer mint uses a higher nonce than the local mint: ", mint.TransactionHash)
+			return nil
+		}
+		if mint.Status == models.StatusSigned {
+			// the local signatures may already be used to mint
+			log.Warn("[MINT SIGNER] Peer mint uses a lower nonce than the signed local mint: ", mint.TransactionHash)
+			return nil
+		}
+		log.Info("[MINT SIGNER] Adopting the lower nonce of the peer mint: ", mint.TransactionHash)
+		signers = []string{}
+		signatures = []string{}
+	}
+
+	if mint.Data == nil || *mint.Data != *peerMint.Data {
+		used, err := x.isNonceUsed(ctx, &mint, peerMint.Data.Nonce)
+		if err != nil {
+			return err
+		}
+		if used {
+			log.Warn("[MINT SIGNER] Peer mint nonce is used by another mint: ", mint.TransactionHash)
+			return nil
+		}
+	}
+
+	mergedFrom := len(signers)
+	for i, signer := range peerMint.Signers {
+		signer = strings.ToLower(signer)
+		if containsSigner(signers, signer) {
+			continue
+		}
+		if !isValidatorAddress(signer) {
+			log.Warn("[MINT SIGNER] Peer mint signer is not a validator: ", signer)
+			continue
+		}
+		recovered, err := util.RecoverMintSigner(x.domain, data, peerMint.Signatures[i])
+		if err != nil || recovered != signer {
+			log.Warn("[MINT SIGNER] Invalid peer mint signature from: ", signer)
+			continue
+		}
+		signers = append(signers, signer)
+		signatures = append(signatures, peerMint.Signatures[i])
+	}
+
+	if len(signers) == mergedFrom {
+		return nil
+	}
+
+	signers, signatures = util.SortSignersAndSignatures(signers, signatures)
+
+	status := mint.Status
+	if int64(len(signatures)) >= x.signerThreshold {
+		status = models.StatusSigned
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"data":       peerMint.Data,
+			"nonce":      peerMint.Data.Nonce,
+			"signers":    signers,
+			"signatures": signatures,
+			"status":     status,
+			"updated_at": time.Now(),
+		},
+	}
+	// only update the version of the mint the signatures were merged into
+	updateFilter := bson.M{
+		"_id":     mint.Id,
+		"status":  mint.Status,
+		"signers": mint.Signers,
+	}
+
+	resourceId := fmt.Sprintf("%s/%s", models.CollectionMints, strings.ToLower(mint.RecipientAddress))
+	lock, lockCtx, err := app.AcquireLock(ctx, resourceId)
+	if err != nil {
+		return fmt.Errorf("error locking mint: %w", err)
+	}
+	defer func() {
+		if err := lock.Release(ctx); err != nil {
+			log.Error("[MINT SIGNER] Error unlocking mint: ", err)
+		}
+	}()
+
+	updateFilter, update, err = app.Fence(lockCtx, updateFilter, update, mint.FencingToken)
+	if err != nil {
+		return fmt.Errorf("lock lost before updating mint: %w", err)
+	}
+
+	if _, err := app.DB.UpdateOne(lockCtx, models.CollectionMints, updateFilter, update); err != nil {
+		return fmt.Errorf("error updating mint: %w", err)
+	}
+	log.Info("[MINT SIGNER] Merged peer signatures into mint: ", mint.TransactionHash)
+	return nil
+}
+
+// isNonceUsed checks if another mint to the same recipient was given the nonce
+func (x *MintSignerRunner) isNonceUsed(ctx context.Context, mint *models.Mint, nonce string) (bool, error) {
+	filter := bson.M{
+		"_id":               bson.M{"$ne": mint.Id},
+		"wpokt_address":     x.wpoktAddress,
+		"vault_address":     x.vaultAddress,
+		"recipient_address": strings.ToLower(mint.RecipientAddress),
+		"nonce":             nonce,
+		"status":            bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusSigned, models.StatusSuccess}},
+	}
+	count, err := app.DB.CountDocuments(ctx, models.CollectionMints, filter)
+	if err != nil {
+		return false, fmt.Errorf("error checking mint nonce: %w", err)
+	}
+	return count > 0, nil
+}
+
+// peerMintData checks that the peer mint data pays the local mint and returns it for signature recovery
+func peerMintData(mint *models.Mint, data *models.MintData) (*autogen.MintControllerMintData, error) {
+	if !strings.EqualFold(data.Recipient, mint.RecipientAddress) {
+		return nil, fmt.Errorf("recipient %s does not match %s", data.Recipient, mint.RecipientAddress)
+	}
+	if data.Amount != mint.Amount {
+		return nil, fmt.Errorf("amount %s does not match %s", data.Amount, mint.Amount)
+	}
+	amount, ok := new(big.Int).SetString(data.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %s", data.Amount)
+	}
+	nonce, ok := new(big.Int).SetString(data.Nonce, 10)
+	if !ok || nonce.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid nonce %s", data.Nonce)
+	}
+	return &autogen.MintControllerMintData{
+		Recipient: ethcommon.HexToAddress(data.Recipient),
+		Amount:    amount,
+		Nonce:     nonce,
+	}, nil
+}
+
+func containsSigner(signers []string, signer string) bool {
+	for _, s := range signers {
+		if strings.EqualFold(s, signer) {
+			return true
+		}
+	}
+	return false
+}
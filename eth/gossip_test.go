@@ -0,0 +1,251 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	appMocks "github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/common"
+	cosmosMocks "github.com/dan13ram/wpokt-validator/cosmos/client/mocks"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	ethMocks "github.com/dan13ram/wpokt-validator/eth/client/mocks"
+	"github.com/dan13ram/wpokt-validator/eth/util"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestMintSignerMergePeerMint(t *testing.T) {
+	peerSigner, _ := common.NewMnemonicSigner("test test test test test test test test test test test junk")
+	peerAddress := strings.ToLower(peerSigner.EthAddress().Hex())
+
+	recipient := common.HexToAddress("0x1234")
+	data := &autogen.MintControllerMintData{
+		Recipient: recipient,
+		Amount:    big.NewInt(20000),
+		Nonce:     big.NewInt(2),
+	}
+	mintData := &models.MintData{
+		Recipient: strings.ToLower(recipient.Hex()),
+		Amount:    "20000",
+		Nonce:     "2",
+	}
+
+	setup := func(t *testing.T) (*MintSignerRunner, *appMocks.MockDatabase) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintSigner(t, ethMocks.NewMockWrappedPocketContract(t), ethMocks.NewMockMintControllerContract(t), ethMocks.NewMockEthereumClient(t), cosmosMocks.NewMockCosmosClient(t))
+		x.vaultAddress = "vault"
+		x.wpoktAddress = "wpokt"
+
+		oldValidatorAddresses := app.Config.Ethereum.ValidatorAddresses
+		t.Cleanup(func() { app.Config.Ethereum.ValidatorAddresses = oldValidatorAddresses })
+		app.Config.Ethereum.ValidatorAddresses = []string{peerAddress, x.address}
+		return x, mockDB
+	}
+
+	peerMint := func(t *testing.T, x *MintSignerRunner) *models.Mint {
+		mint, err := util.SignMint(context.Background(), &models.Mint{TransactionHash: "hash", Data: mintData}, data, x.domain, peerSigner, 2)
+		assert.NoError(t, err)
+		return mint
+	}
+
+	expectLocalMint := func(mockDB *appMocks.MockDatabase, local models.Mint) {
+		filter := bson.M{"transaction_hash": "hash", "wpokt_address": "wpokt", "vault_address": "vault"}
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionMints, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				*result.(*models.Mint) = local
+			}).Return(nil)
+	}
+
+	expectNonceCount := func(mockDB *appMocks.MockDatabase, count int64) {
+		mockDB.EXPECT().CountDocuments(mock.Anything, models.CollectionMints, mock.Anything).
+			Run(func(_ context.Context, _ string, filter interface{}) {
+				assert.Equal(t, "2", filter.(bson.M)["nonce"])
+				assert.Equal(t, strings.ToLower(recipient.Hex()), filter.(bson.M)["recipient_address"])
+			}).Return(count, nil)
+	}
+
+	t.Run("Peer signature merged into unsigned mint", func(t *testing.T) {
+		x, mockDB := setup(t)
+		id := primitive.NewObjectID()
+		expectLocalMint(mockDB, models.Mint{Id: &id, TransactionHash: "hash", RecipientAddress: recipient.Hex(), Amount: "20000", Status: models.StatusConfirmed})
+		expectNonceCount(mockDB, 0)
+
+		mockDB.EXPECT().XLock(mock.Anything, "mints/"+strings.ToLower(recipient.Hex())).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, "mints/"+strings.ToLower(recipient.Hex())).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, filter interface{}, update interface{}) {
+				assert.Equal(t, &id, filter.(bson.M)["$and"].([]bson.M)[0]["_id"])
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, []string{peerAddress}, set["signers"])
+				assert.Equal(t, "2", set["nonce"])
+				assert.Equal(t, models.StatusConfirmed, set["status"])
+			}).Return(id, nil)
+
+		err := x.MergePeerMint(context.Background(), peerMint(t, x))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Peer signature reaches threshold", func(t *testing.T) {
+		x, mockDB := setup(t)
+		local, err := util.SignMint(context.Background(), &models.Mint{TransactionHash: "hash", RecipientAddress: recipient.Hex(), Amount: "20000", Status: models.StatusConfirmed, Data: mintData}, data, x.domain, x.signer, 2)
+		assert.NoError(t, err)
+		expectLocalMint(mockDB, *local)
+
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, 2, len(set["signatures"].([]string)))
+				assert.Equal(t, models.StatusSigned, set["status"])
+			}).Return(primitive.NewObjectID(), nil)
+
+		err = x.MergePeerMint(context.Background(), peerMint(t, x))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Local mint not found", func(t *testing.T) {
+		x, mockDB := setup(t)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(errors.New("not found"))
+
+		err := x.MergePeerMint(context.Background(), peerMint(t, x))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Peer mint uses a higher nonce", func(t *testing.T) {
+		x, mockDB := setup(t)
+		expectLocalMint(mockDB, models.Mint{TransactionHash: "hash", RecipientAddress: recipient.Hex(), Amount: "20000", Status: models.StatusConfirmed,
+			Data: &models.MintData{Recipient: mintData.Recipient, Amount: "20000", Nonce: "1"}})
+
+		err := x.MergePeerMint(context.Background(), peerMint(t, x))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Peer mint uses a lower nonce", func(t *testing.T) {
+		x, mockDB := setup(t)
+		localData := &autogen.MintControllerMintData{Recipient: recipient, Amount: big.NewInt(20000), Nonce: big.NewInt(3)}
+		local, err := util.SignMint(context.Background(), &models.Mint{TransactionHash: "hash", RecipientAddress: recipient.Hex(), Amount: "20000", Status: models.StatusConfirmed,
+			Data: &models.MintData{Recipient: mintData.Recipient, Amount: "20000", Nonce: "3"}}, localData, x.domain, x.signer, 2)
+		assert.NoError(t, err)
+		expectLocalMint(mockDB, *local)
+		expectNonceCount(mockDB, 0)
+
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+			Run(func(_ context.Context, _ string, filter interface{}, update interface{}) {
+				assert.Equal(t, local.Signers, filter.(bson.M)["$and"].([]bson.M)[0]["signers"])
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, []string{peerAddress}, set["signers"])
+				assert.Equal(t, "2", set["nonce"])
+				assert.Equal(t, models.StatusConfirmed, set["status"])
+			}).Return(primitive.NewObjectID(), nil)
+
+		err = x.MergePeerMint(context.Background(), peerMint(t, x))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Signed local mint keeps its nonce", func(t *testing.T) {
+		x, mockDB := setup(t)
+		expectLocalMint(mockDB, models.Mint{TransactionHash: "hash", RecipientAddress: recipient.Hex(), Amount: "20000", Status: models.StatusSigned,
+			Data: &models.MintData{Recipient: mintData.Recipient, Amount: "20000", Nonce: "3"}})
+
+		err := x.MergePeerMint(context.Background(), peerMint(t, x))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Peer nonce used by another mint", func(t *testing.T) {
+		x, mockDB := setup(t)
+		expectLocalMint(mockDB, models.Mint{TransactionHash: "hash", RecipientAddress: recipient.Hex(), Amount: "20000", Status: models.StatusConfirmed})
+		expectNonceCount(mockDB, 1)
+
+		err := x.MergePeerMint(context.Background(), peerMint(t, x))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Peer data pays another amount", func(t *testing.T) {
+		x, mockDB := setup(t)
+		expectLocalMint(mockDB, models.Mint{TransactionHash: "hash", RecipientAddress: recipient.Hex(), Amount: "10000", Status: models.StatusConfirmed})
+
+		err := x.MergePeerMint(context.Background(), peerMint(t, x))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Peer signer is not a validator", func(t *testing.T) {
+		x, mockDB := setup(t)
+		app.Config.Ethereum.ValidatorAddresses = []string{x.address}
+		expectLocalMint(mockDB, models.Mint{TransactionHash: "hash", RecipientAddress: recipient.Hex(), Amount: "20000", Status: models.StatusConfirmed})
+		expectNonceCount(mockDB, 0)
+
+		err := x.MergePeerMint(context.Background(), peerMint(t, x))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Forged peer signature", func(t *testing.T) {
+		x, mockDB := setup(t)
+		expectLocalMint(mockDB, models.Mint{TransactionHash: "hash", RecipientAddress: recipient.Hex(), Amount: "20000", Status: models.StatusConfirmed})
+		expectNonceCount(mockDB, 0)
+
+		mint := peerMint(t, x)
+		mint.Signers = []string{x.address}
+
+		err := x.MergePeerMint(context.Background(), mint)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestMintSignerSyncPeerSignatures(t *testing.T) {
+	oldConfig, oldFetchPeerMints := app.Config.Gossip, appFetchPeerMints
+	defer func() { app.Config.Gossip, appFetchPeerMints = oldConfig, oldFetchPeerMints }()
+	app.Config.Gossip = models.GossipConfig{Enabled: true, TimeoutMillis: 1000, Peers: []string{"https://peer1", "https://peer2"}}
+
+	x := NewTestMintSigner(t, ethMocks.NewMockWrappedPocketContract(t), ethMocks.NewMockMintControllerContract(t), ethMocks.NewMockEthereumClient(t), cosmosMocks.NewMockCosmosClient(t))
+
+	t.Run("No Error", func(t *testing.T) {
+		peers := []string{}
+		appFetchPeerMints = func(_ context.Context, peer string, path string) ([]models.Mint, error) {
+			assert.Equal(t, app.GossipPathMints, path)
+			peers = append(peers, peer)
+			return []models.Mint{{TransactionHash: "hash"}}, nil
+		}
+
+		err := x.SyncPeerSignatures(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, app.Config.Gossip.Peers, peers)
+	})
+
+	t.Run("Peer unreachable", func(t *testing.T) {
+		peers := []string{}
+		appFetchPeerMints = func(_ context.Context, peer string, _ string) ([]models.Mint, error) {
+			peers = append(peers, peer)
+			return nil, errors.New("unreachable")
+		}
+
+		err := x.SyncPeerSignatures(context.Background())
+
+		assert.Error(t, err)
+		assert.Equal(t, app.Config.Gossip.Peers, peers)
+	})
+}
@@ -2,8 +2,11 @@ package eth
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,7 +17,9 @@ import (
 	"github.com/dan13ram/wpokt-validator/models"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
 	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
@@ -28,30 +33,39 @@ type BurnMonitorRunner struct {
 	wpoktContract      eth.WrappedPocketContract
 	client             eth.EthereumClient
 	minimumAmount      *big.Int
+	failedRange        string
+	wpoktAddress       string
+	validatorId        string
 }
 
-func (x *BurnMonitorRunner) Run() {
-	x.UpdateCurrentBlockNumber()
-	x.SyncTxs()
+func (x *BurnMonitorRunner) Run(ctx context.Context) error {
+	err := x.UpdateCurrentBlockNumber(ctx)
+	if !app.SyncFromCheckpoint(ctx, x.validatorId, BurnMonitorName, x.wpoktAddress, x.ReloadCheckpoint, x.SyncTxs) {
+		err = errors.Join(err, app.ErrSyncTxs)
+	}
+	return err
 }
 
 func (x *BurnMonitorRunner) Status() models.RunnerStatus {
 	return models.RunnerStatus{
 		EthBlockNumber: strconv.FormatInt(x.startBlockNumber, 10),
+		FailedRange:    x.failedRange,
+		Endpoints:      app.EndpointHealth(x.client),
 	}
 }
 
-func (x *BurnMonitorRunner) UpdateCurrentBlockNumber() {
-	res, err := x.client.GetBlockNumber()
+func (x *BurnMonitorRunner) UpdateCurrentBlockNumber(ctx context.Context) error {
+	res, err := x.client.GetBlockNumber(ctx)
 	if err != nil {
 		log.Error("[BURN MONITOR] Error while getting current block number: ", err)
-		return
+		return fmt.Errorf("error while getting current block number: %w", err)
 	}
 	x.currentBlockNumber = int64(res)
 	log.Info("[BURN MONITOR] Current block number: ", x.currentBlockNumber)
+	return nil
 }
 
-func (x *BurnMonitorRunner) HandleBurnEvent(event *autogen.WrappedPocketBurnAndBridge) bool {
+func (x *BurnMonitorRunner) HandleBurnEvent(ctx context.Context, event *autogen.WrappedPocketBurnAndBridge) bool {
 	if event == nil {
 		log.Error("[BURN MONITOR] Error while handling burn event: event is nil")
 		return false
@@ -62,7 +76,15 @@ func (x *BurnMonitorRunner) HandleBurnEvent(event *autogen.WrappedPocketBurnAndB
 	// each event is a combination of transaction hash and log index
 	log.Debug("[BURN MONITOR] Handling burn event: ", event.Raw.TxHash, " ", event.Raw.Index)
 
-	_, err := app.DB.InsertOne(models.CollectionBurns, doc)
+	// an orphaned burn whose transaction was included again is restored instead of stored a second time
+	err := app.PersistWithCheckpoint(ctx, func(ctx context.Context) error {
+		restored, err := x.RestoreOrphanedBurn(ctx, doc)
+		if err != nil || restored {
+			return err
+		}
+		_, err = app.DB.InsertOne(ctx, models.CollectionBurns, doc)
+		return err
+	})
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
 			log.Info("[BURN MONITOR] Found duplicate burn event: ", event.Raw.TxHash, " ", event.Raw.Index)
@@ -76,11 +98,55 @@ func (x *BurnMonitorRunner) HandleBurnEvent(event *autogen.WrappedPocketBurnAndB
 	return true
 }
 
-func (x *BurnMonitorRunner) SyncBlocks(startBlockNumber uint64, endBlockNumber uint64) bool {
+// RestoreOrphanedBurn moves an orphaned burn to the block its transaction was included in again, and reports whether one was restored.
+// The burn is matched on its transaction hash and log index, or on its sender, recipient and amount if the log moved within the block.
+func (x *BurnMonitorRunner) RestoreOrphanedBurn(ctx context.Context, doc models.Burn) (bool, error) {
+	update := bson.M{
+		"$set": bson.M{
+			"block_number":  doc.BlockNumber,
+			"block_hash":    doc.BlockHash,
+			"log_index":     doc.LogIndex,
+			"confirmations": "0",
+			"status":        models.StatusPending,
+			"updated_at":    time.Now(),
+		},
+	}
+
+	filters := []bson.M{
+		{
+			"transaction_hash": doc.TransactionHash,
+			"log_index":        doc.LogIndex,
+			"status":           models.StatusOrphaned,
+		},
+		{
+			"transaction_hash":  doc.TransactionHash,
+			"sender_address":    doc.SenderAddress,
+			"recipient_address": doc.RecipientAddress,
+			"amount":            doc.Amount,
+			"status":            models.StatusOrphaned,
+		},
+	}
+
+	for _, filter := range filters {
+		_, err := app.DB.UpdateOne(ctx, models.CollectionBurns, filter, update)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			continue
+		}
+		if err != nil {
+			log.Error("[BURN MONITOR] Error while restoring orphaned burn: ", err)
+			return false, err
+		}
+		log.Info("[BURN MONITOR] Restored orphaned burn in block: ", doc.BlockNumber)
+		return true, nil
+	}
+	return false, nil
+}
+
+func (x *BurnMonitorRunner) SyncBlocks(ctx context.Context, startBlockNumber uint64, endBlockNumber uint64) bool {
 	filter, err := x.wpoktContract.FilterBurnAndBridge(&bind.FilterOpts{
 		Start:   startBlockNumber,
 		End:     &endBlockNumber,
-		Context: context.Background(),
+		Context: ctx,
 	}, []*big.Int{}, []common.Address{}, []common.Address{})
 
 	if filter != nil {
@@ -93,25 +159,26 @@ func (x *BurnMonitorRunner) SyncBlocks(startBlockNumber uint64, endBlockNumber u
 		return false
 	}
 
-	var success = true
 	for filter.Next() {
 		if err := filter.Error(); err != nil {
-			success = false
 			break
 		}
 
 		event := filter.Event()
 
 		if event == nil {
-			success = false
-			continue
+			return false
 		}
 
 		if event.Raw.Removed || event.Amount.Cmp(x.minimumAmount) != 1 {
 			continue
 		}
 
-		success = x.HandleBurnEvent(event) && success
+		// each burn is stored together with the checkpoint at its block, so syncing stops at the first burn that could not be stored
+		checkpointCtx := app.WithCheckpoint(ctx, x.validatorId, BurnMonitorName, x.wpoktAddress, int64(event.Raw.BlockNumber))
+		if !x.HandleBurnEvent(checkpointCtx, event) {
+			return false
+		}
 	}
 
 	if err := filter.Error(); err != nil {
@@ -119,43 +186,87 @@ func (x *BurnMonitorRunner) SyncBlocks(startBlockNumber uint64, endBlockNumber u
 		return false
 	}
 
-	return success
+	return true
+}
+
+// Subscribe stores burn events as they are emitted, the periodic sync backfills anything the subscription missed
+func (x *BurnMonitorRunner) Subscribe(ctx context.Context) {
+	watchEvents(ctx, BurnMonitorName,
+		func(contract eth.WrappedPocketContract, sink chan<- *autogen.WrappedPocketBurnAndBridge) (event.Subscription, error) {
+			return contract.WatchBurnAndBridge(&bind.WatchOpts{Context: ctx}, sink, []*big.Int{}, []common.Address{}, []common.Address{})
+		},
+		func(event *autogen.WrappedPocketBurnAndBridge) {
+			if event == nil || event.Raw.Removed || event.Amount.Cmp(x.minimumAmount) != 1 {
+				return
+			}
+			x.HandleBurnEvent(ctx, event)
+		},
+	)
 }
 
-func (x *BurnMonitorRunner) SyncTxs() bool {
+func (x *BurnMonitorRunner) SyncTxs(ctx context.Context) bool {
 	if x.currentBlockNumber <= x.startBlockNumber {
 		log.Info("[BURN MONITOR] No new blocks to sync")
+		x.failedRange = ""
 		return true
 	}
 
-	success := true
-	if (x.currentBlockNumber - x.startBlockNumber) > eth.MAX_QUERY_BLOCKS {
-		log.Debug("[BURN MONITOR] Syncing burn txs in chunks")
-		for i := x.startBlockNumber; i < x.currentBlockNumber; i += eth.MAX_QUERY_BLOCKS {
-			endBlockNumber := i + eth.MAX_QUERY_BLOCKS
-			if endBlockNumber > x.currentBlockNumber {
-				endBlockNumber = x.currentBlockNumber
-			}
-			log.Info("[BURN MONITOR] Syncing burn txs from blockNumber: ", i, " to blockNumber: ", endBlockNumber)
-			success = success && x.SyncBlocks(uint64(i), uint64(endBlockNumber))
+	for x.startBlockNumber < x.currentBlockNumber {
+		endBlockNumber := x.startBlockNumber + eth.MAX_QUERY_BLOCKS
+		if endBlockNumber > x.currentBlockNumber {
+			endBlockNumber = x.currentBlockNumber
+		}
+
+		log.Info("[BURN MONITOR] Syncing burn txs from blockNumber: ", x.startBlockNumber, " to blockNumber: ", endBlockNumber)
+		if !x.SyncBlocks(ctx, uint64(x.startBlockNumber), uint64(endBlockNumber)) {
+			x.failedRange = fmt.Sprintf("%d-%d", x.startBlockNumber, endBlockNumber)
+			log.Error("[BURN MONITOR] Failed to sync burn txs in range: ", x.failedRange)
+			return false
+		}
+
+		// commit progress after every chunk so that only the failing chunk is retried
+		x.startBlockNumber = endBlockNumber
+		if !x.SaveCheckpoint(ctx) {
+			return false
 		}
-	} else {
-		log.Info("[BURN MONITOR] Syncing burn txs from blockNumber: ", x.startBlockNumber, " to blockNumber: ", x.currentBlockNumber)
-		success = success && x.SyncBlocks(uint64(x.startBlockNumber), uint64(x.currentBlockNumber))
 	}
 
-	if success {
-		x.startBlockNumber = x.currentBlockNumber
+	x.failedRange = ""
+	return true
+}
+
+// ReloadCheckpoint resumes from the stored checkpoint when it was rewound behind the synced block number
+func (x *BurnMonitorRunner) ReloadCheckpoint(height int64) {
+	if height < x.startBlockNumber {
+		log.Warn("[BURN MONITOR] Checkpoint was rewound to block number: ", height)
+		x.startBlockNumber = height
 	}
+}
 
-	return success
+func (x *BurnMonitorRunner) SaveCheckpoint(ctx context.Context) bool {
+	err := app.SaveCheckpoint(ctx, x.validatorId, BurnMonitorName, x.wpoktAddress, x.startBlockNumber)
+	if err != nil {
+		log.Error("[BURN MONITOR] Error saving checkpoint: ", err)
+		return false
+	}
+	log.Debug("[BURN MONITOR] Saved checkpoint at block number: ", x.startBlockNumber)
+	return true
 }
 
-func (x *BurnMonitorRunner) InitStartBlockNumber(lastHealth models.ServiceHealth) {
+func (x *BurnMonitorRunner) InitStartBlockNumber(ctx context.Context, lastHealth models.ServiceHealth) {
 	startBlockNumber := int64(app.Config.Ethereum.StartBlockNumber)
 
-	if lastBlockNumber, err := strconv.ParseInt(lastHealth.EthBlockNumber, 10, 64); err == nil {
-		startBlockNumber = lastBlockNumber
+	checkpoint, err := app.FindCheckpoint(ctx, x.validatorId, BurnMonitorName, x.wpoktAddress)
+	if err == nil {
+		log.Info("[BURN MONITOR] Found checkpoint at block number: ", checkpoint.Height)
+		startBlockNumber = checkpoint.Height
+	} else {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			log.Warn("[BURN MONITOR] Error finding checkpoint: ", err)
+		}
+		if lastBlockNumber, err := strconv.ParseInt(lastHealth.EthBlockNumber, 10, 64); err == nil {
+			startBlockNumber = lastBlockNumber
+		}
 	}
 
 	if startBlockNumber > 0 {
@@ -175,6 +286,12 @@ func NewBurnMonitor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Ser
 	}
 
 	log.Debug("[BURN MONITOR] Initializing burn monitor")
+
+	signer, err := app.GetPocketSignerAndMultisig()
+	if err != nil {
+		log.Fatal("[BURN MONITOR] Error getting signer and multisig: ", err)
+	}
+
 	client, err := eth.NewClient()
 	if err != nil {
 		log.Fatal("[BURN MONITOR] Error initializing ethereum client: ", err)
@@ -193,11 +310,13 @@ func NewBurnMonitor(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Ser
 		wpoktContract:      eth.NewWrappedPocketContract(contract),
 		client:             client,
 		minimumAmount:      big.NewInt(app.Config.Pocket.TxFee),
+		wpoktAddress:       strings.ToLower(app.Config.Ethereum.WrappedPocketAddress),
+		validatorId:        signer.ValidatorId(),
 	}
 
-	x.UpdateCurrentBlockNumber()
+	x.UpdateCurrentBlockNumber(context.Background())
 
-	x.InitStartBlockNumber(lastHealth)
+	x.InitStartBlockNumber(context.Background(), lastHealth)
 
 	log.Info("[BURN MONITOR] Initialized burn monitor")
 
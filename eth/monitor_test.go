@@ -1,10 +1,12 @@
 package eth
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"math/big"
+	"strings"
 	"sync"
 	"testing"
 
@@ -13,12 +15,14 @@ import (
 	"github.com/dan13ram/wpokt-validator/eth/autogen"
 	eth "github.com/dan13ram/wpokt-validator/eth/client"
 	ethMocks "github.com/dan13ram/wpokt-validator/eth/client/mocks"
+	"github.com/dan13ram/wpokt-validator/eth/util"
 	"github.com/dan13ram/wpokt-validator/models"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 
@@ -29,6 +33,12 @@ func init() {
 	log.SetOutput(io.Discard)
 }
 
+// expectTransactions runs transactions of the mock database directly
+func expectTransactions(mockDB *appMocks.MockDatabase) {
+	mockDB.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) }).Maybe()
+}
+
 func NewTestBurnMonitor(t *testing.T, mockContract *ethMocks.MockWrappedPocketContract, mockClient *ethMocks.MockEthereumClient) *BurnMonitorRunner {
 	x := &BurnMonitorRunner{
 		startBlockNumber:   0,
@@ -36,6 +46,8 @@ func NewTestBurnMonitor(t *testing.T, mockContract *ethMocks.MockWrappedPocketCo
 		wpoktContract:      mockContract,
 		client:             mockClient,
 		minimumAmount:      big.NewInt(10000),
+		wpoktAddress:       "wpoktAddress",
+		validatorId:        "validatorId",
 	}
 	return x
 }
@@ -57,9 +69,9 @@ func TestBurnMonitorUpdateCurrentBlockNumber(t *testing.T) {
 		mockClient := ethMocks.NewMockEthereumClient(t)
 		x := NewTestBurnMonitor(t, mockContract, mockClient)
 
-		mockClient.EXPECT().GetBlockNumber().Return(uint64(200), nil)
+		mockClient.EXPECT().GetBlockNumber(mock.Anything).Return(uint64(200), nil)
 
-		x.UpdateCurrentBlockNumber()
+		x.UpdateCurrentBlockNumber(context.Background())
 
 		assert.Equal(t, x.currentBlockNumber, int64(200))
 	})
@@ -69,9 +81,9 @@ func TestBurnMonitorUpdateCurrentBlockNumber(t *testing.T) {
 		mockClient := ethMocks.NewMockEthereumClient(t)
 		x := NewTestBurnMonitor(t, mockContract, mockClient)
 
-		mockClient.EXPECT().GetBlockNumber().Return(uint64(200), errors.New("error"))
+		mockClient.EXPECT().GetBlockNumber(mock.Anything).Return(uint64(200), errors.New("error"))
 
-		x.UpdateCurrentBlockNumber()
+		x.UpdateCurrentBlockNumber(context.Background())
 
 		assert.Equal(t, x.currentBlockNumber, int64(100))
 	})
@@ -87,7 +99,7 @@ func TestBurnMonitorHandleBurnEvent(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestBurnMonitor(t, mockContract, mockClient)
 
-		success := x.HandleBurnEvent(nil)
+		success := x.HandleBurnEvent(context.Background(), nil)
 
 		assert.False(t, success)
 	})
@@ -99,9 +111,10 @@ func TestBurnMonitorHandleBurnEvent(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestBurnMonitor(t, mockContract, mockClient)
 
-		mockDB.EXPECT().InsertOne(models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments).Times(2)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil)
 
-		success := x.HandleBurnEvent(&autogen.WrappedPocketBurnAndBridge{})
+		success := x.HandleBurnEvent(context.Background(), &autogen.WrappedPocketBurnAndBridge{})
 
 		assert.True(t, success)
 	})
@@ -113,13 +126,90 @@ func TestBurnMonitorHandleBurnEvent(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestBurnMonitor(t, mockContract, mockClient)
 
-		mockDB.EXPECT().InsertOne(models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), mongo.CommandError{Code: 11000})
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments).Times(2)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), mongo.CommandError{Code: 11000})
 
-		success := x.HandleBurnEvent(&autogen.WrappedPocketBurnAndBridge{})
+		success := x.HandleBurnEvent(context.Background(), &autogen.WrappedPocketBurnAndBridge{})
 
 		assert.True(t, success)
 	})
 
+	t.Run("Orphaned Burn", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestBurnMonitor(t, mockContract, mockClient)
+
+		event := &autogen.WrappedPocketBurnAndBridge{
+			Raw: types.Log{BlockNumber: 12, BlockHash: common.HexToHash("0x12"), Index: 3},
+		}
+
+		filter := bson.M{
+			"transaction_hash": strings.ToLower(event.Raw.TxHash.String()),
+			"log_index":        "3",
+			"status":           models.StatusOrphaned,
+		}
+
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, filter, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				assert.Equal(t, models.StatusPending, set["status"])
+				assert.Equal(t, "12", set["block_number"])
+				assert.Equal(t, strings.ToLower(common.HexToHash("0x12").Hex()), set["block_hash"])
+			}).Once()
+
+		success := x.HandleBurnEvent(context.Background(), event)
+
+		assert.True(t, success)
+	})
+
+	t.Run("Orphaned Burn at another Log Index", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestBurnMonitor(t, mockContract, mockClient)
+
+		event := &autogen.WrappedPocketBurnAndBridge{
+			Raw:    types.Log{BlockNumber: 12, Index: 5},
+			Amount: big.NewInt(20000),
+		}
+		doc := util.CreateBurn(event)
+
+		moved := bson.M{
+			"transaction_hash":  doc.TransactionHash,
+			"sender_address":    doc.SenderAddress,
+			"recipient_address": doc.RecipientAddress,
+			"amount":            "20000",
+			"status":            models.StatusOrphaned,
+		}
+
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, moved, mock.Anything).Return(primitive.NewObjectID(), nil).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				assert.Equal(t, "5", update.(bson.M)["$set"].(bson.M)["log_index"])
+			}).Once()
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments).Once()
+
+		success := x.HandleBurnEvent(context.Background(), event)
+
+		assert.True(t, success)
+	})
+
+	t.Run("With Update Error", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestBurnMonitor(t, mockContract, mockClient)
+
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NilObjectID, errors.New("error")).Once()
+
+		success := x.HandleBurnEvent(context.Background(), &autogen.WrappedPocketBurnAndBridge{})
+
+		assert.False(t, success)
+	})
+
 	t.Run("With Other Error", func(t *testing.T) {
 		mockContract := ethMocks.NewMockWrappedPocketContract(t)
 		mockClient := ethMocks.NewMockEthereumClient(t)
@@ -127,9 +217,10 @@ func TestBurnMonitorHandleBurnEvent(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestBurnMonitor(t, mockContract, mockClient)
 
-		mockDB.EXPECT().InsertOne(models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments).Times(2)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), errors.New("error"))
 
-		success := x.HandleBurnEvent(&autogen.WrappedPocketBurnAndBridge{})
+		success := x.HandleBurnEvent(context.Background(), &autogen.WrappedPocketBurnAndBridge{})
 
 		assert.False(t, success)
 	})
@@ -149,7 +240,9 @@ func TestBurnMonitorInitStartBlockNumber(t *testing.T) {
 			EthBlockNumber: "10",
 		}
 
-		x.InitStartBlockNumber(lastHealth)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+
+		x.InitStartBlockNumber(context.Background(), lastHealth)
 
 		assert.Equal(t, x.startBlockNumber, int64(10))
 	})
@@ -165,11 +258,35 @@ func TestBurnMonitorInitStartBlockNumber(t *testing.T) {
 			EthBlockNumber: "invalid",
 		}
 
-		x.InitStartBlockNumber(lastHealth)
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+
+		x.InitStartBlockNumber(context.Background(), lastHealth)
 
 		assert.Equal(t, x.startBlockNumber, int64(100))
 	})
 
+	t.Run("Checkpoint is found", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestBurnMonitor(t, mockContract, mockClient)
+
+		lastHealth := models.ServiceHealth{
+			EthBlockNumber: "10",
+		}
+
+		filter := bson.M{"validator_id": "validatorId", "service": BurnMonitorName, "address": x.wpoktAddress}
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, filter, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				result.(*models.Checkpoint).Height = 50
+			}).Once()
+
+		x.InitStartBlockNumber(context.Background(), lastHealth)
+
+		assert.Equal(t, x.startBlockNumber, int64(50))
+	})
+
 }
 
 func TestBurnMonitorSyncBlocks(t *testing.T) {
@@ -187,6 +304,7 @@ func TestBurnMonitorSyncBlocks(t *testing.T) {
 		mockFilter.EXPECT().Next().Return(true).Once()
 		mockFilter.EXPECT().Next().Return(false).Once()
 		app.DB = mockDB
+		expectTransactions(mockDB)
 
 		x := NewTestBurnMonitor(t, mockContract, mockClient)
 		mockContract.EXPECT().FilterBurnAndBridge(mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
@@ -195,9 +313,11 @@ func TestBurnMonitorSyncBlocks(t *testing.T) {
 				assert.Equal(t, opts.Start, uint64(1))
 				assert.Equal(t, *opts.End, uint64(100))
 			}).Once()
-		mockDB.EXPECT().InsertOne(models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
 
-		success := x.SyncBlocks(1, 100)
+		success := x.SyncBlocks(context.Background(), 1, 100)
 		assert.True(t, success)
 	})
 
@@ -223,7 +343,7 @@ func TestBurnMonitorSyncBlocks(t *testing.T) {
 				assert.Equal(t, *opts.End, uint64(100))
 			}).Once()
 
-		success := x.SyncBlocks(1, 100)
+		success := x.SyncBlocks(context.Background(), 1, 100)
 		assert.True(t, success)
 	})
 
@@ -236,7 +356,7 @@ func TestBurnMonitorSyncBlocks(t *testing.T) {
 		mockContract.EXPECT().FilterBurnAndBridge(mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
 			Return(nil, errors.New("some error")).Once()
 
-		success := x.SyncBlocks(1, 100)
+		success := x.SyncBlocks(context.Background(), 1, 100)
 		assert.False(t, success)
 	})
 
@@ -249,14 +369,13 @@ func TestBurnMonitorSyncBlocks(t *testing.T) {
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
 		mockFilter.EXPECT().Next().Return(true).Once()
-		mockFilter.EXPECT().Next().Return(false).Once()
 		app.DB = mockDB
 
 		x := NewTestBurnMonitor(t, mockContract, mockClient)
 		mockContract.EXPECT().FilterBurnAndBridge(mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
 			Return(mockFilter, nil).Once()
 
-		assert.False(t, x.SyncBlocks(1, 100))
+		assert.False(t, x.SyncBlocks(context.Background(), 1, 100))
 	})
 
 	t.Run("Some events were removed", func(t *testing.T) {
@@ -278,14 +397,17 @@ func TestBurnMonitorSyncBlocks(t *testing.T) {
 		mockFilter.EXPECT().Next().Return(true).Times(3)
 		mockFilter.EXPECT().Next().Return(false).Once()
 		app.DB = mockDB
+		expectTransactions(mockDB)
 
 		x := NewTestBurnMonitor(t, mockContract, mockClient)
 		mockContract.EXPECT().FilterBurnAndBridge(mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
 			Return(mockFilter, nil).Once()
 
-		mockDB.EXPECT().InsertOne(models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
 
-		assert.True(t, x.SyncBlocks(1, 100))
+		assert.True(t, x.SyncBlocks(context.Background(), 1, 100))
 	})
 
 	t.Run("Error in Handling First Event", func(t *testing.T) {
@@ -294,21 +416,16 @@ func TestBurnMonitorSyncBlocks(t *testing.T) {
 		mockDB := appMocks.NewMockDatabase(t)
 		mockFilter := ethMocks.NewMockWrappedPocketBurnAndBridgeIterator(t)
 		mockFilter.EXPECT().Event().Return(nil).Once()
-		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketBurnAndBridge{
-			Amount: big.NewInt(20000),
-		}).Once()
 		mockFilter.EXPECT().Error().Return(nil)
 		mockFilter.EXPECT().Close().Return(nil)
-		mockFilter.EXPECT().Next().Return(true).Times(2)
-		mockFilter.EXPECT().Next().Return(false).Once()
-		mockDB.EXPECT().InsertOne(models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockFilter.EXPECT().Next().Return(true).Once()
 		app.DB = mockDB
 
 		x := NewTestBurnMonitor(t, mockContract, mockClient)
 		mockContract.EXPECT().FilterBurnAndBridge(mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
 			Return(mockFilter, nil).Once()
 
-		assert.False(t, x.SyncBlocks(1, 100))
+		assert.False(t, x.SyncBlocks(context.Background(), 1, 100))
 	})
 
 	t.Run("Error During Filtering Iteration", func(t *testing.T) {
@@ -325,7 +442,7 @@ func TestBurnMonitorSyncBlocks(t *testing.T) {
 		mockContract.EXPECT().FilterBurnAndBridge(mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
 			Return(mockFilter, nil).Once()
 
-		assert.False(t, x.SyncBlocks(1, 100))
+		assert.False(t, x.SyncBlocks(context.Background(), 1, 100))
 	})
 
 	t.Run("Error After Filtering Iteration", func(t *testing.T) {
@@ -333,7 +450,9 @@ func TestBurnMonitorSyncBlocks(t *testing.T) {
 		mockClient := ethMocks.NewMockEthereumClient(t)
 		mockDB := appMocks.NewMockDatabase(t)
 		mockFilter := ethMocks.NewMockWrappedPocketBurnAndBridgeIterator(t)
-		mockFilter.EXPECT().Event().Return(nil)
+		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketBurnAndBridge{
+			Amount: big.NewInt(20),
+		})
 		mockFilter.EXPECT().Error().Return(nil).Once()
 		mockFilter.EXPECT().Error().Return(errors.New("iteration error")).Once()
 		mockFilter.EXPECT().Close().Return(nil)
@@ -345,7 +464,7 @@ func TestBurnMonitorSyncBlocks(t *testing.T) {
 		mockContract.EXPECT().FilterBurnAndBridge(mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
 			Return(mockFilter, nil).Once()
 
-		assert.False(t, x.SyncBlocks(1, 100))
+		assert.False(t, x.SyncBlocks(context.Background(), 1, 100))
 	})
 }
 
@@ -360,7 +479,7 @@ func TestBurnMonitorSyncTxs(t *testing.T) {
 		x.currentBlockNumber = 100
 		x.startBlockNumber = 100
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
@@ -374,7 +493,7 @@ func TestBurnMonitorSyncTxs(t *testing.T) {
 		x.currentBlockNumber = 100
 		x.startBlockNumber = 101
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
@@ -392,6 +511,7 @@ func TestBurnMonitorSyncTxs(t *testing.T) {
 		mockFilter.EXPECT().Next().Return(true).Once()
 		mockFilter.EXPECT().Next().Return(false).Once()
 		app.DB = mockDB
+		expectTransactions(mockDB)
 
 		x := NewTestBurnMonitor(t, mockContract, mockClient)
 		x.startBlockNumber = 1
@@ -404,9 +524,11 @@ func TestBurnMonitorSyncTxs(t *testing.T) {
 				assert.Equal(t, opts.Start, uint64(1))
 				assert.Equal(t, *opts.End, uint64(final))
 			}).Once()
-		mockDB.EXPECT().InsertOne(models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 
@@ -429,6 +551,7 @@ func TestBurnMonitorSyncTxs(t *testing.T) {
 		mockFilter.EXPECT().Next().Return(true).Once()
 		mockFilter.EXPECT().Next().Return(false).Once()
 		app.DB = mockDB
+		expectTransactions(mockDB)
 
 		x := NewTestBurnMonitor(t, mockContract, mockClient)
 		x.startBlockNumber = 1
@@ -436,19 +559,68 @@ func TestBurnMonitorSyncTxs(t *testing.T) {
 
 		mockContract.EXPECT().FilterBurnAndBridge(mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
 			Return(mockFilter, nil).Times(2)
-		mockDB.EXPECT().InsertOne(models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil)
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil)
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(4)
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 
 		assert.Equal(t, x.currentBlockNumber, x.startBlockNumber)
+		assert.Equal(t, "", x.failedRange)
+	})
+
+	t.Run("Error in second chunk keeps progress of the first chunk", func(t *testing.T) {
+		mockContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockClient := ethMocks.NewMockEthereumClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		mockFilter := ethMocks.NewMockWrappedPocketBurnAndBridgeIterator(t)
+		mockFilter.EXPECT().Event().Return(&autogen.WrappedPocketBurnAndBridge{
+			Amount: big.NewInt(20000),
+		})
+		mockFilter.EXPECT().Error().Return(nil)
+		mockFilter.EXPECT().Close().Return(nil)
+		mockFilter.EXPECT().Next().Return(true).Once()
+		mockFilter.EXPECT().Next().Return(false).Once()
+		app.DB = mockDB
+		expectTransactions(mockDB)
+
+		x := NewTestBurnMonitor(t, mockContract, mockClient)
+		x.startBlockNumber = 1
+		x.currentBlockNumber = eth.MAX_QUERY_BLOCKS + 2
+
+		mockContract.EXPECT().FilterBurnAndBridge(mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
+			Return(mockFilter, nil).Once()
+		mockContract.EXPECT().FilterBurnAndBridge(mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
+			Return(nil, errors.New("error")).Once()
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments)
+		mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
+
+		success := x.SyncTxs(context.Background())
+
+		assert.False(t, success)
+
+		assert.Equal(t, int64(eth.MAX_QUERY_BLOCKS+1), x.startBlockNumber)
+		assert.Equal(t, fmt.Sprintf("%d-%d", eth.MAX_QUERY_BLOCKS+1, eth.MAX_QUERY_BLOCKS+2), x.failedRange)
+		assert.Equal(t, x.failedRange, x.Status().FailedRange)
 	})
 
 }
 
 func TestNewBurnMonitor(t *testing.T) {
 
+	app.Config.Pocket.Mnemonic = "test test test test test test test test test test test junk"
+	app.Config.Pocket.MultisigPublicKeys = []string{
+		"0223aa679d6d5344e201e0df9f02ab15a84726eee0dfb4e953c46a9e2cb52349dc",
+		"02faaaf0f385bb17381f36dcd86ab2486e8ff8d93440436496665ac007953076c2",
+		"02cae233806460db75a941a269490ca5165a620b43241edb8bc72e169f4143a6df",
+	}
+	app.Config.Pocket.MultisigAddress = "pokt10r5n6x28p9qntchsmhxd4ftq9lk6vzcx3dv4gx"
+	app.Config.Pocket.MultisigThreshold = 2
+	app.Config.Pocket.Bech32Prefix = "pokt"
+
 	t.Run("Disabled", func(t *testing.T) {
 
 		app.Config.BurnMonitor.Enabled = false
@@ -477,6 +649,9 @@ func TestNewBurnMonitor(t *testing.T) {
 	})
 
 	t.Run("Interval is 0", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments)
 
 		app.Config.BurnMonitor.Enabled = true
 		app.Config.Ethereum.RPCURL = "https://eth.llamarpc.com"
@@ -487,6 +662,9 @@ func TestNewBurnMonitor(t *testing.T) {
 	})
 
 	t.Run("Valid", func(t *testing.T) {
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments)
 
 		app.Config.BurnMonitor.Enabled = true
 		app.Config.BurnMonitor.IntervalMillis = 1
@@ -518,19 +696,31 @@ func TestBurnMonitorRun(t *testing.T) {
 	mockFilter.EXPECT().Next().Return(false).Once()
 
 	app.DB = mockDB
+	expectTransactions(mockDB)
 	x := NewTestBurnMonitor(t, mockContract, mockClient)
 	x.currentBlockNumber = 100
 	x.startBlockNumber = 1
 
-	mockClient.EXPECT().GetBlockNumber().Return(uint64(100), nil)
+	mockClient.EXPECT().GetBlockNumber(mock.Anything).Return(uint64(100), nil)
 	mockContract.EXPECT().FilterBurnAndBridge(mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
 		Return(mockFilter, nil).
 		Run(func(opts *bind.FilterOpts, amount []*big.Int, to []common.Address, from []common.Address) {
 			assert.Equal(t, opts.Start, uint64(1))
 			assert.Equal(t, *opts.End, uint64(100))
 		}).Once()
-	mockDB.EXPECT().InsertOne(models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
-
-	x.Run()
+	mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments)
+	mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionBurns, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+	mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.NewObjectID(), nil).Times(2)
+	mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+	mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+	mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+	mockDB.EXPECT().FindOne(mock.Anything, models.CollectionCheckpoints, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+			result.(*models.Checkpoint).Height = 1
+		}).Return(nil)
+
+	err := x.Run(context.Background())
+
+	assert.Nil(t, err)
 
 }
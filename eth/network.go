@@ -1,9 +1,15 @@
 package eth
 
 import (
+	log "github.com/sirupsen/logrus"
+
 	eth "github.com/dan13ram/wpokt-validator/eth/client"
 )
 
 func ValidateNetwork() {
-	eth.Client.ValidateNetwork()
+	client, err := eth.NewClient()
+	if err != nil {
+		log.Fatalln("[ETH]", "Failed to connect to Ethereum node:", err)
+	}
+	client.ValidateNetwork()
 }
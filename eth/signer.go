@@ -2,7 +2,6 @@ package eth
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"sort"
@@ -13,6 +12,7 @@ import (
 
 	"cosmossdk.io/math"
 	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/common"
 	cosmos "github.com/dan13ram/wpokt-validator/cosmos/client"
 	cosmosUtil "github.com/dan13ram/wpokt-validator/cosmos/util"
 	"github.com/dan13ram/wpokt-validator/eth/autogen"
@@ -20,8 +20,7 @@ import (
 	"github.com/dan13ram/wpokt-validator/eth/util"
 	"github.com/dan13ram/wpokt-validator/models"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	log "github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
 	"math/big"
@@ -33,7 +32,7 @@ const (
 
 type MintSignerRunner struct {
 	address                string
-	privateKey             *ecdsa.PrivateKey
+	signer                 common.Signer
 	vaultAddress           string
 	wpoktAddress           string
 	wpoktContract          eth.WrappedPocketContract
@@ -46,32 +45,43 @@ type MintSignerRunner struct {
 	cosmosHeight           int64
 	minimumAmount          math.Int
 	maximumAmount          math.Int
+	validatorId            string
 }
 
-func (x *MintSignerRunner) Run() {
-	x.UpdateBlocks()
-	x.UpdateValidatorCount()
-	x.UpdateMaxMintLimit()
-	x.SyncTxs()
+func (x *MintSignerRunner) Run(ctx context.Context) error {
+	err := errors.Join(
+		x.UpdateBlocks(ctx),
+		x.UpdateValidatorCount(ctx),
+		x.UpdateMaxMintLimit(ctx),
+	)
+	if app.Config.Gossip.Enabled {
+		err = errors.Join(err, x.SyncPeerSignatures(ctx))
+	}
+	if !x.SyncTxs(ctx) {
+		err = errors.Join(err, app.ErrSyncTxs)
+	}
+	return err
 }
 
 func (x *MintSignerRunner) Status() models.RunnerStatus {
 	return models.RunnerStatus{
 		PoktHeight: strconv.FormatInt(x.cosmosHeight, 10),
+		Endpoints:  app.EndpointHealth(x.ethClient, x.cosmosClient),
 	}
 }
 
-func (x *MintSignerRunner) UpdateBlocks() {
+func (x *MintSignerRunner) UpdateBlocks(ctx context.Context) error {
 	log.Debug("[MINT SIGNER] Updating blocks")
-	poktHeight, err := x.cosmosClient.GetLatestBlockHeight()
+	poktHeight, err := x.cosmosClient.GetLatestBlockHeight(ctx)
 	if err != nil {
 		log.Error("[MINT SIGNER] Error fetching pokt block height: ", err)
-		return
+		return fmt.Errorf("error fetching pokt block height: %w", err)
 	}
 	x.cosmosHeight = poktHeight
+	return nil
 }
 
-func (x *MintSignerRunner) FindNonce(mint *models.Mint) (*big.Int, error) {
+func (x *MintSignerRunner) FindNonce(ctx context.Context, mint *models.Mint) (*big.Int, error) {
 	log.Debug("[MINT SIGNER] Finding nonce for mint: ", mint.TransactionHash)
 	var nonce *big.Int
 
@@ -86,10 +96,10 @@ func (x *MintSignerRunner) FindNonce(mint *models.Mint) (*big.Int, error) {
 
 	if nonce == nil || nonce.Cmp(big.NewInt(0)) == 0 {
 		log.Debug("[MINT SIGNER] Mint nonce not set, fetching from contract")
-		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+		callCtx, cancel := context.WithTimeout(ctx, time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
 		defer cancel()
-		opts := &bind.CallOpts{Context: ctx, Pending: false}
-		currentNonce, err := x.wpoktContract.GetUserNonce(opts, common.HexToAddress(mint.RecipientAddress))
+		opts := &bind.CallOpts{Context: callCtx, Pending: false}
+		currentNonce, err := x.wpoktContract.GetUserNonce(opts, ethcommon.HexToAddress(mint.RecipientAddress))
 		if err != nil {
 			log.Error("[MINT SIGNER] Error fetching nonce from contract: ", err)
 			return nil, err
@@ -104,7 +114,7 @@ func (x *MintSignerRunner) FindNonce(mint *models.Mint) (*big.Int, error) {
 			"recipient_address": strings.ToLower(mint.RecipientAddress),
 			"status":            bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusSigned}},
 		}
-		err = app.DB.FindMany(models.CollectionMints, filter, &pendingMints)
+		err = app.DB.FindMany(ctx, models.CollectionMints, filter, &pendingMints)
 		if err != nil {
 			log.Error("[MINT SIGNER] Error fetching pending mints: ", err)
 			return nil, err
@@ -144,10 +154,10 @@ func (x *MintSignerRunner) FindNonce(mint *models.Mint) (*big.Int, error) {
 
 var cosmosUtilValidateTxToCosmosMultisig = cosmosUtil.ValidateTxToCosmosMultisig
 
-func (x *MintSignerRunner) ValidateMint(mint *models.Mint) (bool, error) {
+func (x *MintSignerRunner) ValidateMint(ctx context.Context, mint *models.Mint) (bool, error) {
 	log.Debug("[MINT SIGNER] Validating mint: ", mint.TransactionHash)
 
-	tx, err := x.cosmosClient.GetTx(mint.TransactionHash)
+	tx, err := x.cosmosClient.GetTx(ctx, mint.TransactionHash)
 	if err != nil {
 		return false, errors.New("Error fetching transaction: " + err.Error())
 	}
@@ -202,31 +212,27 @@ func (x *MintSignerRunner) ValidateMint(mint *models.Mint) (bool, error) {
 	return true, nil
 }
 
-func (x *MintSignerRunner) HandleMint(mint *models.Mint) bool {
+func (x *MintSignerRunner) HandleMint(ctx context.Context, mint *models.Mint) error {
 	if mint == nil {
-		log.Error("[MINT EXECUTOR] Invalid mint")
-		return false
+		return errors.New("mint is nil")
 	}
 
 	log.Debug("[MINT SIGNER] Handling mint: ", mint.TransactionHash)
 
-	address := common.HexToAddress(mint.RecipientAddress)
+	address := ethcommon.HexToAddress(mint.RecipientAddress)
 	amount, ok := new(big.Int).SetString(mint.Amount, 10)
 	if !ok {
-		log.Error("[MINT SIGNER] Error converting decimal to big int")
-		return false
+		return errors.New("error converting mint amount to big int")
 	}
 
-	nonce, err := x.FindNonce(mint)
+	nonce, err := x.FindNonce(ctx, mint)
 
 	if err != nil {
-		log.Error("[MINT SIGNER] Error fetching nonce: ", err)
-		return false
+		return fmt.Errorf("error fetching nonce: %w", err)
 	}
 
 	if nonce == nil || nonce.Cmp(big.NewInt(0)) == 0 {
-		log.Error("[MINT SIGNER] Error fetching nonce")
-		return false
+		return errors.New("error fetching nonce: nonce is zero")
 	}
 	log.Debug("[MINT SIGNER] Found Nonce: ", nonce)
 
@@ -238,16 +244,14 @@ func (x *MintSignerRunner) HandleMint(mint *models.Mint) bool {
 
 	mint, err = util.UpdateStatusAndConfirmationsForMint(mint, x.cosmosHeight)
 	if err != nil {
-		log.Error("[MINT SIGNER] Error updating status and confirmations for mint: ", err)
-		return false
+		return fmt.Errorf("error updating status and confirmations for mint: %w", err)
 	}
 
 	var update bson.M
 
-	valid, err := x.ValidateMint(mint)
+	valid, err := x.ValidateMint(ctx, mint)
 	if err != nil {
-		log.Error("[MINT SIGNER] Error validating mint: ", err)
-		return false
+		return fmt.Errorf("error validating mint: %w", err)
 	}
 
 	if !valid {
@@ -263,10 +267,32 @@ func (x *MintSignerRunner) HandleMint(mint *models.Mint) bool {
 		if mint.Status == models.StatusConfirmed {
 			log.Debug("[MINT SIGNER] Mint confirmed, signing")
 
-			mint, err := util.SignMint(mint, data, x.domain, x.privateKey, int(x.signerThreshold))
+			if app.Config.Verification.Enabled {
+				verified, err := x.VerifyMint(ctx, mint, data)
+				if err != nil {
+					return fmt.Errorf("error verifying mint: %w", err)
+				}
+				if !verified {
+					return errors.New("mint not signed due to failed verification")
+				}
+			}
+
+			signingRequest := app.SigningRequest{
+				Kind:      app.SigningKindMint,
+				Id:        mint.TransactionHash,
+				Sender:    mint.SenderAddress,
+				Recipient: strings.ToLower(data.Recipient.Hex()),
+				Amount:    math.NewIntFromBigInt(data.Amount),
+			}
+			reservation, signCtx, err := app.ReserveSigning(ctx, signingRequest)
+			if err != nil {
+				return fmt.Errorf("mint not signed due to signing policy: %w", err)
+			}
+
+			mint, err := util.SignMint(signCtx, mint, data, x.domain, x.signer, int(x.signerThreshold))
 			if err != nil {
-				log.Error("[MINT SIGNER] Error signing mint: ", err)
-				return false
+				reservation.Release()
+				return fmt.Errorf("error signing mint: %w", err)
 			}
 
 			update = bson.M{
@@ -303,17 +329,21 @@ func (x *MintSignerRunner) HandleMint(mint *models.Mint) bool {
 		"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
 	}
 
-	_, err = app.DB.UpdateOne(models.CollectionMints, filter, update)
+	filter, update, err = app.Fence(ctx, filter, update, mint.FencingToken)
 	if err != nil {
-		log.Error("[MINT SIGNER] Error updating mint: ", err)
-		return false
+		return fmt.Errorf("lock lost before updating mint: %w", err)
+	}
+
+	_, err = app.DB.UpdateOne(ctx, models.CollectionMints, filter, update)
+	if err != nil {
+		return fmt.Errorf("error updating mint: %w", err)
 	}
 	log.Info("[MINT SIGNER] Handled mint: ", mint.TransactionHash)
 
-	return true
+	return nil
 }
 
-func (x *MintSignerRunner) SyncTxs() bool {
+func (x *MintSignerRunner) SyncTxs(ctx context.Context) bool {
 	log.Debug("[MINT SIGNER] Syncing pending txs")
 
 	filter := bson.M{
@@ -327,7 +357,7 @@ func (x *MintSignerRunner) SyncTxs() bool {
 
 	var mints []models.Mint
 
-	err := app.DB.FindMany(models.CollectionMints, filter, &mints)
+	err := app.DB.FindMany(ctx, models.CollectionMints, filter, &mints)
 	if err != nil {
 		log.Error("[MINT SIGNER] Error fetching pending mints: ", err)
 		return false
@@ -337,8 +367,14 @@ func (x *MintSignerRunner) SyncTxs() bool {
 	for i := range mints {
 		mint := mints[i]
 
+		retry := mint.Retries[x.address]
+		if !app.RetryDue(retry) {
+			log.Debug("[MINT SIGNER] Skipping mint until its next retry: ", mint.TransactionHash)
+			continue
+		}
+
 		resourceId := fmt.Sprintf("%s/%s", models.CollectionMints, strings.ToLower(mint.RecipientAddress))
-		lockId, err := app.DB.XLock(resourceId)
+		lock, lockCtx, err := app.AcquireLock(ctx, resourceId)
 		if err != nil {
 			log.Error("[MINT SIGNER] Error locking mint: ", err)
 			success = false
@@ -346,9 +382,17 @@ func (x *MintSignerRunner) SyncTxs() bool {
 		}
 		log.Debug("[MINT SIGNER] Locked mint: ", mint.TransactionHash)
 
-		success = x.HandleMint(&mint) && success
+		err = x.HandleMint(lockCtx, &mint)
+		if err != nil {
+			log.Error("[MINT SIGNER] Error handling mint: ", err)
+			success = false
+		}
+		if err = app.RecordAttempt(lockCtx, models.CollectionMints, mint.Id, x.address, retry, app.GiveUpQuorum(x.validatorCount, x.signerThreshold), err); err != nil {
+			log.Error("[MINT SIGNER] Error recording attempt at mint: ", err)
+			success = false
+		}
 
-		if err = app.DB.Unlock(lockId); err != nil {
+		if err = lock.Release(ctx); err != nil {
 			log.Error("[MINT SIGNER] Error unlocking mint: ", err)
 			success = false
 		} else {
@@ -361,64 +405,68 @@ func (x *MintSignerRunner) SyncTxs() bool {
 	return success
 }
 
-func (x *MintSignerRunner) UpdateValidatorCount() {
+func (x *MintSignerRunner) UpdateValidatorCount(ctx context.Context) error {
 	log.Debug("[MINT SIGNER] Fetching mint controller validator count")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
 	defer cancel()
 	opts := &bind.CallOpts{Context: ctx, Pending: false}
 	count, err := x.mintControllerContract.ValidatorCount(opts)
 
 	if err != nil {
 		log.Error("[MINT SIGNER] Error fetching mint controller validator count: ", err)
-		return
+		return fmt.Errorf("error fetching mint controller validator count: %w", err)
 	}
 	log.Debug("[MINT SIGNER] Fetched mint controller validator count")
 	x.validatorCount = count.Int64()
+	return nil
 }
 
-func (x *MintSignerRunner) UpdateSignerThreshold() {
+func (x *MintSignerRunner) UpdateSignerThreshold(ctx context.Context) error {
 	log.Debug("[MINT SIGNER] Fetching mint controller signer threshold")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
 	defer cancel()
 	opts := &bind.CallOpts{Context: ctx, Pending: false}
 	count, err := x.mintControllerContract.SignerThreshold(opts)
 
 	if err != nil {
 		log.Error("[MINT SIGNER] Error fetching mint controller signer threshold: ", err)
-		return
+		return fmt.Errorf("error fetching mint controller signer threshold: %w", err)
 	}
 	log.Debug("[MINT SIGNER] Fetched mint controller signer threshold")
 	x.signerThreshold = count.Int64()
+	return nil
 }
 
-func (x *MintSignerRunner) UpdateDomainData() {
+func (x *MintSignerRunner) UpdateDomainData(ctx context.Context) error {
 	log.Debug("[MINT SIGNER] Fetching mint controller domain data")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
 	defer cancel()
 	opts := &bind.CallOpts{Context: ctx, Pending: false}
 	domain, err := x.mintControllerContract.Eip712Domain(opts)
 
 	if err != nil {
 		log.Error("[MINT SIGNER] Error fetching mint controller domain data: ", err)
-		return
+		return fmt.Errorf("error fetching mint controller domain data: %w", err)
 	}
 	log.Debug("[MINT SIGNER] Fetched mint controller domain data")
 	x.domain = domain
+	return nil
 }
 
-func (x *MintSignerRunner) UpdateMaxMintLimit() {
+func (x *MintSignerRunner) UpdateMaxMintLimit(ctx context.Context) error {
 	log.Debug("[MINT SIGNER] Fetching mint controller max mint limit")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
 	defer cancel()
 	opts := &bind.CallOpts{Context: ctx, Pending: false}
 	mintLimit, err := x.mintControllerContract.MaxMintLimit(opts)
 
 	if err != nil {
 		log.Error("[MINT SIGNER] Error fetching mint controller max mint limit: ", err)
-		return
+		return fmt.Errorf("error fetching mint controller max mint limit: %w", err)
 	}
 	log.Debug("[MINT SIGNER] Fetched mint controller max mint limit")
 	x.maximumAmount = math.NewIntFromBigInt(mintLimit)
+	return nil
 }
 
 var cosmosNewClient = cosmos.NewClient
@@ -431,27 +479,32 @@ func NewMintSigner(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Serv
 
 	log.Debug("[MINT SIGNER] Initializing mint signer")
 
-	privateKey, err := crypto.HexToECDSA(app.Config.Ethereum.PrivateKey)
+	signer, err := app.GetEthereumSigner()
 	if err != nil {
-		log.Fatal("[MINT SIGNER] Error loading private key: ", err)
+		log.Fatal("[MINT SIGNER] Error loading ethereum signer: ", err)
 	}
-	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+	address := signer.Address
 	log.Info("[MINT SIGNER] ETH signer address: ", address)
 
+	pocketSigner, err := app.GetPocketSignerAndMultisig()
+	if err != nil {
+		log.Fatal("[MINT SIGNER] Error loading pocket signer: ", err)
+	}
+
 	ethClient, err := eth.NewClient()
 	if err != nil {
 		log.Fatal("[MINT SIGNER] Error initializing ethereum client: ", err)
 	}
 
 	log.Debug("[MINT SIGNER] Connecting to wpokt contract at: ", app.Config.Ethereum.WrappedPocketAddress)
-	contract, err := autogen.NewWrappedPocket(common.HexToAddress(app.Config.Ethereum.WrappedPocketAddress), ethClient.GetClient())
+	contract, err := autogen.NewWrappedPocket(ethcommon.HexToAddress(app.Config.Ethereum.WrappedPocketAddress), ethClient.GetClient())
 	if err != nil {
 		log.Fatal("[MINT SIGNER] Error initializing Wrapped Pocket contract", err)
 	}
 	log.Debug("[MINT SIGNER] Connected to wpokt contract")
 
 	log.Debug("[MINT SIGNER] Connecting to mint controller contract at: ", app.Config.Ethereum.MintControllerAddress)
-	mintControllerContract, err := autogen.NewMintController(common.HexToAddress(app.Config.Ethereum.MintControllerAddress), ethClient.GetClient())
+	mintControllerContract, err := autogen.NewMintController(ethcommon.HexToAddress(app.Config.Ethereum.MintControllerAddress), ethClient.GetClient())
 	if err != nil {
 		log.Fatal("[MINT SIGNER] Error initializing Mint Controller contract", err)
 	}
@@ -463,7 +516,7 @@ func NewMintSigner(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Serv
 	}
 
 	x := &MintSignerRunner{
-		privateKey:             privateKey,
+		signer:                 signer.Signer,
 		address:                strings.ToLower(address),
 		wpoktAddress:           strings.ToLower(app.Config.Ethereum.WrappedPocketAddress),
 		vaultAddress:           strings.ToLower(app.Config.Pocket.MultisigAddress),
@@ -472,17 +525,18 @@ func NewMintSigner(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Serv
 		ethClient:              ethClient,
 		cosmosClient:           cosmosClient,
 		minimumAmount:          math.NewIntFromUint64(uint64(app.Config.Pocket.TxFee)),
+		validatorId:            pocketSigner.ValidatorId(),
 	}
 
-	x.UpdateBlocks()
+	x.UpdateBlocks(context.Background())
 
 	if x.cosmosHeight == int64(0) {
 		log.Fatal("[MINT SIGNER] Invalid block height")
 	}
 
-	x.UpdateValidatorCount()
+	x.UpdateValidatorCount(context.Background())
 
-	x.UpdateSignerThreshold()
+	x.UpdateSignerThreshold(context.Background())
 
 	if x.validatorCount != int64(len(app.Config.Ethereum.ValidatorAddresses)) {
 		log.Fatal("[MINT SIGNER] Invalid validator count")
@@ -492,7 +546,7 @@ func NewMintSigner(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Serv
 		log.Fatal("[MINT SIGNER] Invalid signer threshold")
 	}
 
-	x.UpdateDomainData()
+	x.UpdateDomainData(context.Background())
 
 	chainId, ok := new(big.Int).SetString(app.Config.Ethereum.ChainID, 10)
 
@@ -504,7 +558,7 @@ func NewMintSigner(wg *sync.WaitGroup, lastHealth models.ServiceHealth) app.Serv
 		log.Fatal("[MINT SIGNER] Invalid mint controller address in domain data")
 	}
 
-	x.UpdateMaxMintLimit()
+	x.UpdateMaxMintLimit(context.Background())
 
 	if x.maximumAmount.LT(x.minimumAmount) {
 		log.Fatal("[MINT MONITOR] Invalid max mint limit")
@@ -1,6 +1,7 @@
 package eth
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -21,7 +22,6 @@ import (
 	ethMocks "github.com/dan13ram/wpokt-validator/eth/client/mocks"
 	"github.com/dan13ram/wpokt-validator/models"
 	ethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson"
@@ -39,15 +39,25 @@ func init() {
 	log.SetOutput(io.Discard)
 }
 
+// fenced is the filter of a fenced update of a document no lock holder has written yet
+func fenced(filter bson.M) bson.M {
+	return bson.M{
+		"$and": []bson.M{
+			filter,
+			{"fencing_token": nil},
+		},
+	}
+}
+
 func NewTestMintSigner(t *testing.T, mockWrappedPocketContract *ethMocks.MockWrappedPocketContract,
 	mockMintControllerContract *ethMocks.MockMintControllerContract,
 	mockEthClient *ethMocks.MockEthereumClient, mockPoktClient *cosmosMocks.MockCosmosClient) *MintSignerRunner {
-	pk, _ := crypto.HexToECDSA("1395eeb9c36ef43e9e05692c9ee34034c00a9bef301135a96d082b2a65fd1680")
-	address := crypto.PubkeyToAddress(pk.PublicKey).Hex()
+	signer, _ := common.NewPrivateKeySigner("1395eeb9c36ef43e9e05692c9ee34034c00a9bef301135a96d082b2a65fd1680")
+	address := signer.EthAddress().Hex()
 
 	x := &MintSignerRunner{
 		address:         strings.ToLower(address),
-		privateKey:      pk,
+		signer:          signer,
 		vaultAddress:    "vaultAddress",
 		wpoktAddress:    "wpoktAddress",
 		validatorCount:  3,
@@ -90,9 +100,9 @@ func TestMintSignerUpdateBlocks(t *testing.T) {
 		mockPoktClient := cosmosMocks.NewMockCosmosClient(t)
 		x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
 
-		mockPoktClient.EXPECT().GetLatestBlockHeight().Return(int64(200), nil)
+		mockPoktClient.EXPECT().GetLatestBlockHeight(mock.Anything).Return(int64(200), nil)
 
-		x.UpdateBlocks()
+		x.UpdateBlocks(context.Background())
 
 		assert.Equal(t, x.cosmosHeight, int64(200))
 	})
@@ -104,9 +114,9 @@ func TestMintSignerUpdateBlocks(t *testing.T) {
 		mockPoktClient := cosmosMocks.NewMockCosmosClient(t)
 		x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
 
-		mockPoktClient.EXPECT().GetLatestBlockHeight().Return(0, errors.New("error"))
+		mockPoktClient.EXPECT().GetLatestBlockHeight(mock.Anything).Return(0, errors.New("error"))
 
-		x.UpdateBlocks()
+		x.UpdateBlocks(context.Background())
 
 		assert.Equal(t, x.cosmosHeight, int64(100))
 	})
@@ -124,7 +134,7 @@ func TestMintSignerUpdateValidatorCount(t *testing.T) {
 
 		mockMintControllerContract.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(5), nil)
 
-		x.UpdateValidatorCount()
+		x.UpdateValidatorCount(context.Background())
 
 		assert.Equal(t, x.validatorCount, int64(5))
 	})
@@ -138,7 +148,7 @@ func TestMintSignerUpdateValidatorCount(t *testing.T) {
 
 		mockMintControllerContract.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(5), errors.New("error"))
 
-		x.UpdateValidatorCount()
+		x.UpdateValidatorCount(context.Background())
 
 		assert.Equal(t, x.validatorCount, int64(3))
 	})
@@ -163,7 +173,7 @@ func TestMintSignerUpdateDomainData(t *testing.T) {
 
 		mockMintControllerContract.EXPECT().Eip712Domain(mock.Anything).Return(domain, nil)
 
-		x.UpdateDomainData()
+		x.UpdateDomainData(context.Background())
 
 		assert.Equal(t, x.domain.Name, "New Domain")
 	})
@@ -184,7 +194,7 @@ func TestMintSignerUpdateDomainData(t *testing.T) {
 
 		mockMintControllerContract.EXPECT().Eip712Domain(mock.Anything).Return(domain, errors.New("error"))
 
-		x.UpdateDomainData()
+		x.UpdateDomainData(context.Background())
 
 		assert.Equal(t, x.domain.Name, "Test")
 	})
@@ -202,7 +212,7 @@ func TestMintSignerUpdateMaxMintLimit(t *testing.T) {
 
 		mockMintControllerContract.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(500000), nil)
 
-		x.UpdateMaxMintLimit()
+		x.UpdateMaxMintLimit(context.Background())
 
 		assert.Equal(t, x.maximumAmount, math.NewInt(500000))
 	})
@@ -216,7 +226,7 @@ func TestMintSignerUpdateMaxMintLimit(t *testing.T) {
 
 		mockMintControllerContract.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(500000), errors.New("error"))
 
-		x.UpdateMaxMintLimit()
+		x.UpdateMaxMintLimit(context.Background())
 
 		assert.Equal(t, x.maximumAmount, math.NewInt(1000000))
 	})
@@ -239,7 +249,7 @@ func TestMintSignerFindNonce(t *testing.T) {
 			Nonce: "10",
 		}
 
-		gotNonce, err := x.FindNonce(mint)
+		gotNonce, err := x.FindNonce(context.Background(), mint)
 
 		assert.Equal(t, gotNonce, big.NewInt(10))
 		assert.Nil(t, err)
@@ -270,13 +280,13 @@ func TestMintSignerFindNonce(t *testing.T) {
 			"status":            bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusSigned}},
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionMints, filter, mock.Anything).
-			Run(func(collection string, _ interface{}, data interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, filter, mock.Anything).
+			Run(func(_ context.Context, collection string, _ interface{}, data interface{}) {
 				d := data.(*[]models.Mint)
 				*d = []models.Mint{}
 			}).Return(nil)
 
-		gotNonce, err := x.FindNonce(mint)
+		gotNonce, err := x.FindNonce(context.Background(), mint)
 
 		assert.Equal(t, gotNonce, big.NewInt(11))
 		assert.Nil(t, err)
@@ -307,8 +317,8 @@ func TestMintSignerFindNonce(t *testing.T) {
 			"status":            bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed, models.StatusSigned}},
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionMints, filter, mock.Anything).
-			Run(func(collection string, _ interface{}, data interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, filter, mock.Anything).
+			Run(func(_ context.Context, collection string, _ interface{}, data interface{}) {
 				d := data.(*[]models.Mint)
 				*d = []models.Mint{
 					{
@@ -334,7 +344,7 @@ func TestMintSignerFindNonce(t *testing.T) {
 				}
 			}).Return(nil)
 
-		gotNonce, err := x.FindNonce(mint)
+		gotNonce, err := x.FindNonce(context.Background(), mint)
 
 		assert.Equal(t, gotNonce, big.NewInt(7))
 		assert.Nil(t, err)
@@ -355,7 +365,7 @@ func TestMintSignerFindNonce(t *testing.T) {
 			Nonce: "invalid",
 		}
 
-		gotNonce, err := x.FindNonce(mint)
+		gotNonce, err := x.FindNonce(context.Background(), mint)
 
 		assert.NotNil(t, err)
 		assert.Nil(t, gotNonce)
@@ -376,7 +386,7 @@ func TestMintSignerFindNonce(t *testing.T) {
 
 		mockWrappedPocketContract.EXPECT().GetUserNonce(mock.Anything, common.HexToAddress("")).Return(big.NewInt(5), errors.New("error"))
 
-		gotNonce, err := x.FindNonce(mint)
+		gotNonce, err := x.FindNonce(context.Background(), mint)
 
 		assert.NotNil(t, err)
 		assert.Nil(t, gotNonce)
@@ -396,9 +406,9 @@ func TestMintSignerFindNonce(t *testing.T) {
 		mint := &models.Mint{}
 
 		mockWrappedPocketContract.EXPECT().GetUserNonce(mock.Anything, common.HexToAddress("")).Return(big.NewInt(5), nil)
-		mockDB.EXPECT().FindMany(models.CollectionMints, mock.Anything, mock.Anything).Return(errors.New("error"))
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(errors.New("error"))
 
-		gotNonce, err := x.FindNonce(mint)
+		gotNonce, err := x.FindNonce(context.Background(), mint)
 
 		assert.NotNil(t, err)
 		assert.Nil(t, gotNonce)
@@ -419,9 +429,9 @@ func TestValidateMint(t *testing.T) {
 
 		mint := &models.Mint{}
 
-		mockPoktClient.EXPECT().GetTx("").Return(nil, errors.New("error"))
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(nil, errors.New("error"))
 
-		valid, err := x.ValidateMint(mint)
+		valid, err := x.ValidateMint(context.Background(), mint)
 
 		assert.False(t, valid)
 		assert.NotNil(t, err)
@@ -438,9 +448,9 @@ func TestValidateMint(t *testing.T) {
 
 		mint := &models.Mint{}
 
-		mockPoktClient.EXPECT().GetTx("").Return(nil, nil)
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(nil, nil)
 
-		valid, err := x.ValidateMint(mint)
+		valid, err := x.ValidateMint(context.Background(), mint)
 
 		assert.False(t, valid)
 		assert.Error(t, err)
@@ -459,7 +469,7 @@ func TestValidateMint(t *testing.T) {
 
 		tx := &sdk.TxResponse{}
 
-		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		oldCosmosUtilValidateTxToCosmosMultisig := cosmosUtilValidateTxToCosmosMultisig
 		defer func() { cosmosUtilValidateTxToCosmosMultisig = oldCosmosUtilValidateTxToCosmosMultisig }()
@@ -474,7 +484,7 @@ func TestValidateMint(t *testing.T) {
 			}
 		}
 
-		valid, err := x.ValidateMint(mint)
+		valid, err := x.ValidateMint(context.Background(), mint)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -493,7 +503,7 @@ func TestValidateMint(t *testing.T) {
 
 		tx := &sdk.TxResponse{}
 
-		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		oldCosmosUtilValidateTxToCosmosMultisig := cosmosUtilValidateTxToCosmosMultisig
 		defer func() { cosmosUtilValidateTxToCosmosMultisig = oldCosmosUtilValidateTxToCosmosMultisig }()
@@ -510,7 +520,7 @@ func TestValidateMint(t *testing.T) {
 			}
 		}
 
-		valid, err := x.ValidateMint(mint)
+		valid, err := x.ValidateMint(context.Background(), mint)
 
 		assert.False(t, valid)
 		assert.Nil(t, err)
@@ -538,7 +548,7 @@ func TestValidateMint(t *testing.T) {
 
 		tx := &sdk.TxResponse{}
 
-		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		oldCosmosUtilValidateTxToCosmosMultisig := cosmosUtilValidateTxToCosmosMultisig
 		defer func() { cosmosUtilValidateTxToCosmosMultisig = oldCosmosUtilValidateTxToCosmosMultisig }()
@@ -560,7 +570,7 @@ func TestValidateMint(t *testing.T) {
 			}
 		}
 
-		valid, err := x.ValidateMint(mint)
+		valid, err := x.ValidateMint(context.Background(), mint)
 
 		assert.True(t, valid)
 		assert.Nil(t, err)
@@ -580,9 +590,9 @@ func TestMintSignerHandleMint(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
 
-		success := x.HandleMint(nil)
+		err := x.HandleMint(context.Background(), nil)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Invalid mint amount", func(t *testing.T) {
@@ -603,9 +613,9 @@ func TestMintSignerHandleMint(t *testing.T) {
 			RecipientChainID: "31337",
 		}
 
-		success := x.HandleMint(mint)
+		err := x.HandleMint(context.Background(), mint)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Error finding nonce", func(t *testing.T) {
@@ -627,9 +637,9 @@ func TestMintSignerHandleMint(t *testing.T) {
 			RecipientChainID: "31337",
 		}
 
-		success := x.HandleMint(mint)
+		err := x.HandleMint(context.Background(), mint)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Error updating confirmations", func(t *testing.T) {
@@ -654,9 +664,9 @@ func TestMintSignerHandleMint(t *testing.T) {
 			Height:           "invalid",
 		}
 
-		success := x.HandleMint(mint)
+		err := x.HandleMint(context.Background(), mint)
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Error validating mint", func(t *testing.T) {
@@ -681,14 +691,14 @@ func TestMintSignerHandleMint(t *testing.T) {
 			Height:           "99",
 		}
 
-		mockPoktClient.EXPECT().GetTx("").Return(nil, errors.New("error"))
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(nil, errors.New("error"))
 
-		success := x.HandleMint(mint)
+		err := x.HandleMint(context.Background(), mint)
 
 		assert.Equal(t, models.StatusConfirmed, mint.Status)
 		assert.Equal(t, mint.Confirmations, "0")
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Validating mint returned false", func(t *testing.T) {
@@ -717,7 +727,7 @@ func TestMintSignerHandleMint(t *testing.T) {
 
 		tx := &sdk.TxResponse{}
 
-		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		filter := bson.M{
 			"_id":    mint.Id,
@@ -730,8 +740,8 @@ func TestMintSignerHandleMint(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, filter, mock.Anything).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Return(primitive.NewObjectID(), nil)
@@ -750,12 +760,12 @@ func TestMintSignerHandleMint(t *testing.T) {
 			}
 		}
 
-		success := x.HandleMint(mint)
+		err := x.HandleMint(context.Background(), mint)
 
 		assert.Equal(t, models.StatusConfirmed, mint.Status)
 		assert.Equal(t, mint.Confirmations, "0")
 
-		assert.True(t, success)
+		assert.Nil(t, err)
 	})
 
 	t.Run("Validating mint returned true, mint pending", func(t *testing.T) {
@@ -804,7 +814,7 @@ func TestMintSignerHandleMint(t *testing.T) {
 			}
 		}
 
-		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
 		filter := bson.M{
 			"_id":    mint.Id,
@@ -818,18 +828,18 @@ func TestMintSignerHandleMint(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, filter, mock.Anything).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				assert.Equal(t, update, gotUpdate)
 			}).Return(primitive.NewObjectID(), nil)
 
-		success := x.HandleMint(mint)
+		err := x.HandleMint(context.Background(), mint)
 
 		assert.Equal(t, models.StatusPending, mint.Status)
 		assert.Equal(t, mint.Confirmations, "1")
 
-		assert.True(t, success)
+		assert.Nil(t, err)
 	})
 
 	t.Run("Validating mint returned true, mint confirmed, signing failed", func(t *testing.T) {
@@ -882,14 +892,72 @@ func TestMintSignerHandleMint(t *testing.T) {
 			}
 		}
 
-		mockPoktClient.EXPECT().GetTx("").Return(tx, nil)
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
 
-		success := x.HandleMint(mint)
+		err := x.HandleMint(context.Background(), mint)
 
 		assert.Equal(t, models.StatusConfirmed, mint.Status)
 		assert.Equal(t, mint.Confirmations, "0")
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Validating mint returned true, mint confirmed, rejected by signing policy", func(t *testing.T) {
+		mockWrappedPocketContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockMintControllerContract := ethMocks.NewMockMintControllerContract(t)
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		mockPoktClient := cosmosMocks.NewMockCosmosClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
+
+		address := common.HexToAddress("0x1234").Hex()
+
+		app.Config.Pocket.Confirmations = 0
+		app.Config.SigningPolicy.Mint.MaxAmount = 10000
+		defer func() { app.Config.SigningPolicy.Mint.MaxAmount = 0 }()
+
+		mint := &models.Mint{
+			SenderAddress:    "abcd",
+			RecipientAddress: address,
+			Amount:           "20000",
+			Nonce:            "1",
+			RecipientChainID: "31337",
+			Height:           "99",
+		}
+
+		app.Config.Ethereum.ChainID = "31337"
+
+		tx := &sdk.TxResponse{}
+		oldCosmosUtilValidateTxToCosmosMultisig := cosmosUtilValidateTxToCosmosMultisig
+		defer func() { cosmosUtilValidateTxToCosmosMultisig = oldCosmosUtilValidateTxToCosmosMultisig }()
+		cosmosUtilValidateTxToCosmosMultisig = func(
+			txResponse *sdk.TxResponse,
+			config models.CosmosConfig,
+
+			minAmount math.Int,
+			maxAmount math.Int,
+		) *cosmosUtil.ValidateTxResult {
+			return &cosmosUtil.ValidateTxResult{
+				TxValid:       true,
+				NeedsRefund:   false,
+				SenderAddress: "abcd",
+				Memo: models.MintMemo{
+					Address: address,
+					ChainID: "31337",
+				},
+				Amount: sdk.NewCoin("upokt", math.NewInt(20000)),
+			}
+		}
+
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(tx, nil)
+
+		err := x.HandleMint(context.Background(), mint)
+
+		assert.Equal(t, models.StatusConfirmed, mint.Status)
+		assert.Equal(t, mint.Confirmations, "0")
+
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Error updating mint", func(t *testing.T) {
@@ -957,7 +1025,7 @@ func TestMintSignerHandleMint(t *testing.T) {
 		}
 		txResponse.Tx = &codectypes.Any{Value: txValue}
 
-		mockPoktClient.EXPECT().GetTx("").Return(txResponse, nil)
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
 
 		filter := bson.M{
 			"_id":    mint.Id,
@@ -979,8 +1047,8 @@ func TestMintSignerHandleMint(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, filter, mock.Anything).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				gotUpdate.(bson.M)["$set"].(bson.M)["signatures"] = update["$set"].(bson.M)["signatures"]
 				assert.Equal(t, update, gotUpdate)
@@ -1007,12 +1075,12 @@ func TestMintSignerHandleMint(t *testing.T) {
 			}
 		}
 
-		success := x.HandleMint(mint)
+		err = x.HandleMint(context.Background(), mint)
 
 		assert.Equal(t, models.StatusConfirmed, mint.Status)
 		assert.Equal(t, mint.Confirmations, "0")
 
-		assert.False(t, success)
+		assert.NotNil(t, err)
 	})
 
 	t.Run("Successful case", func(t *testing.T) {
@@ -1079,7 +1147,7 @@ func TestMintSignerHandleMint(t *testing.T) {
 		}
 		txResponse.Tx = &codectypes.Any{Value: txValue}
 
-		mockPoktClient.EXPECT().GetTx("").Return(txResponse, nil)
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
 
 		filter := bson.M{
 			"_id":    mint.Id,
@@ -1101,8 +1169,8 @@ func TestMintSignerHandleMint(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, filter, mock.Anything).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, filter, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				gotUpdate.(bson.M)["$set"].(bson.M)["signatures"] = update["$set"].(bson.M)["signatures"]
 				assert.Equal(t, update, gotUpdate)
@@ -1129,12 +1197,12 @@ func TestMintSignerHandleMint(t *testing.T) {
 			}
 		}
 
-		success := x.HandleMint(mint)
+		err = x.HandleMint(context.Background(), mint)
 
 		assert.Equal(t, models.StatusConfirmed, mint.Status)
 		assert.Equal(t, mint.Confirmations, "0")
 
-		assert.True(t, success)
+		assert.Nil(t, err)
 	})
 
 }
@@ -1150,9 +1218,9 @@ func TestMintSignerSyncTxs(t *testing.T) {
 		app.DB = mockDB
 		x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
 
-		mockDB.EXPECT().FindMany(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"))
+		mockDB.EXPECT().FindMany(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"))
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.False(t, success)
 
@@ -1176,13 +1244,80 @@ func TestMintSignerSyncTxs(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionMints, filter, mock.Anything).Return(nil)
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, filter, mock.Anything).Return(nil)
+
+		success := x.SyncTxs(context.Background())
+
+		assert.True(t, success)
+	})
+
+	t.Run("Skips mint until next retry", func(t *testing.T) {
+		mockWrappedPocketContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockMintControllerContract := ethMocks.NewMockMintControllerContract(t)
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		mockPoktClient := cosmosMocks.NewMockCosmosClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
+
+		nextRetryAt := time.Now().Add(time.Minute)
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				v := result.(*[]models.Mint)
+				*v = []models.Mint{
+					{Retries: map[string]models.Retry{x.address: {Attempts: 1, NextRetryAt: &nextRetryAt}}},
+				}
+			})
 
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
 
+	t.Run("Records failed attempt", func(t *testing.T) {
+		mockWrappedPocketContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockMintControllerContract := ethMocks.NewMockMintControllerContract(t)
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		mockPoktClient := cosmosMocks.NewMockCosmosClient(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintSigner(t, mockWrappedPocketContract, mockMintControllerContract, mockEthClient, mockPoktClient)
+
+		app.Config.Retry.MaxAttempts = 10
+		id := primitive.NewObjectID()
+		// the backoff of another validator does not hold this one back
+		nextRetryAt := time.Now().Add(time.Minute)
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
+				v := result.(*[]models.Mint)
+				*v = []models.Mint{
+					{Id: &id, Amount: "invalid", Retries: map[string]models.Retry{
+						x.address: {Attempts: 1},
+						"0xother": {Attempts: 9, NextRetryAt: &nextRetryAt},
+					}},
+				}
+			})
+
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		filterAttempt := bson.M{
+			"_id":    &id,
+			"status": bson.M{"$in": []string{models.StatusPending, models.StatusConfirmed}},
+		}
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, filterAttempt, mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, update interface{}) {
+				set := update.(bson.M)["$set"].(bson.M)
+				retry := set["retries."+x.address].(models.Retry)
+				assert.Equal(t, int64(2), retry.Attempts)
+				assert.Equal(t, "error converting mint amount to big int", retry.LastError)
+			}).Return(id, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
+
+		success := x.SyncTxs(context.Background())
+
+		assert.False(t, success)
+	})
+
 	t.Run("Error locking", func(t *testing.T) {
 		mockWrappedPocketContract := ethMocks.NewMockWrappedPocketContract(t)
 		mockMintControllerContract := ethMocks.NewMockMintControllerContract(t)
@@ -1201,16 +1336,16 @@ func TestMintSignerSyncTxs(t *testing.T) {
 			},
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionMints, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.Mint)
 				*v = []models.Mint{
 					{},
 				}
 			})
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", errors.New("error"))
-		success := x.SyncTxs()
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", errors.New("error"))
+		success := x.SyncTxs(context.Background())
 
 		assert.False(t, success)
 
@@ -1291,7 +1426,7 @@ func TestMintSignerSyncTxs(t *testing.T) {
 		}
 		txResponse.Tx = &codectypes.Any{Value: txValue}
 
-		mockPoktClient.EXPECT().GetTx("").Return(txResponse, nil)
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
 
 		filterUpdate := bson.M{
 			"_id":    mint.Id,
@@ -1309,27 +1444,29 @@ func TestMintSignerSyncTxs(t *testing.T) {
 				"signers":       []string{x.address},
 				"status":        models.StatusConfirmed,
 				"confirmations": "0",
+				"fencing_token": int64(1),
 				"updated_at":    time.Now(),
 			},
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionMints, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.Mint)
 				*v = []models.Mint{
 					*mint,
 				}
 			})
 
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, filterUpdate, mock.Anything).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, fenced(filterUpdate), mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				gotUpdate.(bson.M)["$set"].(bson.M)["signatures"] = update["$set"].(bson.M)["signatures"]
 				assert.Equal(t, update, gotUpdate)
 			}).Return(primitive.NewObjectID(), nil)
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
-		mockDB.EXPECT().Unlock("lockId").Return(errors.New("error"))
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(errors.New("error"))
 
 		oldCosmosUtilValidateTxToCosmosMultisig := cosmosUtilValidateTxToCosmosMultisig
 		defer func() { cosmosUtilValidateTxToCosmosMultisig = oldCosmosUtilValidateTxToCosmosMultisig }()
@@ -1351,7 +1488,7 @@ func TestMintSignerSyncTxs(t *testing.T) {
 				Amount: sdk.NewCoin("upokt", math.NewInt(20000)),
 			}
 		}
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.False(t, success)
 	})
@@ -1431,7 +1568,7 @@ func TestMintSignerSyncTxs(t *testing.T) {
 		}
 		txResponse.Tx = &codectypes.Any{Value: txValue}
 
-		mockPoktClient.EXPECT().GetTx("").Return(txResponse, nil)
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
 
 		filterUpdate := bson.M{
 			"_id":    mint.Id,
@@ -1449,27 +1586,29 @@ func TestMintSignerSyncTxs(t *testing.T) {
 				"signers":       []string{x.address},
 				"status":        models.StatusConfirmed,
 				"confirmations": "0",
+				"fencing_token": int64(1),
 				"updated_at":    time.Now(),
 			},
 		}
 
-		mockDB.EXPECT().FindMany(models.CollectionMints, filterFind, mock.Anything).Return(nil).
-			Run(func(_ string, _ interface{}, result interface{}) {
+		mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, filterFind, mock.Anything).Return(nil).
+			Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 				v := result.(*[]models.Mint)
 				*v = []models.Mint{
 					*mint,
 				}
 			})
 
-		mockDB.EXPECT().UpdateOne(models.CollectionMints, filterUpdate, mock.Anything).
-			Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+		mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, fenced(filterUpdate), mock.Anything).
+			Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 				gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 				gotUpdate.(bson.M)["$set"].(bson.M)["signatures"] = update["$set"].(bson.M)["signatures"]
 				assert.Equal(t, update, gotUpdate)
 			}).Return(primitive.NewObjectID(), nil)
 
-		mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
-		mockDB.EXPECT().Unlock("lockId").Return(nil)
+		mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+		mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+		mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
 
 		oldCosmosUtilValidateTxToCosmosMultisig := cosmosUtilValidateTxToCosmosMultisig
 		defer func() { cosmosUtilValidateTxToCosmosMultisig = oldCosmosUtilValidateTxToCosmosMultisig }()
@@ -1491,7 +1630,7 @@ func TestMintSignerSyncTxs(t *testing.T) {
 				Amount: sdk.NewCoin("upokt", math.NewInt(20000)),
 			}
 		}
-		success := x.SyncTxs()
+		success := x.SyncTxs(context.Background())
 
 		assert.True(t, success)
 	})
@@ -1574,7 +1713,7 @@ func TestMintSignerRun(t *testing.T) {
 	}
 	txResponse.Tx = &codectypes.Any{Value: txValue}
 
-	mockPoktClient.EXPECT().GetTx("").Return(txResponse, nil)
+	mockPoktClient.EXPECT().GetTx(mock.Anything, "").Return(txResponse, nil)
 
 	filterUpdate := bson.M{
 		"_id":    mint.Id,
@@ -1592,29 +1731,31 @@ func TestMintSignerRun(t *testing.T) {
 			"signers":       []string{x.address},
 			"status":        models.StatusConfirmed,
 			"confirmations": "0",
+			"fencing_token": int64(1),
 			"updated_at":    time.Now(),
 		},
 	}
 
-	mockDB.EXPECT().FindMany(models.CollectionMints, filterFind, mock.Anything).Return(nil).
-		Run(func(_ string, _ interface{}, result interface{}) {
+	mockDB.EXPECT().FindMany(mock.Anything, models.CollectionMints, filterFind, mock.Anything).Return(nil).
+		Run(func(_ context.Context, _ string, _ interface{}, result interface{}) {
 			v := result.(*[]models.Mint)
 			*v = []models.Mint{
 				*mint,
 			}
 		})
 
-	mockDB.EXPECT().UpdateOne(models.CollectionMints, filterUpdate, mock.Anything).
-		Run(func(_ string, _ interface{}, gotUpdate interface{}) {
+	mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, fenced(filterUpdate), mock.Anything).
+		Run(func(_ context.Context, _ string, _ interface{}, gotUpdate interface{}) {
 			gotUpdate.(bson.M)["$set"].(bson.M)["updated_at"] = update["$set"].(bson.M)["updated_at"]
 			gotUpdate.(bson.M)["$set"].(bson.M)["signatures"] = update["$set"].(bson.M)["signatures"]
 			assert.Equal(t, update, gotUpdate)
 		}).Return(primitive.NewObjectID(), nil)
 
-	mockDB.EXPECT().XLock(mock.Anything).Return("lockId", nil)
-	mockDB.EXPECT().Unlock("lockId").Return(nil)
+	mockDB.EXPECT().XLock(mock.Anything, mock.Anything).Return("lockId", nil)
+	mockDB.EXPECT().NextFencingToken(mock.Anything, mock.Anything).Return(1, nil)
+	mockDB.EXPECT().Unlock(mock.Anything, "lockId").Return(nil)
 
-	mockPoktClient.EXPECT().GetLatestBlockHeight().Return(int64(200), nil)
+	mockPoktClient.EXPECT().GetLatestBlockHeight(mock.Anything).Return(int64(200), nil)
 
 	mockMintControllerContract.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(3), nil)
 
@@ -1641,12 +1782,24 @@ func TestMintSignerRun(t *testing.T) {
 		}
 	}
 
-	x.Run()
+	err = x.Run(context.Background())
+
+	assert.Nil(t, err)
 
 }
 
 func TestNewMintSigner(t *testing.T) {
 
+	app.Config.Pocket.Mnemonic = "test test test test test test test test test test test junk"
+	app.Config.Pocket.MultisigPublicKeys = []string{
+		"0223aa679d6d5344e201e0df9f02ab15a84726eee0dfb4e953c46a9e2cb52349dc",
+		"02faaaf0f385bb17381f36dcd86ab2486e8ff8d93440436496665ac007953076c2",
+		"02cae233806460db75a941a269490ca5165a620b43241edb8bc72e169f4143a6df",
+	}
+	app.Config.Pocket.MultisigAddress = "pokt10r5n6x28p9qntchsmhxd4ftq9lk6vzcx3dv4gx"
+	app.Config.Pocket.MultisigThreshold = 2
+	app.Config.Pocket.Bech32Prefix = "pokt"
+
 	t.Run("Disabled", func(t *testing.T) {
 
 		app.Config.MintSigner.Enabled = false
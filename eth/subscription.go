@@ -0,0 +1,99 @@
+package eth
+
+import (
+	"context"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+	log "github.com/sirupsen/logrus"
+)
+
+// SubscriptionRetryDelay is how long a subscription waits before reconnecting after an error
+var SubscriptionRetryDelay = 5 * time.Second
+
+// websocketURLs are the configured websocket endpoints, ws_url first
+func websocketURLs() []string {
+	urls := []string{}
+	if app.Config.Ethereum.WSURL != "" {
+		urls = append(urls, app.Config.Ethereum.WSURL)
+	}
+	return append(urls, app.Config.Ethereum.WSURLs...)
+}
+
+// dialWebsocketContract connects to the wpokt contract over a websocket endpoint
+var dialWebsocketContract = func(ctx context.Context, url string) (eth.WrappedPocketContract, func(), error) {
+	client, err := ethclient.DialContext(ctx, url)
+	if err != nil {
+		return nil, nil, err
+	}
+	contract, err := autogen.NewWrappedPocket(common.HexToAddress(app.Config.Ethereum.WrappedPocketAddress), client)
+	if err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+	return eth.NewWrappedPocketContract(contract), client.Close, nil
+}
+
+// watchEvents subscribes to events over the websocket endpoints until ctx is done,
+// reconnecting to the next endpoint after errors. Events missed while disconnected are picked up by the periodic sync.
+func watchEvents[T any](
+	ctx context.Context,
+	name string,
+	watch func(contract eth.WrappedPocketContract, sink chan<- *T) (event.Subscription, error),
+	handle func(event *T),
+) {
+	urls := websocketURLs()
+	if len(urls) == 0 {
+		return
+	}
+
+	for index := 0; ctx.Err() == nil; index = (index + 1) % len(urls) {
+		if err := watchOnce(ctx, name, urls[index], watch, handle); err != nil {
+			log.Errorf("[%s] Subscription to endpoint %d failed, reconnecting in %s: %s", name, index, SubscriptionRetryDelay, err)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(SubscriptionRetryDelay):
+		}
+	}
+	log.Infof("[%s] Subscription stopped", name)
+}
+
+func watchOnce[T any](
+	ctx context.Context,
+	name string,
+	url string,
+	watch func(contract eth.WrappedPocketContract, sink chan<- *T) (event.Subscription, error),
+	handle func(event *T),
+) error {
+	contract, closeClient, err := dialWebsocketContract(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer closeClient()
+
+	sink := make(chan *T)
+	sub, err := watch(contract, sink)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	log.Infof("[%s] Subscribed to events", name)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sub.Err():
+			return err
+		case event := <-sink:
+			handle(event)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	appMocks "github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	ethMocks "github.com/dan13ram/wpokt-validator/eth/client/mocks"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func withWebsocketContract(t *testing.T, dial func(ctx context.Context, url string) (eth.WrappedPocketContract, func(), error)) {
+	oldDial, oldDelay, oldURL, oldURLs := dialWebsocketContract, SubscriptionRetryDelay, app.Config.Ethereum.WSURL, app.Config.Ethereum.WSURLs
+	t.Cleanup(func() {
+		dialWebsocketContract, SubscriptionRetryDelay, app.Config.Ethereum.WSURL, app.Config.Ethereum.WSURLs = oldDial, oldDelay, oldURL, oldURLs
+	})
+	dialWebsocketContract = dial
+	SubscriptionRetryDelay = 10 * time.Millisecond
+	app.Config.Ethereum.WSURL = "ws://localhost:8546"
+	app.Config.Ethereum.WSURLs = nil
+}
+
+func TestWatchEventsDisabled(t *testing.T) {
+	withWebsocketContract(t, func(ctx context.Context, url string) (eth.WrappedPocketContract, func(), error) {
+		t.Fatal("dialed without a websocket url")
+		return nil, nil, nil
+	})
+	app.Config.Ethereum.WSURL = ""
+	app.Config.Ethereum.WSURLs = nil
+
+	watchEvents(context.Background(), BurnMonitorName,
+		func(contract eth.WrappedPocketContract, sink chan<- *autogen.WrappedPocketBurnAndBridge) (event.Subscription, error) {
+			return nil, nil
+		},
+		func(event *autogen.WrappedPocketBurnAndBridge) {},
+	)
+}
+
+func TestWatchEventsReconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dials := 0
+	withWebsocketContract(t, func(ctx context.Context, url string) (eth.WrappedPocketContract, func(), error) {
+		dials++
+		if dials == 1 {
+			return nil, nil, errors.New("connection refused")
+		}
+		return ethMocks.NewMockWrappedPocketContract(t), func() {}, nil
+	})
+
+	watchEvents(ctx, MintExecutorName,
+		func(contract eth.WrappedPocketContract, sink chan<- *autogen.WrappedPocketMinted) (event.Subscription, error) {
+			return event.NewSubscription(func(quit <-chan struct{}) error {
+				sink <- &autogen.WrappedPocketMinted{Nonce: big.NewInt(int64(dials))}
+				<-quit
+				return nil
+			}), nil
+		},
+		func(event *autogen.WrappedPocketMinted) {
+			assert.Equal(t, int64(2), event.Nonce.Int64())
+			cancel()
+		},
+	)
+
+	assert.Equal(t, 2, dials)
+}
+
+func TestWatchEventsFailover(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dialed := []string{}
+	withWebsocketContract(t, func(ctx context.Context, url string) (eth.WrappedPocketContract, func(), error) {
+		dialed = append(dialed, url)
+		if url == "ws://localhost:8546" {
+			return nil, nil, errors.New("connection refused")
+		}
+		return ethMocks.NewMockWrappedPocketContract(t), func() {}, nil
+	})
+	app.Config.Ethereum.WSURLs = []string{"wss://backup:8546"}
+
+	watchEvents(ctx, MintExecutorName,
+		func(contract eth.WrappedPocketContract, sink chan<- *autogen.WrappedPocketMinted) (event.Subscription, error) {
+			return event.NewSubscription(func(quit <-chan struct{}) error {
+				sink <- &autogen.WrappedPocketMinted{}
+				<-quit
+				return nil
+			}), nil
+		},
+		func(event *autogen.WrappedPocketMinted) {
+			cancel()
+		},
+	)
+
+	assert.Equal(t, []string{"ws://localhost:8546", "wss://backup:8546"}, dialed)
+}
+
+func TestBurnMonitorSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockContract := ethMocks.NewMockWrappedPocketContract(t)
+	mockClient := ethMocks.NewMockEthereumClient(t)
+	mockDB := appMocks.NewMockDatabase(t)
+	app.DB = mockDB
+	x := NewTestBurnMonitor(t, mockContract, mockClient)
+
+	withWebsocketContract(t, func(ctx context.Context, url string) (eth.WrappedPocketContract, func(), error) {
+		return mockContract, func() {}, nil
+	})
+
+	mockContract.EXPECT().WatchBurnAndBridge(mock.Anything, mock.Anything, []*big.Int{}, []common.Address{}, []common.Address{}).
+		RunAndReturn(func(opts *bind.WatchOpts, sink chan<- *autogen.WrappedPocketBurnAndBridge, amount []*big.Int, poktAddress []common.Address, from []common.Address) (event.Subscription, error) {
+			return event.NewSubscription(func(quit <-chan struct{}) error {
+				events := []*autogen.WrappedPocketBurnAndBridge{
+					{Amount: big.NewInt(10), Raw: types.Log{Index: 1}},
+					{Amount: big.NewInt(20000), Raw: types.Log{Index: 2, Removed: true}},
+					{Amount: big.NewInt(20000), Raw: types.Log{Index: 3}},
+				}
+				for _, e := range events {
+					sink <- e
+				}
+				<-quit
+				return nil
+			}), nil
+		}).Once()
+
+	mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionBurns, mock.Anything, mock.Anything).Return(primitive.NilObjectID, mongo.ErrNoDocuments)
+	mockDB.EXPECT().InsertOne(mock.Anything, models.CollectionBurns, mock.Anything).
+		Run(func(ctx context.Context, collection string, data interface{}) {
+			assert.Equal(t, "3", data.(models.Burn).LogIndex)
+			cancel()
+		}).
+		Return(primitive.NewObjectID(), nil).Once()
+
+	x.Subscribe(ctx)
+}
+
+func TestMintExecutorSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockContract := ethMocks.NewMockWrappedPocketContract(t)
+	mockClient := ethMocks.NewMockEthereumClient(t)
+	mockDB := appMocks.NewMockDatabase(t)
+	app.DB = mockDB
+	x := NewTestMintExecutor(t, mockContract, mockClient)
+
+	withWebsocketContract(t, func(ctx context.Context, url string) (eth.WrappedPocketContract, func(), error) {
+		return mockContract, func() {}, nil
+	})
+
+	unconfirmed := common.HexToHash("0x01")
+	confirmed := common.HexToHash("0x02")
+	mockContract.EXPECT().WatchMinted(mock.Anything, mock.Anything, []common.Address{}, []*big.Int{}, []*big.Int{}).
+		RunAndReturn(func(opts *bind.WatchOpts, sink chan<- *autogen.WrappedPocketMinted, recipient []common.Address, amount []*big.Int, nonce []*big.Int) (event.Subscription, error) {
+			return event.NewSubscription(func(quit <-chan struct{}) error {
+				events := []*autogen.WrappedPocketMinted{
+					{Nonce: big.NewInt(1), Amount: big.NewInt(1), Raw: types.Log{TxHash: unconfirmed, Index: 1}},
+					{Nonce: big.NewInt(2), Amount: big.NewInt(1), Raw: types.Log{TxHash: confirmed, Index: 2}},
+				}
+				for _, e := range events {
+					sink <- e
+				}
+				<-quit
+				return nil
+			}), nil
+		}).Once()
+
+	mockClient.EXPECT().GetTransactionReceipt(mock.Anything, unconfirmed.String()).Return(nil, errors.New("no quorum"))
+	mockClient.EXPECT().GetTransactionReceipt(mock.Anything, confirmed.String()).
+		Return(&types.Receipt{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{{Index: 2}}}, nil)
+	mockDB.EXPECT().UpdateOne(mock.Anything, models.CollectionMints, mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, collection string, filter interface{}, update interface{}) {
+			assert.Equal(t, "2", filter.(bson.M)["nonce"])
+			cancel()
+		}).
+		Return(primitive.NewObjectID(), nil).Once()
+
+	x.Subscribe(ctx)
+}
@@ -17,6 +17,7 @@ func CreateBurn(event *autogen.WrappedPocketBurnAndBridge) models.Burn {
 
 	doc := models.Burn{
 		BlockNumber:           strconv.FormatInt(int64(event.Raw.BlockNumber), 10),
+		BlockHash:             strings.ToLower(event.Raw.BlockHash.String()),
 		Confirmations:         "0",
 		TransactionHash:       strings.ToLower(event.Raw.TxHash.String()),
 		LogIndex:              strconv.FormatInt(int64(event.Raw.Index), 10),
@@ -22,6 +22,7 @@ func TestCreateBurn(t *testing.T) {
 	SENDER_ADDRESS := "0x0000000000000000000000000000000000abcDeF"
 	RECIPIENT_ADDRESS := "0000000000000000000000000000001234567890"
 	ZERO_ADDRESS := "0x0000000000000000000000000000000000000000"
+	ZERO_HASH := "0x0000000000000000000000000000000000000000000000000000000000000000"
 
 	recipientAddress, _ := common.Bech32FromBytes(app.Config.Pocket.Bech32Prefix, ethcommon.HexToAddress(RECIPIENT_ADDRESS).Bytes())
 
@@ -47,6 +48,7 @@ func TestCreateBurn(t *testing.T) {
 			},
 			expectedBurn: models.Burn{
 				BlockNumber:           "10",
+				BlockHash:             ZERO_HASH,
 				Confirmations:         "0",
 				TransactionHash:       TX_HASH,
 				LogIndex:              "0",
@@ -1,17 +1,18 @@
 package util
 
 import (
-	"crypto/ecdsa"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
 
-	"github.com/ethereum/go-ethereum/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/common"
 	"github.com/dan13ram/wpokt-validator/eth/autogen"
 	eth "github.com/dan13ram/wpokt-validator/eth/client"
 	"github.com/dan13ram/wpokt-validator/models"
@@ -56,11 +57,10 @@ var typesStandard = apitypes.Types{
 	},
 }
 
-func signTypedData(
+func newTypedData(
 	domainData eth.DomainData,
 	mint *autogen.MintControllerMintData,
-	key *ecdsa.PrivateKey,
-) ([]byte, error) {
+) apitypes.TypedData {
 
 	message := apitypes.TypedDataMessage{
 		"recipient": mint.Recipient.String(),
@@ -75,12 +75,20 @@ func signTypedData(
 		VerifyingContract: domainData.VerifyingContract.String(),
 	}
 
-	typedData := apitypes.TypedData{
+	return apitypes.TypedData{
 		Types:       typesStandard,
 		PrimaryType: primaryType,
 		Domain:      domain,
 		Message:     message,
 	}
+}
+
+// MintDigest returns the EIP-712 hash signed for the mint data
+func MintDigest(
+	domainData eth.DomainData,
+	mint *autogen.MintControllerMintData,
+) ([]byte, error) {
+	typedData := newTypedData(domainData, mint)
 
 	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
 	if err != nil {
@@ -93,14 +101,55 @@ func signTypedData(
 	}
 
 	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
-	sighash := crypto.Keccak256(rawData)
+	return crypto.Keccak256(rawData), nil
+}
+
+// RecoverMintSigner returns the lowercase address that produced the hex encoded signature over the mint data
+func RecoverMintSigner(
+	domainData eth.DomainData,
+	mint *autogen.MintControllerMintData,
+	signature string,
+) (string, error) {
+	sighash, err := MintDigest(domainData, mint)
+	if err != nil {
+		return "", err
+	}
+
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return "", fmt.Errorf("error decoding signature: %w", err)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return "", fmt.Errorf("invalid signature length: %d", len(sig))
+	}
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(sighash, sig)
+	if err != nil {
+		return "", fmt.Errorf("error recovering signer: %w", err)
+	}
+	return strings.ToLower(crypto.PubkeyToAddress(*pubKey).Hex()), nil
+}
+
+func signTypedData(
+	ctx context.Context,
+	domainData eth.DomainData,
+	mint *autogen.MintControllerMintData,
+	signer common.Signer,
+) ([]byte, error) {
 
-	signature, err := crypto.Sign(sighash, key)
-	if signature[64] == 0 || signature[64] == 1 {
-		signature[64] += 27
+	if typedDataSigner, ok := signer.(common.TypedDataSigner); ok {
+		return typedDataSigner.EthSignTypedData(ctx, newTypedData(domainData, mint))
 	}
 
-	return signature, err
+	sighash, err := MintDigest(domainData, mint)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.EthSign(ctx, sighash)
 }
 
 func UpdateStatusAndConfirmationsForMint(mint *models.Mint, poktHeight int64) (*models.Mint, error) {
@@ -131,7 +180,7 @@ func UpdateStatusAndConfirmationsForMint(mint *models.Mint, poktHeight int64) (*
 	return mint, nil
 }
 
-func sortSignersAndSignatures(signers, signatures []string) ([]string, []string) {
+func SortSignersAndSignatures(signers, signatures []string) ([]string, []string) {
 	type SignerSignaturePair struct {
 		Signer    string
 		Signature string
@@ -148,7 +197,7 @@ func sortSignersAndSignatures(signers, signatures []string) ([]string, []string)
 
 	// Sort pairs based on signer
 	sort.Slice(pairs, func(i, j int) bool {
-		return common.HexToAddress(pairs[i].Signer).Big().Cmp(common.HexToAddress(pairs[j].Signer).Big()) == -1
+		return ethcommon.HexToAddress(pairs[i].Signer).Big().Cmp(ethcommon.HexToAddress(pairs[j].Signer).Big()) == -1
 	})
 
 	// Extract sorted signers and signatures
@@ -161,18 +210,37 @@ func sortSignersAndSignatures(signers, signatures []string) ([]string, []string)
 }
 
 func SignMint(
+	ctx context.Context,
 	mint *models.Mint,
 	data *autogen.MintControllerMintData,
 	domain eth.DomainData,
-	privateKey *ecdsa.PrivateKey,
+	signer common.Signer,
 	signerThreshold int,
 ) (*models.Mint, error) {
-	signature, err := signTypedData(domain, data, privateKey)
+	signature, err := signTypedData(ctx, domain, data, signer)
 	if err != nil {
 		return mint, err
 	}
 
 	signatureEncoded := "0x" + hex.EncodeToString(signature)
+
+	digest, err := MintDigest(domain, data)
+	if err != nil {
+		return mint, err
+	}
+	err = app.RecordSignature(ctx, models.AuditEntry{
+		Kind:                  app.SigningKindMint,
+		Signer:                strings.ToLower(signer.EthAddress().Hex()),
+		Digest:                "0x" + hex.EncodeToString(digest),
+		Signature:             signatureEncoded,
+		SourceTransactionHash: mint.TransactionHash,
+		Recipient:             strings.ToLower(data.Recipient.Hex()),
+		Amount:                data.Amount.String(),
+		Nonce:                 data.Nonce.String(),
+	})
+	if err != nil {
+		return mint, fmt.Errorf("error recording signature: %w", err)
+	}
 	signatures := mint.Signatures
 	signers := mint.Signers
 	if signatures == nil || signers == nil || len(signatures) != len(signers) || len(signatures) == 0 {
@@ -180,9 +248,9 @@ func SignMint(
 		signers = []string{}
 	}
 	signatures = append(signatures, signatureEncoded)
-	signers = append(signers, strings.ToLower(crypto.PubkeyToAddress(privateKey.PublicKey).Hex()))
+	signers = append(signers, strings.ToLower(signer.EthAddress().Hex()))
 
-	sortedSigners, sortedSignatures := sortSignersAndSignatures(signers, signatures)
+	sortedSigners, sortedSignatures := SortSignersAndSignatures(signers, signatures)
 
 	if len(sortedSignatures) >= signerThreshold {
 		mint.Status = models.StatusSigned
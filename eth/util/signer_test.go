@@ -1,17 +1,17 @@
 package util
 
 import (
-	"crypto/ecdsa"
+	"context"
 	"math/big"
 	"strings"
 	"testing"
 
 	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/common"
 	"github.com/dan13ram/wpokt-validator/eth/autogen"
 	eth "github.com/dan13ram/wpokt-validator/eth/client"
 	"github.com/dan13ram/wpokt-validator/models"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -148,18 +148,18 @@ func TestSignMint(t *testing.T) {
 		Name:              "Test",
 		Version:           "1",
 		ChainId:           big.NewInt(1),
-		VerifyingContract: common.HexToAddress(ZERO_ADDRESS),
+		VerifyingContract: ethcommon.HexToAddress(ZERO_ADDRESS),
 	}
 
-	testPrivateKey, _ := crypto.HexToECDSA("1395eeb9c36ef43e9e05692c9ee34034c00a9bef301135a96d082b2a65fd1680")
+	testSigner, _ := common.NewPrivateKeySigner("1395eeb9c36ef43e9e05692c9ee34034c00a9bef301135a96d082b2a65fd1680")
 	testSignature := "0x6b170e88743324cb571398f279d58a235e41d16efb7b4a90db7e86a6ddf5eb472d5b791c00aaa59c7755305cc3fb20c407a8d1fbbeacdd7032d509ce7c48cebd1b"
 	testData := autogen.MintControllerMintData{
-		Recipient: common.HexToAddress(ZERO_ADDRESS),
+		Recipient: ethcommon.HexToAddress(ZERO_ADDRESS),
 		Amount:    big.NewInt(100),
 		Nonce:     big.NewInt(1),
 	}
 
-	testAddress := strings.ToLower(crypto.PubkeyToAddress(testPrivateKey.PublicKey).Hex())
+	testAddress := strings.ToLower(testSigner.EthAddress().Hex())
 
 	testCases := []struct {
 		name         string
@@ -169,7 +169,7 @@ func TestSignMint(t *testing.T) {
 		expectedErr  bool
 		data         autogen.MintControllerMintData
 		domain       eth.DomainData
-		privateKey   *ecdsa.PrivateKey
+		signer       common.Signer
 	}{
 		{
 			name: "Single signer, mint not signed",
@@ -187,7 +187,7 @@ func TestSignMint(t *testing.T) {
 			expectedErr: false,
 			data:        testData,
 			domain:      testDomain,
-			privateKey:  testPrivateKey,
+			signer:      testSigner,
 		},
 		{
 			name: "Multiple signers, mint not signed",
@@ -205,7 +205,7 @@ func TestSignMint(t *testing.T) {
 			expectedErr: false,
 			data:        testData,
 			domain:      testDomain,
-			privateKey:  testPrivateKey,
+			signer:      testSigner,
 		},
 		{
 			name: "Multiple signers, mint signed",
@@ -223,7 +223,7 @@ func TestSignMint(t *testing.T) {
 			expectedErr: false,
 			data:        testData,
 			domain:      testDomain,
-			privateKey:  testPrivateKey,
+			signer:      testSigner,
 		},
 		{
 			name: "Multiple signers, mint signed",
@@ -241,7 +241,7 @@ func TestSignMint(t *testing.T) {
 			expectedErr: false,
 			data:        testData,
 			domain:      testDomain,
-			privateKey:  testPrivateKey,
+			signer:      testSigner,
 		},
 		{
 			name: "Invalid domain",
@@ -261,10 +261,10 @@ func TestSignMint(t *testing.T) {
 			domain: eth.DomainData{
 				ChainId: big.NewInt(1),
 			},
-			privateKey: testPrivateKey,
+			signer: testSigner,
 		},
 		{
-			name: "Invalid privateKey",
+			name: "Invalid mint data",
 			initialMint: models.Mint{
 				Status:     models.StatusConfirmed,
 				Signatures: []string{"0x..."},
@@ -278,17 +278,17 @@ func TestSignMint(t *testing.T) {
 			},
 			expectedErr: true,
 			data: autogen.MintControllerMintData{
-				Recipient: common.HexToAddress(ZERO_ADDRESS),
+				Recipient: ethcommon.HexToAddress(ZERO_ADDRESS),
 				Amount:    big.NewInt(100),
 			},
-			domain:     testDomain,
-			privateKey: testPrivateKey,
+			domain: testDomain,
+			signer: testSigner,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result, err := SignMint(&tc.initialMint, &tc.data, tc.domain, tc.privateKey, tc.numSigners)
+			result, err := SignMint(context.Background(), &tc.initialMint, &tc.data, tc.domain, tc.signer, tc.numSigners)
 
 			if tc.expectedErr {
 				assert.Error(t, err)
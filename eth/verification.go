@@ -0,0 +1,92 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	"github.com/dan13ram/wpokt-validator/eth/util"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	log "github.com/sirupsen/logrus"
+)
+
+func (x *MintSignerRunner) recordDiscrepancy(ctx context.Context, mint *models.Mint, field string, expected string, stored string) {
+	err := app.RecordDiscrepancy(ctx, models.Discrepancy{
+		ValidatorId:     x.validatorId,
+		Service:         MintSignerName,
+		Collection:      models.CollectionMints,
+		TransactionHash: mint.TransactionHash,
+		Field:           field,
+		Expected:        expected,
+		Stored:          stored,
+	})
+	if err != nil {
+		log.Error("[MINT SIGNER] Error recording discrepancy: ", err)
+	}
+}
+
+// VerifyMint checks the stored nonce, mint data and signatures against the mint data rebuilt from chain state
+func (x *MintSignerRunner) VerifyMint(ctx context.Context, mint *models.Mint, data *autogen.MintControllerMintData) (bool, error) {
+	log.Debug("[MINT SIGNER] Verifying mint: ", mint.TransactionHash)
+
+	callCtx, cancel := context.WithTimeout(ctx, time.Duration(app.Config.Ethereum.RPCTimeoutMillis)*time.Millisecond)
+	defer cancel()
+	opts := &bind.CallOpts{Context: callCtx, Pending: false}
+	currentNonce, err := x.wpoktContract.GetUserNonce(opts, data.Recipient)
+	if err != nil {
+		return false, fmt.Errorf("error fetching nonce from contract: %w", err)
+	}
+
+	verified := true
+
+	if data.Nonce.Cmp(currentNonce) <= 0 {
+		x.recordDiscrepancy(ctx, mint, "nonce", "> "+currentNonce.String(), data.Nonce.String())
+		verified = false
+	}
+
+	expected := models.MintData{
+		Recipient: strings.ToLower(data.Recipient.Hex()),
+		Amount:    data.Amount.String(),
+		Nonce:     data.Nonce.String(),
+	}
+	if mint.Data != nil && *mint.Data != expected {
+		x.recordDiscrepancy(ctx, mint, "data", fmt.Sprintf("%+v", expected), fmt.Sprintf("%+v", *mint.Data))
+		verified = false
+	}
+
+	if len(mint.Signatures) != len(mint.Signers) {
+		x.recordDiscrepancy(ctx, mint, "signatures", fmt.Sprintf("%d signatures", len(mint.Signers)), fmt.Sprintf("%d signatures", len(mint.Signatures)))
+		return false, nil
+	}
+
+	for i, signer := range mint.Signers {
+		if !isValidatorAddress(signer) {
+			x.recordDiscrepancy(ctx, mint, "signers", "validator address", signer)
+			verified = false
+			continue
+		}
+		recovered, err := util.RecoverMintSigner(x.domain, data, mint.Signatures[i])
+		if err != nil || !strings.EqualFold(recovered, signer) {
+			x.recordDiscrepancy(ctx, mint, "signatures", signer, mint.Signatures[i])
+			verified = false
+		}
+	}
+
+	if verified {
+		log.Debug("[MINT SIGNER] Verified mint")
+	}
+	return verified, nil
+}
+
+func isValidatorAddress(address string) bool {
+	for _, validator := range app.Config.Ethereum.ValidatorAddresses {
+		if strings.EqualFold(validator, address) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dan13ram/wpokt-validator/app"
+	appMocks "github.com/dan13ram/wpokt-validator/app/mocks"
+	"github.com/dan13ram/wpokt-validator/common"
+	cosmosMocks "github.com/dan13ram/wpokt-validator/cosmos/client/mocks"
+	cosmosUtil "github.com/dan13ram/wpokt-validator/cosmos/util"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	ethMocks "github.com/dan13ram/wpokt-validator/eth/client/mocks"
+	"github.com/dan13ram/wpokt-validator/eth/util"
+	"github.com/dan13ram/wpokt-validator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestMintSignerVerifyMint(t *testing.T) {
+	otherSigner, _ := common.NewMnemonicSigner("test test test test test test test test test test test junk")
+	otherAddress := strings.ToLower(otherSigner.EthAddress().Hex())
+
+	oldValidatorAddresses := app.Config.Ethereum.ValidatorAddresses
+	defer func() { app.Config.Ethereum.ValidatorAddresses = oldValidatorAddresses }()
+	app.Config.Ethereum.ValidatorAddresses = []string{otherAddress}
+
+	recipient := common.HexToAddress("0x1234")
+	data := &autogen.MintControllerMintData{
+		Recipient: recipient,
+		Amount:    big.NewInt(20000),
+		Nonce:     big.NewInt(2),
+	}
+	storedData := &models.MintData{
+		Recipient: strings.ToLower(recipient.Hex()),
+		Amount:    "20000",
+		Nonce:     "2",
+	}
+
+	setup := func(t *testing.T) (*MintSignerRunner, *ethMocks.MockWrappedPocketContract, *appMocks.MockDatabase) {
+		mockWrappedPocketContract := ethMocks.NewMockWrappedPocketContract(t)
+		mockDB := appMocks.NewMockDatabase(t)
+		app.DB = mockDB
+		x := NewTestMintSigner(t, mockWrappedPocketContract, ethMocks.NewMockMintControllerContract(t), ethMocks.NewMockEthereumClient(t), cosmosMocks.NewMockCosmosClient(t))
+		x.validatorId = "wpokt-validator-01"
+		return x, mockWrappedPocketContract, mockDB
+	}
+
+	signedMint := func(t *testing.T, x *MintSignerRunner) *models.Mint {
+		mint, err := util.SignMint(context.Background(), &models.Mint{TransactionHash: "hash", Data: storedData}, data, x.domain, otherSigner, 2)
+		assert.NoError(t, err)
+		return mint
+	}
+
+	expectDiscrepancy := func(mockDB *appMocks.MockDatabase, field string) {
+		filter := bson.M{"validator_id": "wpokt-validator-01", "collection": models.CollectionMints, "transaction_hash": "hash", "field": field}
+		mockDB.EXPECT().UpsertOne(mock.Anything, models.CollectionDiscrepancies, filter, mock.Anything).Return(primitive.NewObjectID(), nil).Once()
+	}
+
+	t.Run("Nothing stored yet", func(t *testing.T) {
+		x, mockWrappedPocketContract, _ := setup(t)
+		mockWrappedPocketContract.EXPECT().GetUserNonce(mock.Anything, recipient).Return(big.NewInt(1), nil)
+
+		verified, err := x.VerifyMint(context.Background(), &models.Mint{TransactionHash: "hash"}, data)
+
+		assert.NoError(t, err)
+		assert.True(t, verified)
+	})
+
+	t.Run("Stored data and signature match", func(t *testing.T) {
+		x, mockWrappedPocketContract, _ := setup(t)
+		mockWrappedPocketContract.EXPECT().GetUserNonce(mock.Anything, recipient).Return(big.NewInt(1), nil)
+
+		verified, err := x.VerifyMint(context.Background(), signedMint(t, x), data)
+
+		assert.NoError(t, err)
+		assert.True(t, verified)
+	})
+
+	t.Run("Error fetching nonce", func(t *testing.T) {
+		x, mockWrappedPocketContract, _ := setup(t)
+		mockWrappedPocketContract.EXPECT().GetUserNonce(mock.Anything, recipient).Return(nil, errors.New("error"))
+
+		verified, err := x.VerifyMint(context.Background(), &models.Mint{TransactionHash: "hash"}, data)
+
+		assert.Error(t, err)
+		assert.False(t, verified)
+	})
+
+	t.Run("Nonce already used on chain", func(t *testing.T) {
+		x, mockWrappedPocketContract, mockDB := setup(t)
+		mockWrappedPocketContract.EXPECT().GetUserNonce(mock.Anything, recipient).Return(big.NewInt(2), nil)
+		expectDiscrepancy(mockDB, "nonce")
+
+		verified, err := x.VerifyMint(context.Background(), &models.Mint{TransactionHash: "hash"}, data)
+
+		assert.NoError(t, err)
+		assert.False(t, verified)
+	})
+
+	t.Run("Stored data tampered", func(t *testing.T) {
+		x, mockWrappedPocketContract, mockDB := setup(t)
+		mockWrappedPocketContract.EXPECT().GetUserNonce(mock.Anything, recipient).Return(big.NewInt(1), nil)
+		expectDiscrepancy(mockDB, "data")
+
+		mint := &models.Mint{TransactionHash: "hash", Data: &models.MintData{
+			Recipient: storedData.Recipient,
+			Amount:    "200000",
+			Nonce:     storedData.Nonce,
+		}}
+		verified, err := x.VerifyMint(context.Background(), mint, data)
+
+		assert.NoError(t, err)
+		assert.False(t, verified)
+	})
+
+	t.Run("Signature over other data", func(t *testing.T) {
+		x, mockWrappedPocketContract, mockDB := setup(t)
+		mockWrappedPocketContract.EXPECT().GetUserNonce(mock.Anything, recipient).Return(big.NewInt(1), nil)
+		expectDiscrepancy(mockDB, "signatures")
+
+		otherData := &autogen.MintControllerMintData{Recipient: recipient, Amount: big.NewInt(200000), Nonce: big.NewInt(2)}
+		mint, err := util.SignMint(context.Background(), &models.Mint{TransactionHash: "hash"}, otherData, x.domain, otherSigner, 2)
+		assert.NoError(t, err)
+
+		verified, err := x.VerifyMint(context.Background(), mint, data)
+
+		assert.NoError(t, err)
+		assert.False(t, verified)
+	})
+
+	t.Run("Signer is not a validator", func(t *testing.T) {
+		x, mockWrappedPocketContract, mockDB := setup(t)
+		mockWrappedPocketContract.EXPECT().GetUserNonce(mock.Anything, recipient).Return(big.NewInt(1), nil)
+		expectDiscrepancy(mockDB, "signers")
+
+		mint, err := util.SignMint(context.Background(), &models.Mint{TransactionHash: "hash"}, data, x.domain, x.signer, 2)
+		assert.NoError(t, err)
+
+		verified, err := x.VerifyMint(context.Background(), mint, data)
+
+		assert.NoError(t, err)
+		assert.False(t, verified)
+	})
+
+	t.Run("Mint not signed when verification fails", func(t *testing.T) {
+		x, mockWrappedPocketContract, mockDB := setup(t)
+		mockPoktClient := x.cosmosClient.(*cosmosMocks.MockCosmosClient)
+
+		app.Config.Verification.Enabled = true
+		defer func() { app.Config.Verification.Enabled = false }()
+		app.Config.Pocket.Confirmations = 0
+
+		mint := &models.Mint{
+			TransactionHash:  "hash",
+			SenderAddress:    "abcd",
+			RecipientAddress: recipient.Hex(),
+			Amount:           "20000",
+			Nonce:            "2",
+			RecipientChainID: "31337",
+			Height:           "99",
+		}
+
+		oldCosmosUtilValidateTxToCosmosMultisig := cosmosUtilValidateTxToCosmosMultisig
+		defer func() { cosmosUtilValidateTxToCosmosMultisig = oldCosmosUtilValidateTxToCosmosMultisig }()
+		cosmosUtilValidateTxToCosmosMultisig = func(*sdk.TxResponse, models.CosmosConfig, math.Int, math.Int) *cosmosUtil.ValidateTxResult {
+			return &cosmosUtil.ValidateTxResult{
+				TxValid:       true,
+				SenderAddress: "abcd",
+				Memo:          models.MintMemo{Address: recipient.Hex(), ChainID: "31337"},
+				Amount:        sdk.NewCoin("upokt", math.NewInt(20000)),
+			}
+		}
+
+		mockPoktClient.EXPECT().GetTx(mock.Anything, "hash").Return(&sdk.TxResponse{}, nil)
+		mockWrappedPocketContract.EXPECT().GetUserNonce(mock.Anything, recipient).Return(big.NewInt(2), nil)
+		expectDiscrepancy(mockDB, "nonce")
+
+		err := x.HandleMint(context.Background(), mint)
+
+		assert.NotNil(t, err)
+	})
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
 	"os/signal"
@@ -8,14 +9,19 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/dan13ram/wpokt-validator/app"
+	"github.com/dan13ram/wpokt-validator/cli"
 	"github.com/dan13ram/wpokt-validator/cosmos"
 	"github.com/dan13ram/wpokt-validator/eth"
 	"github.com/dan13ram/wpokt-validator/models"
 	log "github.com/sirupsen/logrus"
 )
 
+// shutdownTimeout bounds how long in-flight runs may take to return after a signal
+const shutdownTimeout = 30 * time.Second
+
 type ServiceFactory = func(*sync.WaitGroup, models.ServiceHealth) app.Service
 
 var ServiceFactoryMap map[string]ServiceFactory = map[string]ServiceFactory{
@@ -61,10 +67,31 @@ func main() {
 		}
 	}
 
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	app.InitConfig(absConfigPath, absEnvPath)
 	app.InitLogger()
 	app.InitDB()
 
+	// the migrate command inspects and applies migrations itself
+	if flag.Arg(0) != "migrate" {
+		app.MigrateDB()
+	}
+
+	if flag.NArg() > 0 {
+		err = cli.Run(ctx, flag.Args())
+		if dbErr := app.DB.Disconnect(); dbErr != nil {
+			log.Error("[MAIN] Error disconnecting from DB: ", dbErr)
+		}
+		if err != nil {
+			log.Fatal("[MAIN] ", err)
+		}
+		return
+	}
+
+	app.InitAuditLog()
+
 	cosmos.ValidateNetwork()
 	eth.ValidateNetwork()
 
@@ -73,7 +100,7 @@ func main() {
 	serviceHealthMap := make(map[string]models.ServiceHealth)
 
 	if app.Config.HealthCheck.ReadLastHealth {
-		if lastHealth, err := healthcheck.FindLastHealth(); err == nil {
+		if lastHealth, err := healthcheck.FindLastHealth(ctx); err == nil {
 			for _, serviceHealth := range lastHealth.ServiceHealths {
 				serviceHealthMap[serviceHealth.Name] = serviceHealth
 			}
@@ -93,21 +120,22 @@ func main() {
 
 	services = append(services, app.NewHealthService(healthcheck, &wg))
 
+	services = append(services, app.NewHttpServer(healthcheck, &wg))
+
+	services = append(services, app.NewGossipServer(&wg))
+
 	healthcheck.SetServices(services)
 
 	wg.Add(len(services))
 
 	for _, service := range services {
-		go service.Start()
+		go service.Start(ctx)
 	}
 
 	log.Info("[MAIN] Server started")
 
-	gracefulStop := make(chan os.Signal, 1)
-	done := make(chan bool, 1)
-	signal.Notify(gracefulStop, syscall.SIGINT, syscall.SIGTERM)
-	go waitForExitSignals(gracefulStop, done)
-	<-done
+	<-ctx.Done()
+	stop()
 
 	log.Debug("[MAIN] Stopping server gracefully")
 
@@ -115,7 +143,17 @@ func main() {
 		service.Stop()
 	}
 
-	wg.Wait()
+	stopped := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		log.Warn("[MAIN] Timed out waiting for services to stop")
+	}
 
 	err = app.DB.Disconnect()
 	if err != nil {
@@ -123,9 +161,3 @@ func main() {
 	}
 	log.Info("[MAIN] Server stopped")
 }
-
-func waitForExitSignals(gracefulStop chan os.Signal, done chan bool) {
-	sig := <-gracefulStop
-	log.Debug("[MAIN] Caught signal: ", sig)
-	done <- true
-}
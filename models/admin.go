@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionAdminActions = "admin_actions"
+)
+
+const (
+	AdminActionFail    = "fail"    // force the document to failed
+	AdminActionRequeue = "requeue" // move the document back to pending and clear its failed attempts
+	AdminActionReset   = "reset"   // clear the signatures and sequence of the document and move it back to pending
+)
+
+// AdminAction records a manual transition of a mint, invalid mint or burn by an operator
+type AdminAction struct {
+	Id              *primitive.ObjectID `bson:"_id,omitempty" json:"_id"`
+	Action          string              `bson:"action" json:"action"`
+	Collection      string              `bson:"collection" json:"collection"`
+	DocumentId      string              `bson:"document_id" json:"document_id"`
+	TransactionHash string              `bson:"transaction_hash" json:"transaction_hash"`
+	FromStatus      string              `bson:"from_status" json:"from_status"`
+	ToStatus        string              `bson:"to_status" json:"to_status"`
+	Reason          string              `bson:"reason" json:"reason"`
+	Operator        string              `bson:"operator" json:"operator"`
+	CreatedAt       time.Time           `bson:"created_at" json:"created_at"`
+}
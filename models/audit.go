@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	CollectionAuditLog = "audit_log"
+)
+
+// AuditEntry records one signature produced by this validator, chained to the previous entry by its hash
+type AuditEntry struct {
+	Index                 int64     `bson:"index" json:"index"`
+	Kind                  string    `bson:"kind" json:"kind"`
+	RequestId             string    `bson:"request_id,omitempty" json:"request_id,omitempty"`
+	Signer                string    `bson:"signer" json:"signer"`
+	Digest                string    `bson:"digest" json:"digest"`
+	Signature             string    `bson:"signature" json:"signature"`
+	SourceTransactionHash string    `bson:"source_transaction_hash" json:"source_transaction_hash"`
+	Memo                  string    `bson:"memo" json:"memo"`
+	Recipient             string    `bson:"recipient" json:"recipient"`
+	Amount                string    `bson:"amount" json:"amount"`
+	Nonce                 string    `bson:"nonce" json:"nonce"`
+	Sequence              string    `bson:"sequence" json:"sequence"`
+	SignedAt              time.Time `bson:"signed_at" json:"signed_at"`
+	PrevHash              string    `bson:"prev_hash" json:"prev_hash"`
+	Hash                  string    `bson:"hash" json:"hash"`
+}
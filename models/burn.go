@@ -20,6 +20,7 @@ type Burn struct {
 	TransactionHash  string              `bson:"transaction_hash" json:"transaction_hash"`
 	LogIndex         string              `bson:"log_index" json:"log_index"`
 	BlockNumber      string              `bson:"block_number" json:"block_number"`
+	BlockHash        string              `bson:"block_hash" json:"block_hash"`
 	Confirmations    string              `bson:"confirmations" json:"confirmations"`
 	SenderAddress    string              `bson:"sender_address" json:"sender_address"`
 	SenderChainID    string              `bson:"sender_chain_id" json:"sender_chain_id"`
@@ -35,4 +36,7 @@ type Burn struct {
 	Signatures            []Signature `json:"signatures" bson:"signatures"`
 	Sequence              *uint64     `json:"sequence" bson:"sequence"` // account sequence for submitting the transaction
 	ReturnTransactionHash string      `json:"return_transaction_hash" bson:"return_transaction_hash"`
+
+	Retries      map[string]Retry `bson:"retries" json:"retries"`             // failure state per validator address
+	FencingToken *int64           `bson:"fencing_token" json:"fencing_token"` // token of the last lock holder that wrote the document
 }
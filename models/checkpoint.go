@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionCheckpoints = "checkpoints"
+)
+
+type Checkpoint struct {
+	Id          *primitive.ObjectID `bson:"_id,omitempty" json:"_id"`
+	ValidatorId string              `bson:"validator_id" json:"validator_id"`
+	Service     string              `bson:"service" json:"service"`
+	Address     string              `bson:"address" json:"address"`
+	Height      int64               `bson:"height" json:"height"`
+	CreatedAt   time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time           `bson:"updated_at" json:"updated_at"`
+}
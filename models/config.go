@@ -3,8 +3,11 @@ package models
 type Config struct {
 	GoogleSecretManager GoogleSecretManagerConfig `yaml:"google_secret_manager" json:"google_secret_manager"`
 	HealthCheck         HealthCheckConfig         `yaml:"health_check" json:"health_check"`
+	HTTPServer          HTTPServerConfig          `yaml:"http_server" json:"http_server"`
 	Logger              LoggerConfig              `yaml:"logger" json:"logger"`
+	DatabaseBackend     string                    `yaml:"database_backend" json:"database_backend"`
 	MongoDB             MongoConfig               `yaml:"mongodb" json:"mongo_db"`
+	Postgres            PostgresConfig            `yaml:"postgres" json:"postgres"`
 	Ethereum            EthereumConfig            `yaml:"ethereum" json:"ethereum"`
 	Pocket              CosmosConfig              `yaml:"pocket" json:"pocket"`
 	MintMonitor         ServiceConfig             `yaml:"mint_monitor" json:"mint_monitor"`
@@ -13,6 +16,11 @@ type Config struct {
 	BurnMonitor         ServiceConfig             `yaml:"burn_monitor" json:"burn_monitor"`
 	BurnSigner          ServiceConfig             `yaml:"burn_signer" json:"burn_signer"`
 	BurnExecutor        ServiceConfig             `yaml:"burn_executor" json:"burn_executor"`
+	SigningPolicy       SigningPolicyConfig       `yaml:"signing_policy" json:"signing_policy"`
+	Verification        VerificationConfig        `yaml:"verification" json:"verification"`
+	Gossip              GossipConfig              `yaml:"gossip" json:"gossip"`
+	AuditLog            AuditLogConfig            `yaml:"audit_log" json:"audit_log"`
+	Retry               RetryConfig               `yaml:"retry" json:"retry"`
 }
 
 type GoogleSecretManagerConfig struct {
@@ -23,8 +31,15 @@ type GoogleSecretManagerConfig struct {
 }
 
 type HealthCheckConfig struct {
-	IntervalMillis int64 `yaml:"interval_ms" json:"interval_ms"`
-	ReadLastHealth bool  `yaml:"read_last_health" json:"read_last_health"`
+	IntervalMillis         int64 `yaml:"interval_ms" json:"interval_ms"`
+	ReadLastHealth         bool  `yaml:"read_last_health" json:"read_last_health"`
+	DegradedAfterFailures  int64 `yaml:"degraded_after_failures" json:"degraded_after_failures"`
+	UnhealthyAfterFailures int64 `yaml:"unhealthy_after_failures" json:"unhealthy_after_failures"`
+}
+
+type HTTPServerConfig struct {
+	Enabled       bool   `yaml:"enabled" json:"enabled"`
+	ListenAddress string `yaml:"listen_address" json:"listen_address"`
 }
 
 type LoggerConfig struct {
@@ -37,16 +52,41 @@ type MongoConfig struct {
 	TimeoutMillis int64  `yaml:"timeout_ms" json:"timeout_ms"`
 }
 
+const (
+	DatabaseMongo    = "mongodb"
+	DatabasePostgres = "postgres"
+)
+
+type PostgresConfig struct {
+	URI           string `yaml:"uri" json:"uri"`
+	TimeoutMillis int64  `yaml:"timeout_ms" json:"timeout_ms"`
+}
+
 type EthereumConfig struct {
-	StartBlockNumber      int64    `yaml:"start_block_number" json:"start_block_number"`
-	Confirmations         int64    `yaml:"confirmations" json:"confirmations"`
-	PrivateKey            string   `yaml:"private_key" json:"private_key"`
-	RPCURL                string   `yaml:"rpc_url" json:"rpcurl"`
-	RPCTimeoutMillis      int64    `yaml:"rpc_timeout_ms" json:"rpc_timeout_ms"`
-	ChainID               string   `yaml:"chain_id" json:"chain_id"`
-	WrappedPocketAddress  string   `yaml:"wrapped_pocket_address" json:"wrapped_pocket_address"`
-	MintControllerAddress string   `yaml:"mint_controller_address" json:"mint_controller_address"`
-	ValidatorAddresses    []string `yaml:"validator_addresses" json:"validator_addresses"`
+	StartBlockNumber      int64                    `yaml:"start_block_number" json:"start_block_number"`
+	Confirmations         int64                    `yaml:"confirmations" json:"confirmations"`
+	PrivateKey            string                   `yaml:"private_key" json:"private_key"`
+	Mnemonic              string                   `yaml:"mnemonic" json:"mnemonic"`
+	GcpKmsKeyName         string                   `yaml:"gcp_kms_key_name" json:"gcp_kms_key_name"`
+	RemoteSignerSocket    string                   `yaml:"remote_signer_socket" json:"remote_signer_socket"`
+	RemoteSignerToken     string                   `yaml:"remote_signer_token" json:"remote_signer_token"`
+	RPCURL                string                   `yaml:"rpc_url" json:"rpcurl"`
+	RPCTimeoutMillis      int64                    `yaml:"rpc_timeout_ms" json:"rpc_timeout_ms"`
+	RPCRateLimit          float64                  `yaml:"rpc_rate_limit" json:"rpc_rate_limit"`
+	RPCEndpoints          []EthereumEndpointConfig `yaml:"rpc_endpoints" json:"rpc_endpoints"`
+	Quorum                int64                    `yaml:"quorum" json:"quorum"`
+	WSURL                 string                   `yaml:"ws_url" json:"ws_url"`
+	WSURLs                []string                 `yaml:"ws_urls" json:"ws_urls"`
+	ChainID               string                   `yaml:"chain_id" json:"chain_id"`
+	WrappedPocketAddress  string                   `yaml:"wrapped_pocket_address" json:"wrapped_pocket_address"`
+	MintControllerAddress string                   `yaml:"mint_controller_address" json:"mint_controller_address"`
+	ValidatorAddresses    []string                 `yaml:"validator_addresses" json:"validator_addresses"`
+}
+
+type EthereumEndpointConfig struct {
+	URL           string  `yaml:"url" json:"url"`
+	TimeoutMillis int64   `yaml:"timeout_ms" json:"timeout_ms"`
+	RateLimit     float64 `yaml:"rate_limit" json:"rate_limit"`
 }
 
 type CosmosConfig struct {
@@ -54,10 +94,15 @@ type CosmosConfig struct {
 	Confirmations      int64    `yaml:"confirmations" json:"confirmations"`
 	Mnemonic           string   `yaml:"mnemonic" json:"mnemonic"`
 	GcpKmsKeyName      string   `yaml:"gcp_kms_key_name" json:"gcp_kms_key_name"`
+	RemoteSignerSocket string   `yaml:"remote_signer_socket" json:"remote_signer_socket"`
+	RemoteSignerToken  string   `yaml:"remote_signer_token" json:"remote_signer_token"`
 	RPCURL             string   `yaml:"rpc_url" json:"rpcurl"`
+	RPCURLs            []string `yaml:"rpc_urls" json:"rpc_urls"`
 	GRPCEnabled        bool     `yaml:"grpc_enabled" json:"grpc_enabled"`
 	GRPCHost           string   `yaml:"grpc_host" json:"grpc_host"`
 	GRPCPort           uint64   `yaml:"grpc_port" json:"grpc_port"`
+	GRPCEndpoints      []string `yaml:"grpc_endpoints" json:"grpc_endpoints"`
+	Quorum             int64    `yaml:"quorum" json:"quorum"`
 	RPCTimeoutMillis   int64    `yaml:"rpc_timeout_ms" json:"rpc_timeout_ms"`
 	ChainID            string   `yaml:"chain_id" json:"chain_id"`
 	TxFee              int64    `yaml:"tx_fee" json:"tx_fee"`
@@ -67,9 +112,66 @@ type CosmosConfig struct {
 	MultisigPublicKeys []string `yaml:"multisig_public_keys" json:"multisig_public_keys"`
 	MultisigThreshold  uint64   `yaml:"multisig_threshold" json:"multisig_threshold"`
 	MintDisabled       bool     `yaml:"mint_disabled" json:"mint_disabled"`
+	ScanMode           string   `yaml:"scan_mode" json:"scan_mode"`
+	ScanMaxBlocks      int64    `yaml:"scan_max_blocks" json:"scan_max_blocks"`
+	Subscribe          bool     `yaml:"subscribe" json:"subscribe"`
 }
 
+const (
+	// ScanModeTxSearch finds transfers to the vault with a tx_search query, which requires tx indexing on the node
+	ScanModeTxSearch = "tx_search"
+	// ScanModeBlocks finds transfers to the vault by reading every block and its results
+	ScanModeBlocks = "blocks"
+)
+
 type ServiceConfig struct {
 	Enabled        bool  `yaml:"enabled" json:"enabled"`
 	IntervalMillis int64 `yaml:"interval_ms" json:"interval_ms"`
 }
+
+type SigningPolicyConfig struct {
+	Paused    bool                `yaml:"paused" json:"paused"`
+	PauseFile string              `yaml:"pause_file" json:"pause_file"`
+	Mint      SigningLimitsConfig `yaml:"mint" json:"mint"`
+	Refund    SigningLimitsConfig `yaml:"refund" json:"refund"`
+}
+
+// SigningLimitsConfig amounts are in the smallest denomination, 0 means no limit
+type SigningLimitsConfig struct {
+	MaxAmount                 int64    `yaml:"max_amount" json:"max_amount"`
+	MaxAmountPerHour          int64    `yaml:"max_amount_per_hour" json:"max_amount_per_hour"`
+	MaxAmountPerDay           int64    `yaml:"max_amount_per_day" json:"max_amount_per_day"`
+	MaxRecipientAmountPerHour int64    `yaml:"max_recipient_amount_per_hour" json:"max_recipient_amount_per_hour"`
+	MaxRecipientAmountPerDay  int64    `yaml:"max_recipient_amount_per_day" json:"max_recipient_amount_per_day"`
+	AllowedRecipients         []string `yaml:"allowed_recipients" json:"allowed_recipients"`
+	DeniedRecipients          []string `yaml:"denied_recipients" json:"denied_recipients"`
+	AllowedSenders            []string `yaml:"allowed_senders" json:"allowed_senders"`
+	DeniedSenders             []string `yaml:"denied_senders" json:"denied_senders"`
+}
+
+type VerificationConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+}
+
+// GossipConfig enables the per-validator database mode, where signatures are exchanged with peers instead of a shared database
+type GossipConfig struct {
+	Enabled       bool     `yaml:"enabled" json:"enabled"`
+	ListenAddress string   `yaml:"listen_address" json:"listen_address"`
+	TLSCertFile   string   `yaml:"tls_cert_file" json:"tls_cert_file"`
+	TLSKeyFile    string   `yaml:"tls_key_file" json:"tls_key_file"`
+	TLSCAFile     string   `yaml:"tls_ca_file" json:"tls_ca_file"`
+	Peers         []string `yaml:"peers" json:"peers"`
+	TimeoutMillis int64    `yaml:"timeout_ms" json:"timeout_ms"`
+}
+
+type AuditLogConfig struct {
+	File  string `yaml:"file" json:"file"`
+	Mongo bool   `yaml:"mongo" json:"mongo"`
+}
+
+// RetryConfig controls how mints, invalid mints and burns that fail to be handled are retried
+type RetryConfig struct {
+	MaxAttempts      int64 `yaml:"max_attempts" json:"max_attempts"`
+	BackoffMillis    int64 `yaml:"backoff_ms" json:"backoff_ms"`
+	MaxBackoffMillis int64 `yaml:"max_backoff_ms" json:"max_backoff_ms"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionDeposits = "deposits"
+)
+
+// Deposit marks a deposit to the vault as stored, with the collection it was stored in.
+// It is unique by transaction hash and written with the mint or invalid mint,
+// so that concurrent validators cannot store the same deposit with different classifications.
+type Deposit struct {
+	Id              *primitive.ObjectID `bson:"_id,omitempty" json:"_id"`
+	TransactionHash string              `bson:"transaction_hash" json:"transaction_hash"`
+	Collection      string              `bson:"collection" json:"collection"`
+	CreatedAt       time.Time           `bson:"created_at" json:"created_at"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionDiscrepancies = "discrepancies"
+)
+
+// Discrepancy records a stored field that does not match what the validator rebuilt from chain data
+type Discrepancy struct {
+	Id              *primitive.ObjectID `bson:"_id,omitempty" json:"_id"`
+	ValidatorId     string              `bson:"validator_id" json:"validator_id"`
+	Service         string              `bson:"service" json:"service"`
+	Collection      string              `bson:"collection" json:"collection"`
+	TransactionHash string              `bson:"transaction_hash" json:"transaction_hash"`
+	Field           string              `bson:"field" json:"field"`
+	Expected        string              `bson:"expected" json:"expected"`
+	Stored          string              `bson:"stored" json:"stored"`
+	Count           int64               `bson:"count" json:"count"`
+	CreatedAt       time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt       time.Time           `bson:"updated_at" json:"updated_at"`
+}
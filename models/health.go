@@ -10,6 +10,14 @@ const (
 	CollectionHealthChecks = "healthchecks"
 )
 
+type ServiceState string
+
+const (
+	ServiceStateHealthy   ServiceState = "healthy"
+	ServiceStateDegraded  ServiceState = "degraded"
+	ServiceStateUnhealthy ServiceState = "unhealthy"
+)
+
 type Health struct {
 	Id               *primitive.ObjectID `bson:"_id,omitempty" json:"_id"`
 	PoktVaultAddress string              `bson:"pokt_vault_address" json:"pokt_vault_address"`
@@ -26,6 +34,7 @@ type Health struct {
 	UpdatedAt        time.Time           `bson:"updated_at" json:"updated_at"`
 	ServiceHealths   []ServiceHealth     `bson:"service_healths" json:"service_healths"`
 	MintDisabled     bool                `bson:"mint_disabled" json:"mint_disabled"`
+	NeedsAttention   map[string]int64    `bson:"needs_attention" json:"needs_attention"` // documents per collection that are no longer retried
 }
 
 type ServiceHealth struct {
@@ -33,11 +42,33 @@ type ServiceHealth struct {
 	Healthy        bool      `bson:"healthy" json:"healthy"`
 	EthBlockNumber string    `bson:"eth_block_number" json:"eth_block_number"` // not used for all services
 	PoktHeight     string    `bson:"pokt_height" json:"pokt_height"`           // not used for all services
+	FailedRange    string    `bson:"failed_range" json:"failed_range"`         // not used for all services
 	LastSyncTime   time.Time `bson:"last_sync_time" json:"last_sync_time"`
 	NextSyncTime   time.Time `bson:"next_sync_time" json:"next_sync_time"`
+
+	State               ServiceState `bson:"state" json:"state"`
+	ConsecutiveFailures int64        `bson:"consecutive_failures" json:"consecutive_failures"`
+	ErrorCount          int64        `bson:"error_count" json:"error_count"`
+	LastError           string       `bson:"last_error" json:"last_error"`
+	LastErrorTime       time.Time    `bson:"last_error_time" json:"last_error_time"`
+
+	Endpoints []EndpointHealth `bson:"endpoints,omitempty" json:"endpoints,omitempty"` // only for services with several chain endpoints
+}
+
+// EndpointHealth is a snapshot of the health of one chain endpoint of a service
+type EndpointHealth struct {
+	Chain     string  `bson:"chain" json:"chain"`
+	Name      string  `bson:"name" json:"name"`
+	Healthy   bool    `bson:"healthy" json:"healthy"`
+	Score     float64 `bson:"score" json:"score"` // share of recent requests that succeeded, recent requests weigh more
+	Failures  int     `bson:"failures" json:"failures"`
+	LastError string  `bson:"last_error" json:"last_error"`
 }
 
 type RunnerStatus struct {
 	EthBlockNumber string `bson:"eth_block_number" json:"eth_block_number"`
 	PoktHeight     string `bson:"pokt_height" json:"pokt_height"`
+	FailedRange    string `bson:"failed_range" json:"failed_range"` // "<start>-<end>" range that is retried on the next run
+
+	Endpoints []EndpointHealth `bson:"endpoints,omitempty" json:"endpoints,omitempty"`
 }
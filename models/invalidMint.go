@@ -28,4 +28,7 @@ type InvalidMint struct {
 	Signatures            []Signature `json:"signatures" bson:"signatures"`
 	Sequence              *uint64     `json:"sequence" bson:"sequence"` // account sequence for submitting the transaction
 	ReturnTransactionHash string      `json:"return_transaction_hash" bson:"return_transaction_hash"`
+
+	Retries      map[string]Retry `bson:"retries" json:"retries"`             // failure state per validator address
+	FencingToken *int64           `bson:"fencing_token" json:"fencing_token"` // token of the last lock holder that wrote the document
 }
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	CollectionSchemaMigrations = "schema_migrations"
+)
+
+// Migration is a numbered change to the database schema, applying it again has no effect
+type Migration struct {
+	Version     int64  `bson:"version" json:"version"`
+	Description string `bson:"description" json:"description"`
+}
+
+type SchemaMigration struct {
+	Version     int64     `bson:"version" json:"version"`
+	Description string    `bson:"description" json:"description"`
+	AppliedAt   time.Time `bson:"applied_at" json:"applied_at"`
+}
@@ -31,6 +31,12 @@ type Mint struct {
 	Signers             []string            `bson:"signers" json:"signers"`
 	Signatures          []string            `bson:"signatures" json:"signatures"`
 	MintTransactionHash string              `bson:"mint_transaction_hash" json:"mint_transaction_hash"`
+	MintBlockNumber     string              `bson:"mint_block_number" json:"mint_block_number"`
+	MintBlockHash       string              `bson:"mint_block_hash" json:"mint_block_hash"`
+	MintFinalized       bool                `bson:"mint_finalized" json:"mint_finalized"`
+
+	Retries      map[string]Retry `bson:"retries" json:"retries"`             // failure state per validator address
+	FencingToken *int64           `bson:"fencing_token" json:"fencing_token"` // token of the last lock holder that wrote the document
 }
 
 type MintMemo struct {
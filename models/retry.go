@@ -0,0 +1,13 @@
+package models
+
+import (
+	"time"
+)
+
+// Retry is the failure state of one validator handling a mint, invalid mint or burn
+type Retry struct {
+	Attempts       int64      `bson:"attempts" json:"attempts"` // consecutive failed attempts of the validator
+	LastError      string     `bson:"last_error" json:"last_error"`
+	NextRetryAt    *time.Time `bson:"next_retry_at" json:"next_retry_at"`
+	NeedsAttention bool       `bson:"needs_attention" json:"needs_attention"` // the validator gave up on the document
+}
@@ -0,0 +1,6 @@
+package models
+
+const (
+	// CollectionSigningLedger held the signing ledger before it was kept by each validator, it is only dropped by a migration
+	CollectionSigningLedger = "signing_ledger"
+)
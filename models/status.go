@@ -7,4 +7,7 @@ const (
 	StatusSubmitted = "submitted"
 	StatusSuccess   = "success"
 	StatusFailed    = "failed"
+	StatusOrphaned  = "orphaned" // the ethereum block of the event is no longer canonical
+
+	StatusNeedsAttention = "needs_attention" // failed to be handled too many times, no longer retried
 )
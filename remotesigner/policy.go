@@ -0,0 +1,133 @@
+package remotesigner
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	mintDataPrimaryType = "MintData"
+	domainType          = "EIP712Domain"
+	cosmosMsgSendType   = "cosmos-sdk/MsgSend"
+)
+
+var mintDataFields = []apitypes.Type{
+	{Name: "recipient", Type: "address"},
+	{Name: "amount", Type: "uint256"},
+	{Name: "nonce", Type: "uint256"},
+}
+
+var domainFields = []apitypes.Type{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "address"},
+}
+
+// Policy restricts what the signer daemon is willing to sign
+type Policy struct {
+	EthChainId            string
+	MintControllerAddress string
+	EthDomainName         string
+	EthDomainVersion      string
+	CosmosChainId         string
+	VaultAddress          string
+}
+
+type cosmosSignDoc struct {
+	ChainId string          `json:"chain_id"`
+	Msgs    []cosmosSignMsg `json:"msgs"`
+}
+
+type cosmosSignMsg struct {
+	Type  string `json:"type"`
+	Value struct {
+		FromAddress string `json:"from_address"`
+	} `json:"value"`
+}
+
+func checkTypeDefinition(types apitypes.Types, name string, expected []apitypes.Type) error {
+	fields := types[name]
+	if len(fields) != len(expected) {
+		return fmt.Errorf("unexpected %s type definition", name)
+	}
+	for i, field := range fields {
+		if field.Name != expected[i].Name || field.Type != expected[i].Type {
+			return fmt.Errorf("unexpected %s type definition", name)
+		}
+	}
+	return nil
+}
+
+// CheckTypedData only allows EIP-712 MintData for the configured mint controller,
+// with exactly the domain and types the validator signs
+func (p Policy) CheckTypedData(typedData apitypes.TypedData) error {
+	if typedData.PrimaryType != mintDataPrimaryType {
+		return fmt.Errorf("primary type %q is not allowed", typedData.PrimaryType)
+	}
+
+	if len(typedData.Types) != 2 {
+		return fmt.Errorf("only %s and %s types are allowed", domainType, mintDataPrimaryType)
+	}
+	if err := checkTypeDefinition(typedData.Types, domainType, domainFields); err != nil {
+		return err
+	}
+	if err := checkTypeDefinition(typedData.Types, mintDataPrimaryType, mintDataFields); err != nil {
+		return err
+	}
+
+	if typedData.Domain.Name != p.EthDomainName {
+		return fmt.Errorf("domain name %q is not allowed", typedData.Domain.Name)
+	}
+	if typedData.Domain.Version != p.EthDomainVersion {
+		return fmt.Errorf("domain version %q is not allowed", typedData.Domain.Version)
+	}
+	if typedData.Domain.Salt != "" {
+		return fmt.Errorf("domain salt is not allowed")
+	}
+
+	if !strings.EqualFold(typedData.Domain.VerifyingContract, p.MintControllerAddress) {
+		return fmt.Errorf("verifying contract %s is not allowed", typedData.Domain.VerifyingContract)
+	}
+
+	chainId, ok := new(big.Int).SetString(p.EthChainId, 10)
+	if !ok {
+		return fmt.Errorf("invalid policy chain id: %s", p.EthChainId)
+	}
+	if typedData.Domain.ChainId == nil || (*big.Int)(typedData.Domain.ChainId).Cmp(chainId) != 0 {
+		return fmt.Errorf("chain id is not allowed")
+	}
+
+	return nil
+}
+
+// CheckCosmosSignBytes only allows amino JSON sign docs of MsgSend from the vault
+func (p Policy) CheckCosmosSignBytes(data []byte) error {
+	var doc cosmosSignDoc
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("sign bytes are not an amino json sign doc: %w", err)
+	}
+
+	if doc.ChainId != p.CosmosChainId {
+		return fmt.Errorf("chain id %q is not allowed", doc.ChainId)
+	}
+
+	if len(doc.Msgs) == 0 {
+		return fmt.Errorf("sign doc has no messages")
+	}
+
+	for i, msg := range doc.Msgs {
+		if msg.Type != cosmosMsgSendType {
+			return fmt.Errorf("message %d of type %q is not allowed", i, msg.Type)
+		}
+		if !strings.EqualFold(msg.Value.FromAddress, p.VaultAddress) {
+			return fmt.Errorf("message %d is not sent from the vault", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package remotesigner
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testMintController = "0x8ec8384b9cd40f596a609180c9452384d523cf4d"
+	testVaultAddress   = "pokt10r5n6x28p9qntchsmhxd4ftq9lk6vzcx3dv4gx"
+)
+
+var testPolicy = Policy{
+	EthChainId:            "1",
+	MintControllerAddress: testMintController,
+	EthDomainName:         "MintController",
+	EthDomainVersion:      "1",
+	CosmosChainId:         "poktroll",
+	VaultAddress:          testVaultAddress,
+}
+
+func newTestTypedData() apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"MintData": mintDataFields,
+		},
+		PrimaryType: "MintData",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "MintController",
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(1),
+			VerifyingContract: testMintController,
+		},
+		Message: apitypes.TypedDataMessage{
+			"recipient": "0x0000000000000000000000000000000000000001",
+			"amount":    "100",
+			"nonce":     "1",
+		},
+	}
+}
+
+func newTestSignDoc(chainId string, msgType string, from string) []byte {
+	return []byte(fmt.Sprintf(`{"account_number":"1","chain_id":%q,"fee":{"amount":[{"amount":"10","denom":"upokt"}],"gas":"200000"},"memo":"memo","msgs":[{"type":%q,"value":{"amount":[{"amount":"99990","denom":"upokt"}],"from_address":%q,"to_address":"pokt1qyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqh5cfrx"}}],"sequence":"2"}`, chainId, msgType, from))
+}
+
+func TestPolicyCheckTypedData(t *testing.T) {
+	t.Run("Valid mint data", func(t *testing.T) {
+		assert.NoError(t, testPolicy.CheckTypedData(newTestTypedData()))
+	})
+
+	t.Run("Other primary type", func(t *testing.T) {
+		typedData := newTestTypedData()
+		typedData.PrimaryType = "EIP712Domain"
+		assert.Error(t, testPolicy.CheckTypedData(typedData))
+	})
+
+	t.Run("Modified type definition", func(t *testing.T) {
+		typedData := newTestTypedData()
+		typedData.Types["MintData"] = []apitypes.Type{{Name: "recipient", Type: "address"}, {Name: "amount", Type: "uint256"}}
+		assert.Error(t, testPolicy.CheckTypedData(typedData))
+	})
+
+	t.Run("Extra type", func(t *testing.T) {
+		typedData := newTestTypedData()
+		typedData.Types["Extra"] = []apitypes.Type{{Name: "data", Type: "bytes"}}
+		assert.Error(t, testPolicy.CheckTypedData(typedData))
+	})
+
+	t.Run("Modified domain type definition", func(t *testing.T) {
+		typedData := newTestTypedData()
+		typedData.Types["EIP712Domain"] = append(typedData.Types["EIP712Domain"], apitypes.Type{Name: "salt", Type: "bytes32"})
+		assert.Error(t, testPolicy.CheckTypedData(typedData))
+	})
+
+	t.Run("Other domain name", func(t *testing.T) {
+		typedData := newTestTypedData()
+		typedData.Domain.Name = "OtherController"
+		assert.Error(t, testPolicy.CheckTypedData(typedData))
+	})
+
+	t.Run("Other domain version", func(t *testing.T) {
+		typedData := newTestTypedData()
+		typedData.Domain.Version = "2"
+		assert.Error(t, testPolicy.CheckTypedData(typedData))
+	})
+
+	t.Run("Domain salt", func(t *testing.T) {
+		typedData := newTestTypedData()
+		typedData.Domain.Salt = "0x01"
+		assert.Error(t, testPolicy.CheckTypedData(typedData))
+	})
+
+	t.Run("Other verifying contract", func(t *testing.T) {
+		typedData := newTestTypedData()
+		typedData.Domain.VerifyingContract = "0x0000000000000000000000000000000000000002"
+		assert.Error(t, testPolicy.CheckTypedData(typedData))
+	})
+
+	t.Run("Other chain id", func(t *testing.T) {
+		typedData := newTestTypedData()
+		typedData.Domain.ChainId = math.NewHexOrDecimal256(5)
+		assert.Error(t, testPolicy.CheckTypedData(typedData))
+	})
+}
+
+func TestPolicyCheckCosmosSignBytes(t *testing.T) {
+	t.Run("Send from vault", func(t *testing.T) {
+		assert.NoError(t, testPolicy.CheckCosmosSignBytes(newTestSignDoc("poktroll", "cosmos-sdk/MsgSend", testVaultAddress)))
+	})
+
+	t.Run("Send from other address", func(t *testing.T) {
+		assert.Error(t, testPolicy.CheckCosmosSignBytes(newTestSignDoc("poktroll", "cosmos-sdk/MsgSend", "pokt1qyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqh5cfrx")))
+	})
+
+	t.Run("Other message type", func(t *testing.T) {
+		assert.Error(t, testPolicy.CheckCosmosSignBytes(newTestSignDoc("poktroll", "cosmos-sdk/MsgMultiSend", testVaultAddress)))
+	})
+
+	t.Run("Other chain id", func(t *testing.T) {
+		assert.Error(t, testPolicy.CheckCosmosSignBytes(newTestSignDoc("pocket", "cosmos-sdk/MsgSend", testVaultAddress)))
+	})
+
+	t.Run("Not a sign doc", func(t *testing.T) {
+		assert.Error(t, testPolicy.CheckCosmosSignBytes([]byte{0x0a, 0x01}))
+	})
+
+	t.Run("No messages", func(t *testing.T) {
+		assert.Error(t, testPolicy.CheckCosmosSignBytes([]byte(`{"chain_id":"poktroll","msgs":[]}`)))
+	})
+}
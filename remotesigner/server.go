@@ -0,0 +1,165 @@
+package remotesigner
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/dan13ram/wpokt-validator/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	log "github.com/sirupsen/logrus"
+)
+
+const maxRequestBytes = 1 << 20
+
+// Server signs requests from the validator with keys that never leave this process
+type Server struct {
+	ethSigner    common.Signer
+	cosmosSigner common.Signer
+	token        string
+	policy       Policy
+}
+
+func NewServer(ethSigner common.Signer, cosmosSigner common.Signer, token string, policy Policy) *Server {
+	return &Server{
+		ethSigner:    ethSigner,
+		cosmosSigner: cosmosSigner,
+		token:        token,
+		policy:       policy,
+	}
+}
+
+func (x *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+common.RemoteSignerPathKeys, x.handleKeys)
+	mux.HandleFunc("POST "+common.RemoteSignerPathEthSign, x.handleEthSign)
+	mux.HandleFunc("POST "+common.RemoteSignerPathEthSignTyped, x.handleEthSignTypedData)
+	mux.HandleFunc("POST "+common.RemoteSignerPathCosmosSign, x.handleCosmosSign)
+	return x.authenticate(mux)
+}
+
+func (x *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), common.RemoteSignerAuthorizationType)
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(x.token)) != 1 {
+			writeError(w, http.StatusUnauthorized, "invalid token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (x *Server) handleKeys(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, common.RemoteSignerKeys{
+		EthAddress:      x.ethSigner.EthAddress().Hex(),
+		CosmosPublicKey: hex.EncodeToString(x.cosmosSigner.CosmosPublicKey().Bytes()),
+	})
+}
+
+// handleEthSign rejects raw digests since the policy cannot inspect them
+func (x *Server) handleEthSign(w http.ResponseWriter, r *http.Request) {
+	log.Warn("[REMOTE SIGNER] Rejected raw ethereum signing request")
+	writeError(w, http.StatusForbidden, "raw ethereum signing is not allowed, use typed data")
+}
+
+func (x *Server) handleEthSignTypedData(w http.ResponseWriter, r *http.Request) {
+	req, ok := readRequest(w, r)
+	if !ok {
+		return
+	}
+	if req.TypedData == nil {
+		writeError(w, http.StatusBadRequest, "typed data is required")
+		return
+	}
+
+	if err := x.policy.CheckTypedData(*req.TypedData); err != nil {
+		log.Warn("[REMOTE SIGNER] Rejected typed data: ", err)
+		writeError(w, http.StatusForbidden, err.Error())
+		return
+	}
+
+	sighash, _, err := apitypes.TypedDataAndHash(*req.TypedData)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("error hashing typed data: %s", err))
+		return
+	}
+
+	signature, err := x.ethSigner.EthSign(r.Context(), sighash)
+	if err != nil {
+		log.Error("[REMOTE SIGNER] Error signing typed data: ", err)
+		writeError(w, http.StatusInternalServerError, "error signing typed data")
+		return
+	}
+
+	log.Info("[REMOTE SIGNER] Signed mint data for recipient ", req.TypedData.Message["recipient"])
+	writeJSON(w, http.StatusOK, common.RemoteSignResponse{Signature: signature})
+}
+
+func (x *Server) handleCosmosSign(w http.ResponseWriter, r *http.Request) {
+	req, ok := readRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := x.policy.CheckCosmosSignBytes(req.Data); err != nil {
+		log.Warn("[REMOTE SIGNER] Rejected cosmos sign bytes: ", err)
+		writeError(w, http.StatusForbidden, err.Error())
+		return
+	}
+
+	signature, err := x.cosmosSigner.CosmosSign(r.Context(), req.Data)
+	if err != nil {
+		log.Error("[REMOTE SIGNER] Error signing cosmos tx: ", err)
+		writeError(w, http.StatusInternalServerError, "error signing cosmos tx")
+		return
+	}
+
+	log.Info("[REMOTE SIGNER] Signed cosmos tx")
+	writeJSON(w, http.StatusOK, common.RemoteSignResponse{Signature: signature})
+}
+
+func readRequest(w http.ResponseWriter, r *http.Request) (common.RemoteSignRequest, bool) {
+	var req common.RemoteSignRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return req, false
+	}
+	return req, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Error("[REMOTE SIGNER] Error encoding response: ", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, common.RemoteSignResponse{Error: message})
+}
+
+// Listen creates the unix socket, replacing a stale one, readable only by the current user
+func Listen(socketPath string) (net.Listener, error) {
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error removing stale socket: %w", err)
+	}
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("error listening on socket: %w", err)
+	}
+
+	if err := os.Chmod(socketPath, 0600); err != nil {
+		//nolint:errcheck
+		listener.Close()
+		return nil, fmt.Errorf("error setting socket permissions: %w", err)
+	}
+
+	return listener, nil
+}
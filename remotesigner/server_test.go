@@ -0,0 +1,193 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/dan13ram/wpokt-validator/common"
+	"github.com/dan13ram/wpokt-validator/eth/autogen"
+	eth "github.com/dan13ram/wpokt-validator/eth/client"
+	"github.com/dan13ram/wpokt-validator/eth/util"
+	"github.com/dan13ram/wpokt-validator/models"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testToken      = "secret"
+	testPrivateKey = "1395eeb9c36ef43e9e05692c9ee34034c00a9bef301135a96d082b2a65fd1680"
+	testMnemonic   = "test test test test test test test test test test test junk"
+)
+
+func init() {
+	log.SetOutput(io.Discard)
+}
+
+func newTestServer(t *testing.T) (*Server, common.Signer, common.Signer) {
+	ethSigner, err := common.NewPrivateKeySigner(testPrivateKey)
+	assert.NoError(t, err)
+	cosmosSigner, err := common.NewMnemonicSigner(testMnemonic)
+	assert.NoError(t, err)
+	return NewServer(ethSigner, cosmosSigner, testToken, testPolicy), ethSigner, cosmosSigner
+}
+
+func doRequest(handler http.Handler, method string, path string, token string, body interface{}) (*httptest.ResponseRecorder, common.RemoteSignResponse) {
+	var reqBody bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&reqBody).Encode(body)
+	}
+	req := httptest.NewRequest(method, path, &reqBody)
+	req.Header.Set("Authorization", common.RemoteSignerAuthorizationType+token)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var res common.RemoteSignResponse
+	_ = json.Unmarshal(rec.Body.Bytes(), &res)
+	return rec, res
+}
+
+func TestServerHandler(t *testing.T) {
+	server, ethSigner, cosmosSigner := newTestServer(t)
+	handler := server.Handler()
+
+	t.Run("Invalid token", func(t *testing.T) {
+		rec, res := doRequest(handler, http.MethodGet, common.RemoteSignerPathKeys, "wrong", nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, "invalid token", res.Error)
+	})
+
+	t.Run("Token without bearer scheme", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, common.RemoteSignerPathKeys, nil)
+		req.Header.Set("Authorization", testToken)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("Keys", func(t *testing.T) {
+		rec, _ := doRequest(handler, http.MethodGet, common.RemoteSignerPathKeys, testToken, nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var keys common.RemoteSignerKeys
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &keys))
+		assert.Equal(t, ethSigner.EthAddress().Hex(), keys.EthAddress)
+		assert.Equal(t, hex.EncodeToString(cosmosSigner.CosmosPublicKey().Bytes()), keys.CosmosPublicKey)
+	})
+
+	t.Run("Raw ethereum signing is rejected", func(t *testing.T) {
+		rec, res := doRequest(handler, http.MethodPost, common.RemoteSignerPathEthSign, testToken, common.RemoteSignRequest{Data: []byte("data")})
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Empty(t, res.Signature)
+	})
+
+	t.Run("Typed data is signed", func(t *testing.T) {
+		typedData := newTestTypedData()
+		rec, res := doRequest(handler, http.MethodPost, common.RemoteSignerPathEthSignTyped, testToken, common.RemoteSignRequest{TypedData: &typedData})
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		sighash, _, err := apitypes.TypedDataAndHash(typedData)
+		assert.NoError(t, err)
+		res.Signature[64] -= 27
+		pubKey, err := crypto.SigToPub(sighash, res.Signature)
+		assert.NoError(t, err)
+		assert.Equal(t, ethSigner.EthAddress(), crypto.PubkeyToAddress(*pubKey))
+	})
+
+	t.Run("Typed data rejected by policy", func(t *testing.T) {
+		typedData := newTestTypedData()
+		typedData.Domain.VerifyingContract = "0x0000000000000000000000000000000000000002"
+		rec, res := doRequest(handler, http.MethodPost, common.RemoteSignerPathEthSignTyped, testToken, common.RemoteSignRequest{TypedData: &typedData})
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Empty(t, res.Signature)
+	})
+
+	t.Run("Typed data missing", func(t *testing.T) {
+		rec, _ := doRequest(handler, http.MethodPost, common.RemoteSignerPathEthSignTyped, testToken, common.RemoteSignRequest{})
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Cosmos send from vault is signed", func(t *testing.T) {
+		data := newTestSignDoc("poktroll", "cosmos-sdk/MsgSend", testVaultAddress)
+		rec, res := doRequest(handler, http.MethodPost, common.RemoteSignerPathCosmosSign, testToken, common.RemoteSignRequest{Data: data})
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.True(t, cosmosSigner.CosmosPublicKey().VerifySignature(data, res.Signature))
+	})
+
+	t.Run("Cosmos sign rejected by policy", func(t *testing.T) {
+		data := newTestSignDoc("poktroll", "cosmos-sdk/MsgSend", "pokt1qyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqh5cfrx")
+		rec, _ := doRequest(handler, http.MethodPost, common.RemoteSignerPathCosmosSign, testToken, common.RemoteSignRequest{Data: data})
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
+func TestRemoteSigner(t *testing.T) {
+	server, ethSigner, cosmosSigner := newTestServer(t)
+
+	socketPath := filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := Listen(socketPath)
+	assert.NoError(t, err)
+
+	httpServer := &http.Server{Handler: server.Handler()}
+	go func() {
+		_ = httpServer.Serve(listener)
+	}()
+	defer httpServer.Close()
+
+	t.Run("Invalid token", func(t *testing.T) {
+		_, err := common.NewRemoteSigner(socketPath, "wrong")
+		assert.Error(t, err)
+	})
+
+	remoteSigner, err := common.NewRemoteSigner(socketPath, testToken)
+	assert.NoError(t, err)
+	defer remoteSigner.Destroy()
+
+	t.Run("Keys", func(t *testing.T) {
+		assert.Equal(t, ethSigner.EthAddress(), remoteSigner.EthAddress())
+		assert.True(t, cosmosSigner.CosmosPublicKey().Equals(remoteSigner.CosmosPublicKey()))
+	})
+
+	t.Run("Mint signature matches local signer", func(t *testing.T) {
+		domain := eth.DomainData{
+			Name:              "MintController",
+			Version:           "1",
+			ChainId:           big.NewInt(1),
+			VerifyingContract: ethcommon.HexToAddress(testMintController),
+		}
+		data := &autogen.MintControllerMintData{
+			Recipient: ethcommon.HexToAddress("0x0000000000000000000000000000000000000001"),
+			Amount:    big.NewInt(100),
+			Nonce:     big.NewInt(1),
+		}
+
+		local, err := util.SignMint(context.Background(), &models.Mint{}, data, domain, ethSigner, 1)
+		assert.NoError(t, err)
+		remote, err := util.SignMint(context.Background(), &models.Mint{}, data, domain, remoteSigner, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, local.Signatures, remote.Signatures)
+		assert.Equal(t, local.Signers, remote.Signers)
+	})
+
+	t.Run("Raw ethereum signing is rejected", func(t *testing.T) {
+		_, err := remoteSigner.EthSign(context.Background(), []byte("data"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Cosmos sign", func(t *testing.T) {
+		data := newTestSignDoc("poktroll", "cosmos-sdk/MsgSend", testVaultAddress)
+		signature, err := remoteSigner.CosmosSign(context.Background(), data)
+		assert.NoError(t, err)
+		assert.True(t, remoteSigner.CosmosPublicKey().VerifySignature(data, signature))
+	})
+}
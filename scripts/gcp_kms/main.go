@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -39,14 +40,14 @@ func main() {
 	txData := []byte("example transaction data")
 
 	// Ethereum
-	ethSignature, err := signer.EthSign(txData)
+	ethSignature, err := signer.EthSign(context.Background(), txData)
 	if err != nil {
 		log.Fatalf("failed to sign Ethereum hash: %v", err)
 	}
 	fmt.Printf("Ethereum Signature: %x\n", ethSignature)
 
 	// Cosmos
-	cosmosSignature, err := signer.CosmosSign(txData)
+	cosmosSignature, err := signer.CosmosSign(context.Background(), txData)
 	if err != nil {
 		log.Fatalf("failed to sign Cosmos hash: %v", err)
 	}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/dan13ram/wpokt-validator/common"
+	"github.com/dan13ram/wpokt-validator/remotesigner"
+	log "github.com/sirupsen/logrus"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+func createSigner(privateKey string, mnemonic string, gcpKmsKeyName string) (common.Signer, error) {
+	if privateKey != "" {
+		return common.NewPrivateKeySigner(privateKey)
+	}
+	if mnemonic != "" {
+		return common.NewMnemonicSigner(mnemonic)
+	}
+	return common.NewGcpKmsSigner(gcpKmsKeyName)
+}
+
+func requireEnv(name string) string {
+	value := os.Getenv(name)
+	if value == "" {
+		log.Fatalf("[REMOTE SIGNER] %s is required", name)
+	}
+	return value
+}
+
+// Signer daemon holding the validator keys, configured with the same env variables as the validator
+func main() {
+	var socketPath string
+	flag.StringVar(&socketPath, "socket", os.Getenv("REMOTE_SIGNER_SOCKET"), "path to the unix socket")
+	flag.Parse()
+
+	if socketPath == "" {
+		log.Fatal("[REMOTE SIGNER] socket path is required")
+	}
+	token := requireEnv("REMOTE_SIGNER_TOKEN")
+
+	ethSigner, err := createSigner(os.Getenv("ETH_PRIVATE_KEY"), os.Getenv("ETH_MNEMONIC"), os.Getenv("ETH_GCP_KMS_KEY_NAME"))
+	if err != nil {
+		log.Fatal("[REMOTE SIGNER] Error creating ethereum signer: ", err)
+	}
+	cosmosSigner, err := createSigner("", os.Getenv("POKT_MNEMONIC"), os.Getenv("POKT_GCP_KMS_KEY_NAME"))
+	if err != nil {
+		log.Fatal("[REMOTE SIGNER] Error creating pocket signer: ", err)
+	}
+
+	policy := remotesigner.Policy{
+		EthChainId:            requireEnv("ETH_CHAIN_ID"),
+		MintControllerAddress: requireEnv("ETH_MINT_CONTROLLER_ADDRESS"),
+		EthDomainName:         requireEnv("ETH_MINT_CONTROLLER_DOMAIN_NAME"),
+		EthDomainVersion:      requireEnv("ETH_MINT_CONTROLLER_DOMAIN_VERSION"),
+		CosmosChainId:         requireEnv("POKT_CHAIN_ID"),
+		VaultAddress:          requireEnv("POKT_MULTISIG_ADDRESS"),
+	}
+
+	listener, err := remotesigner.Listen(socketPath)
+	if err != nil {
+		log.Fatal("[REMOTE SIGNER] ", err)
+	}
+
+	server := &http.Server{
+		Handler:           remotesigner.NewServer(ethSigner, cosmosSigner, token, policy).Handler(),
+		ReadHeaderTimeout: shutdownTimeout,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Error("[REMOTE SIGNER] Error shutting down: ", err)
+		}
+	}()
+
+	log.Infof("[REMOTE SIGNER] Ethereum address %s, listening on %s", ethSigner.EthAddress().Hex(), socketPath)
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+		log.Fatal("[REMOTE SIGNER] Error serving: ", err)
+	}
+
+	ethSigner.Destroy()
+	cosmosSigner.Destroy()
+	log.Info("[REMOTE SIGNER] Stopped")
+}